@@ -19,9 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Disperser_DisperseBlob_FullMethodName  = "/disperser.Disperser/DisperseBlob"
-	Disperser_GetBlobStatus_FullMethodName = "/disperser.Disperser/GetBlobStatus"
-	Disperser_RetrieveBlob_FullMethodName  = "/disperser.Disperser/RetrieveBlob"
+	Disperser_DisperseBlob_FullMethodName              = "/disperser.Disperser/DisperseBlob"
+	Disperser_DisperseBlobAuthenticated_FullMethodName = "/disperser.Disperser/DisperseBlobAuthenticated"
+	Disperser_DisperseBlobStream_FullMethodName        = "/disperser.Disperser/DisperseBlobStream"
+	Disperser_DisperseBlobs_FullMethodName             = "/disperser.Disperser/DisperseBlobs"
+	Disperser_CancelBlob_FullMethodName                = "/disperser.Disperser/CancelBlob"
+	Disperser_CancelBlobAuthenticated_FullMethodName   = "/disperser.Disperser/CancelBlobAuthenticated"
+	Disperser_GetBlobStatus_FullMethodName             = "/disperser.Disperser/GetBlobStatus"
+	Disperser_GetBlobStatuses_FullMethodName           = "/disperser.Disperser/GetBlobStatuses"
+	Disperser_SubscribeBlobStatus_FullMethodName       = "/disperser.Disperser/SubscribeBlobStatus"
+	Disperser_RetrieveBlob_FullMethodName              = "/disperser.Disperser/RetrieveBlob"
 )
 
 // DisperserClient is the client API for Disperser service.
@@ -33,8 +40,62 @@ type DisperserClient interface {
 	// is accepted. The client could use GetBlobStatus() API to poll the the
 	// processing status of the blob.
 	DisperseBlob(ctx context.Context, in *DisperseBlobRequest, opts ...grpc.CallOption) (*DisperseBlobReply, error)
+	// DisperseBlobAuthenticated is similar to DisperseBlob, except that it requires the
+	// client to authenticate itself via the AuthenticationData message. The protocol is as follows:
+	// 1. The client sends a DisperseBlobAuthenticated message with the DisperseBlobRequest message,
+	//    where account_id is the Ethereum address of the client.
+	// 2. The Disperser sends back a BlobAuthHeader message containing a challenge nonce.
+	// 3. The client signs keccak256(keccak256(data) || challenge_parameter) with its ECDSA key
+	//    and sends the signature in an AuthenticationData message.
+	// 4. The Disperser recovers the signer address from the signature, checks it against
+	//    account_id, and then proceeds with the dispersal on behalf of that account.
+	//    The DisperseBlobReply is sent back to the client.
+	DisperseBlobAuthenticated(ctx context.Context, opts ...grpc.CallOption) (Disperser_DisperseBlobAuthenticatedClient, error)
+	// DisperseBlobStream is similar to DisperseBlob, except that the data is uploaded
+	// in chunks, so it isn't bound by the size of a single gRPC message.
+	// The first message must be a DisperseBlobStreamHeader, and it must be followed by
+	// the data chunks, in order. The Disperser starts the dispersal once the client
+	// closes the stream.
+	DisperseBlobStream(ctx context.Context, opts ...grpc.CallOption) (Disperser_DisperseBlobStreamClient, error)
+	// DisperseBlobs accepts multiple blobs to disperse in a single request.
+	// The rate limits are checked against the whole request, so either all the valid
+	// blobs are accepted or the request is rejected. Otherwise, each blob is validated
+	// and stored independently, and the result for each blob is returned in the same
+	// order as the request. A blob that fails doesn't fail the rest of the request.
+	DisperseBlobs(ctx context.Context, in *DisperseBlobsRequest, opts ...grpc.CallOption) (*DisperseBlobsReply, error)
+	// CancelBlob withdraws a blob that is still PROCESSING, moving it to CANCELLED so
+	// that it won't be included in any batch. Only the account that dispersed the blob
+	// can cancel it, so the request must come from the same client IP address as the
+	// dispersal. Blobs dispersed with DisperseBlobAuthenticated are cancelled with
+	// CancelBlobAuthenticated instead.
+	// A blob that has already been included in a batch that is being dispersed can't be
+	// cancelled anymore.
+	CancelBlob(ctx context.Context, in *CancelBlobRequest, opts ...grpc.CallOption) (*CancelBlobReply, error)
+	// CancelBlobAuthenticated is similar to CancelBlob, for blobs dispersed with
+	// DisperseBlobAuthenticated. The client proves that it controls the account that
+	// dispersed the blob with the same challenge as DisperseBlobAuthenticated:
+	// 1. The client sends an AuthenticatedCancelRequest message with the CancelBlobRequest message.
+	// 2. The Disperser sends back a BlobAuthHeader message containing a challenge nonce.
+	// 3. The client signs keccak256(keccak256("CancelBlob" || request_id) || challenge_parameter)
+	//    with its ECDSA key and sends the signature in an AuthenticationData message.
+	// 4. The Disperser recovers the signer address from the signature, checks it against
+	//    the account that dispersed the blob, and then cancels the blob.
+	//    The CancelBlobReply is sent back to the client.
+	CancelBlobAuthenticated(ctx context.Context, opts ...grpc.CallOption) (Disperser_CancelBlobAuthenticatedClient, error)
 	// This API is meant to be polled for the blob status.
 	GetBlobStatus(ctx context.Context, in *BlobStatusRequest, opts ...grpc.CallOption) (*BlobStatusReply, error)
+	// GetBlobStatuses is similar to GetBlobStatus, except that it looks up multiple
+	// blobs in a single request. The status of each blob is returned in the same order
+	// as the request. A blob that can't be looked up doesn't fail the rest of the request.
+	GetBlobStatuses(ctx context.Context, in *BlobStatusesRequest, opts ...grpc.CallOption) (*BlobStatusesReply, error)
+	// This API streams the status of a blob as it moves through the dispersal
+	// pipeline, so the client doesn't have to poll GetBlobStatus().
+	// A BlobStatusReply is sent immediately with the current status, and then
+	// once for every subsequent status transition. The BlobInfo is populated
+	// once the blob is confirmed.
+	// The stream is closed by the server after the blob reaches a terminal
+	// state (FINALIZED, FAILED, INSUFFICIENT_SIGNATURES or CANCELLED).
+	SubscribeBlobStatus(ctx context.Context, in *BlobStatusRequest, opts ...grpc.CallOption) (Disperser_SubscribeBlobStatusClient, error)
 	// This retrieves the requested blob from the Disperser's backend.
 	// This is a more efficient way to retrieve blobs than directly retrieving
 	// from the DA Nodes (see detail about this approach in
@@ -61,6 +122,120 @@ func (c *disperserClient) DisperseBlob(ctx context.Context, in *DisperseBlobRequ
 	return out, nil
 }
 
+func (c *disperserClient) DisperseBlobAuthenticated(ctx context.Context, opts ...grpc.CallOption) (Disperser_DisperseBlobAuthenticatedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Disperser_ServiceDesc.Streams[0], Disperser_DisperseBlobAuthenticated_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &disperserDisperseBlobAuthenticatedClient{stream}
+	return x, nil
+}
+
+type Disperser_DisperseBlobAuthenticatedClient interface {
+	Send(*AuthenticatedRequest) error
+	Recv() (*AuthenticatedReply, error)
+	grpc.ClientStream
+}
+
+type disperserDisperseBlobAuthenticatedClient struct {
+	grpc.ClientStream
+}
+
+func (x *disperserDisperseBlobAuthenticatedClient) Send(m *AuthenticatedRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *disperserDisperseBlobAuthenticatedClient) Recv() (*AuthenticatedReply, error) {
+	m := new(AuthenticatedReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *disperserClient) DisperseBlobStream(ctx context.Context, opts ...grpc.CallOption) (Disperser_DisperseBlobStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Disperser_ServiceDesc.Streams[1], Disperser_DisperseBlobStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &disperserDisperseBlobStreamClient{stream}
+	return x, nil
+}
+
+type Disperser_DisperseBlobStreamClient interface {
+	Send(*DisperseBlobStreamRequest) error
+	CloseAndRecv() (*DisperseBlobReply, error)
+	grpc.ClientStream
+}
+
+type disperserDisperseBlobStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *disperserDisperseBlobStreamClient) Send(m *DisperseBlobStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *disperserDisperseBlobStreamClient) CloseAndRecv() (*DisperseBlobReply, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(DisperseBlobReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *disperserClient) DisperseBlobs(ctx context.Context, in *DisperseBlobsRequest, opts ...grpc.CallOption) (*DisperseBlobsReply, error) {
+	out := new(DisperseBlobsReply)
+	err := c.cc.Invoke(ctx, Disperser_DisperseBlobs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *disperserClient) CancelBlob(ctx context.Context, in *CancelBlobRequest, opts ...grpc.CallOption) (*CancelBlobReply, error) {
+	out := new(CancelBlobReply)
+	err := c.cc.Invoke(ctx, Disperser_CancelBlob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *disperserClient) CancelBlobAuthenticated(ctx context.Context, opts ...grpc.CallOption) (Disperser_CancelBlobAuthenticatedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Disperser_ServiceDesc.Streams[2], Disperser_CancelBlobAuthenticated_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &disperserCancelBlobAuthenticatedClient{stream}
+	return x, nil
+}
+
+type Disperser_CancelBlobAuthenticatedClient interface {
+	Send(*AuthenticatedCancelRequest) error
+	Recv() (*AuthenticatedCancelReply, error)
+	grpc.ClientStream
+}
+
+type disperserCancelBlobAuthenticatedClient struct {
+	grpc.ClientStream
+}
+
+func (x *disperserCancelBlobAuthenticatedClient) Send(m *AuthenticatedCancelRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *disperserCancelBlobAuthenticatedClient) Recv() (*AuthenticatedCancelReply, error) {
+	m := new(AuthenticatedCancelReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *disperserClient) GetBlobStatus(ctx context.Context, in *BlobStatusRequest, opts ...grpc.CallOption) (*BlobStatusReply, error) {
 	out := new(BlobStatusReply)
 	err := c.cc.Invoke(ctx, Disperser_GetBlobStatus_FullMethodName, in, out, opts...)
@@ -70,6 +245,47 @@ func (c *disperserClient) GetBlobStatus(ctx context.Context, in *BlobStatusReque
 	return out, nil
 }
 
+func (c *disperserClient) GetBlobStatuses(ctx context.Context, in *BlobStatusesRequest, opts ...grpc.CallOption) (*BlobStatusesReply, error) {
+	out := new(BlobStatusesReply)
+	err := c.cc.Invoke(ctx, Disperser_GetBlobStatuses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *disperserClient) SubscribeBlobStatus(ctx context.Context, in *BlobStatusRequest, opts ...grpc.CallOption) (Disperser_SubscribeBlobStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Disperser_ServiceDesc.Streams[3], Disperser_SubscribeBlobStatus_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &disperserSubscribeBlobStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Disperser_SubscribeBlobStatusClient interface {
+	Recv() (*BlobStatusReply, error)
+	grpc.ClientStream
+}
+
+type disperserSubscribeBlobStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *disperserSubscribeBlobStatusClient) Recv() (*BlobStatusReply, error) {
+	m := new(BlobStatusReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *disperserClient) RetrieveBlob(ctx context.Context, in *RetrieveBlobRequest, opts ...grpc.CallOption) (*RetrieveBlobReply, error) {
 	out := new(RetrieveBlobReply)
 	err := c.cc.Invoke(ctx, Disperser_RetrieveBlob_FullMethodName, in, out, opts...)
@@ -88,8 +304,62 @@ type DisperserServer interface {
 	// is accepted. The client could use GetBlobStatus() API to poll the the
 	// processing status of the blob.
 	DisperseBlob(context.Context, *DisperseBlobRequest) (*DisperseBlobReply, error)
+	// DisperseBlobAuthenticated is similar to DisperseBlob, except that it requires the
+	// client to authenticate itself via the AuthenticationData message. The protocol is as follows:
+	// 1. The client sends a DisperseBlobAuthenticated message with the DisperseBlobRequest message,
+	//    where account_id is the Ethereum address of the client.
+	// 2. The Disperser sends back a BlobAuthHeader message containing a challenge nonce.
+	// 3. The client signs keccak256(keccak256(data) || challenge_parameter) with its ECDSA key
+	//    and sends the signature in an AuthenticationData message.
+	// 4. The Disperser recovers the signer address from the signature, checks it against
+	//    account_id, and then proceeds with the dispersal on behalf of that account.
+	//    The DisperseBlobReply is sent back to the client.
+	DisperseBlobAuthenticated(Disperser_DisperseBlobAuthenticatedServer) error
+	// DisperseBlobStream is similar to DisperseBlob, except that the data is uploaded
+	// in chunks, so it isn't bound by the size of a single gRPC message.
+	// The first message must be a DisperseBlobStreamHeader, and it must be followed by
+	// the data chunks, in order. The Disperser starts the dispersal once the client
+	// closes the stream.
+	DisperseBlobStream(Disperser_DisperseBlobStreamServer) error
+	// DisperseBlobs accepts multiple blobs to disperse in a single request.
+	// The rate limits are checked against the whole request, so either all the valid
+	// blobs are accepted or the request is rejected. Otherwise, each blob is validated
+	// and stored independently, and the result for each blob is returned in the same
+	// order as the request. A blob that fails doesn't fail the rest of the request.
+	DisperseBlobs(context.Context, *DisperseBlobsRequest) (*DisperseBlobsReply, error)
+	// CancelBlob withdraws a blob that is still PROCESSING, moving it to CANCELLED so
+	// that it won't be included in any batch. Only the account that dispersed the blob
+	// can cancel it, so the request must come from the same client IP address as the
+	// dispersal. Blobs dispersed with DisperseBlobAuthenticated are cancelled with
+	// CancelBlobAuthenticated instead.
+	// A blob that has already been included in a batch that is being dispersed can't be
+	// cancelled anymore.
+	CancelBlob(context.Context, *CancelBlobRequest) (*CancelBlobReply, error)
+	// CancelBlobAuthenticated is similar to CancelBlob, for blobs dispersed with
+	// DisperseBlobAuthenticated. The client proves that it controls the account that
+	// dispersed the blob with the same challenge as DisperseBlobAuthenticated:
+	// 1. The client sends an AuthenticatedCancelRequest message with the CancelBlobRequest message.
+	// 2. The Disperser sends back a BlobAuthHeader message containing a challenge nonce.
+	// 3. The client signs keccak256(keccak256("CancelBlob" || request_id) || challenge_parameter)
+	//    with its ECDSA key and sends the signature in an AuthenticationData message.
+	// 4. The Disperser recovers the signer address from the signature, checks it against
+	//    the account that dispersed the blob, and then cancels the blob.
+	//    The CancelBlobReply is sent back to the client.
+	CancelBlobAuthenticated(Disperser_CancelBlobAuthenticatedServer) error
 	// This API is meant to be polled for the blob status.
 	GetBlobStatus(context.Context, *BlobStatusRequest) (*BlobStatusReply, error)
+	// GetBlobStatuses is similar to GetBlobStatus, except that it looks up multiple
+	// blobs in a single request. The status of each blob is returned in the same order
+	// as the request. A blob that can't be looked up doesn't fail the rest of the request.
+	GetBlobStatuses(context.Context, *BlobStatusesRequest) (*BlobStatusesReply, error)
+	// This API streams the status of a blob as it moves through the dispersal
+	// pipeline, so the client doesn't have to poll GetBlobStatus().
+	// A BlobStatusReply is sent immediately with the current status, and then
+	// once for every subsequent status transition. The BlobInfo is populated
+	// once the blob is confirmed.
+	// The stream is closed by the server after the blob reaches a terminal
+	// state (FINALIZED, FAILED, INSUFFICIENT_SIGNATURES or CANCELLED).
+	SubscribeBlobStatus(*BlobStatusRequest, Disperser_SubscribeBlobStatusServer) error
 	// This retrieves the requested blob from the Disperser's backend.
 	// This is a more efficient way to retrieve blobs than directly retrieving
 	// from the DA Nodes (see detail about this approach in
@@ -107,9 +377,30 @@ type UnimplementedDisperserServer struct {
 func (UnimplementedDisperserServer) DisperseBlob(context.Context, *DisperseBlobRequest) (*DisperseBlobReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DisperseBlob not implemented")
 }
+func (UnimplementedDisperserServer) DisperseBlobAuthenticated(Disperser_DisperseBlobAuthenticatedServer) error {
+	return status.Errorf(codes.Unimplemented, "method DisperseBlobAuthenticated not implemented")
+}
+func (UnimplementedDisperserServer) DisperseBlobStream(Disperser_DisperseBlobStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method DisperseBlobStream not implemented")
+}
+func (UnimplementedDisperserServer) DisperseBlobs(context.Context, *DisperseBlobsRequest) (*DisperseBlobsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisperseBlobs not implemented")
+}
+func (UnimplementedDisperserServer) CancelBlob(context.Context, *CancelBlobRequest) (*CancelBlobReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBlob not implemented")
+}
+func (UnimplementedDisperserServer) CancelBlobAuthenticated(Disperser_CancelBlobAuthenticatedServer) error {
+	return status.Errorf(codes.Unimplemented, "method CancelBlobAuthenticated not implemented")
+}
 func (UnimplementedDisperserServer) GetBlobStatus(context.Context, *BlobStatusRequest) (*BlobStatusReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlobStatus not implemented")
 }
+func (UnimplementedDisperserServer) GetBlobStatuses(context.Context, *BlobStatusesRequest) (*BlobStatusesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlobStatuses not implemented")
+}
+func (UnimplementedDisperserServer) SubscribeBlobStatus(*BlobStatusRequest, Disperser_SubscribeBlobStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeBlobStatus not implemented")
+}
 func (UnimplementedDisperserServer) RetrieveBlob(context.Context, *RetrieveBlobRequest) (*RetrieveBlobReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RetrieveBlob not implemented")
 }
@@ -144,6 +435,120 @@ func _Disperser_DisperseBlob_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Disperser_DisperseBlobAuthenticated_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DisperserServer).DisperseBlobAuthenticated(&disperserDisperseBlobAuthenticatedServer{stream})
+}
+
+type Disperser_DisperseBlobAuthenticatedServer interface {
+	Send(*AuthenticatedReply) error
+	Recv() (*AuthenticatedRequest, error)
+	grpc.ServerStream
+}
+
+type disperserDisperseBlobAuthenticatedServer struct {
+	grpc.ServerStream
+}
+
+func (x *disperserDisperseBlobAuthenticatedServer) Send(m *AuthenticatedReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *disperserDisperseBlobAuthenticatedServer) Recv() (*AuthenticatedRequest, error) {
+	m := new(AuthenticatedRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Disperser_DisperseBlobStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DisperserServer).DisperseBlobStream(&disperserDisperseBlobStreamServer{stream})
+}
+
+type Disperser_DisperseBlobStreamServer interface {
+	SendAndClose(*DisperseBlobReply) error
+	Recv() (*DisperseBlobStreamRequest, error)
+	grpc.ServerStream
+}
+
+type disperserDisperseBlobStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *disperserDisperseBlobStreamServer) SendAndClose(m *DisperseBlobReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *disperserDisperseBlobStreamServer) Recv() (*DisperseBlobStreamRequest, error) {
+	m := new(DisperseBlobStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Disperser_DisperseBlobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisperseBlobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisperserServer).DisperseBlobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Disperser_DisperseBlobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisperserServer).DisperseBlobs(ctx, req.(*DisperseBlobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disperser_CancelBlob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBlobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisperserServer).CancelBlob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Disperser_CancelBlob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisperserServer).CancelBlob(ctx, req.(*CancelBlobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disperser_CancelBlobAuthenticated_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DisperserServer).CancelBlobAuthenticated(&disperserCancelBlobAuthenticatedServer{stream})
+}
+
+type Disperser_CancelBlobAuthenticatedServer interface {
+	Send(*AuthenticatedCancelReply) error
+	Recv() (*AuthenticatedCancelRequest, error)
+	grpc.ServerStream
+}
+
+type disperserCancelBlobAuthenticatedServer struct {
+	grpc.ServerStream
+}
+
+func (x *disperserCancelBlobAuthenticatedServer) Send(m *AuthenticatedCancelReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *disperserCancelBlobAuthenticatedServer) Recv() (*AuthenticatedCancelRequest, error) {
+	m := new(AuthenticatedCancelRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _Disperser_GetBlobStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BlobStatusRequest)
 	if err := dec(in); err != nil {
@@ -162,6 +567,45 @@ func _Disperser_GetBlobStatus_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Disperser_GetBlobStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlobStatusesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisperserServer).GetBlobStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Disperser_GetBlobStatuses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisperserServer).GetBlobStatuses(ctx, req.(*BlobStatusesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disperser_SubscribeBlobStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlobStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DisperserServer).SubscribeBlobStatus(m, &disperserSubscribeBlobStatusServer{stream})
+}
+
+type Disperser_SubscribeBlobStatusServer interface {
+	Send(*BlobStatusReply) error
+	grpc.ServerStream
+}
+
+type disperserSubscribeBlobStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *disperserSubscribeBlobStatusServer) Send(m *BlobStatusReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Disperser_RetrieveBlob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RetrieveBlobRequest)
 	if err := dec(in); err != nil {
@@ -191,15 +635,50 @@ var Disperser_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DisperseBlob",
 			Handler:    _Disperser_DisperseBlob_Handler,
 		},
+		{
+			MethodName: "DisperseBlobs",
+			Handler:    _Disperser_DisperseBlobs_Handler,
+		},
+		{
+			MethodName: "CancelBlob",
+			Handler:    _Disperser_CancelBlob_Handler,
+		},
 		{
 			MethodName: "GetBlobStatus",
 			Handler:    _Disperser_GetBlobStatus_Handler,
 		},
+		{
+			MethodName: "GetBlobStatuses",
+			Handler:    _Disperser_GetBlobStatuses_Handler,
+		},
 		{
 			MethodName: "RetrieveBlob",
 			Handler:    _Disperser_RetrieveBlob_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DisperseBlobAuthenticated",
+			Handler:       _Disperser_DisperseBlobAuthenticated_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DisperseBlobStream",
+			Handler:       _Disperser_DisperseBlobStream_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "CancelBlobAuthenticated",
+			Handler:       _Disperser_CancelBlobAuthenticated_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "SubscribeBlobStatus",
+			Handler:       _Disperser_SubscribeBlobStatus_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "disperser/disperser.proto",
 }
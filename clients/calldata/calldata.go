@@ -0,0 +1,193 @@
+package calldata
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	disperser_rpc "github.com/Layr-Labs/eigenda/api/grpc/disperser"
+	"github.com/Layr-Labs/eigenda/clients/verifier"
+	rollupbindings "github.com/Layr-Labs/eigenda/contracts/bindings/MockRollup"
+	"github.com/Layr-Labs/eigenda/core"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+var ErrBlobNotConfirmed = errors.New("blob is not confirmed")
+
+// postCommitmentArguments returns the arguments of MockRollup.postCommitment, which takes the same structs as
+// EigenDABlobUtils.verifyBlob. The generated bindings cache the parsed ABI.
+func postCommitmentArguments() (blobHeaderArguments abi.Arguments, blobVerificationProofArguments abi.Arguments, err error) {
+	rollupABI, err := rollupbindings.ContractMockRollupMetaData.GetAbi()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse MockRollup ABI: %w", err)
+	}
+	postCommitment, ok := rollupABI.Methods["postCommitment"]
+	if !ok || len(postCommitment.Inputs) != 2 {
+		return nil, nil, errors.New("MockRollup ABI does not have the expected postCommitment method")
+	}
+	return abi.Arguments{postCommitment.Inputs[0]}, abi.Arguments{postCommitment.Inputs[1]}, nil
+}
+
+// FromBlobStatusReply converts the BlobInfo of a confirmed or finalized blob into the structs expected by rollup contracts
+func FromBlobStatusReply(reply *disperser_rpc.BlobStatusReply) (rollupbindings.IEigenDAServiceManagerBlobHeader, rollupbindings.EigenDABlobUtilsBlobVerificationProof, error) {
+	status := reply.GetStatus()
+	if status != disperser_rpc.BlobStatus_CONFIRMED && status != disperser_rpc.BlobStatus_FINALIZED {
+		return rollupbindings.IEigenDAServiceManagerBlobHeader{}, rollupbindings.EigenDABlobUtilsBlobVerificationProof{}, fmt.Errorf("%w: status is %s", ErrBlobNotConfirmed, status)
+	}
+
+	blobHeader, err := BlobHeaderFromProto(reply.GetInfo().GetBlobHeader())
+	if err != nil {
+		return rollupbindings.IEigenDAServiceManagerBlobHeader{}, rollupbindings.EigenDABlobUtilsBlobVerificationProof{}, err
+	}
+	verificationProof, err := BlobVerificationProofFromProto(reply.GetInfo().GetBlobVerificationProof())
+	if err != nil {
+		return rollupbindings.IEigenDAServiceManagerBlobHeader{}, rollupbindings.EigenDABlobUtilsBlobVerificationProof{}, err
+	}
+	return blobHeader, verificationProof, nil
+}
+
+// BlobHeaderFromProto converts a blob header returned by the disperser into an IEigenDAServiceManager.BlobHeader
+func BlobHeaderFromProto(blobHeader *disperser_rpc.BlobHeader) (rollupbindings.IEigenDAServiceManagerBlobHeader, error) {
+	if blobHeader == nil {
+		return rollupbindings.IEigenDAServiceManagerBlobHeader{}, errors.New("blob header is nil")
+	}
+	commitment, err := new(core.Commitment).Deserialize(blobHeader.GetCommitment())
+	if err != nil {
+		return rollupbindings.IEigenDAServiceManagerBlobHeader{}, fmt.Errorf("failed to deserialize commitment: %w", err)
+	}
+	if commitment.G1Point == nil {
+		return rollupbindings.IEigenDAServiceManagerBlobHeader{}, core.ErrInvalidCommitment
+	}
+
+	quorums := make([]rollupbindings.IEigenDAServiceManagerQuorumBlobParam, len(blobHeader.GetBlobQuorumParams()))
+	for i, quorum := range blobHeader.GetBlobQuorumParams() {
+		for _, value := range []uint32{quorum.GetQuorumNumber(), quorum.GetAdversaryThresholdPercentage(), quorum.GetQuorumThresholdPercentage(), quorum.GetQuantizationParam()} {
+			if value > math.MaxUint8 {
+				return rollupbindings.IEigenDAServiceManagerBlobHeader{}, fmt.Errorf("quorum blob param %d does not fit in uint8: %d", i, value)
+			}
+		}
+		quorums[i] = rollupbindings.IEigenDAServiceManagerQuorumBlobParam{
+			QuorumNumber:                 uint8(quorum.GetQuorumNumber()),
+			AdversaryThresholdPercentage: uint8(quorum.GetAdversaryThresholdPercentage()),
+			QuorumThresholdPercentage:    uint8(quorum.GetQuorumThresholdPercentage()),
+			QuantizationParameter:        uint8(quorum.GetQuantizationParam()),
+		}
+	}
+
+	return rollupbindings.IEigenDAServiceManagerBlobHeader{
+		Commitment: rollupbindings.BN254G1Point{
+			X: commitment.X.BigInt(new(big.Int)),
+			Y: commitment.Y.BigInt(new(big.Int)),
+		},
+		DataLength:       blobHeader.GetDataLength(),
+		QuorumBlobParams: quorums,
+	}, nil
+}
+
+// BlobVerificationProofFromProto converts a verification proof returned by the disperser into an EigenDABlobUtils.BlobVerificationProof
+func BlobVerificationProofFromProto(verificationProof *disperser_rpc.BlobVerificationProof) (rollupbindings.EigenDABlobUtilsBlobVerificationProof, error) {
+	if verificationProof == nil || verificationProof.GetBatchMetadata() == nil || verificationProof.GetBatchMetadata().GetBatchHeader() == nil {
+		return rollupbindings.EigenDABlobUtilsBlobVerificationProof{}, errors.New("verification proof, batch metadata and batch header are required")
+	}
+	if verificationProof.GetBlobIndex() > math.MaxUint8 {
+		return rollupbindings.EigenDABlobUtilsBlobVerificationProof{}, fmt.Errorf("blob index does not fit in uint8: %d", verificationProof.GetBlobIndex())
+	}
+
+	batchMetadataProto := verificationProof.GetBatchMetadata()
+	batchHeaderProto := batchMetadataProto.GetBatchHeader()
+	if len(batchHeaderProto.GetBatchRoot()) != 32 {
+		return rollupbindings.EigenDABlobUtilsBlobVerificationProof{}, fmt.Errorf("batch root must be 32 bytes, got %d", len(batchHeaderProto.GetBatchRoot()))
+	}
+	if len(batchMetadataProto.GetSignatoryRecordHash()) != 32 {
+		return rollupbindings.EigenDABlobUtilsBlobVerificationProof{}, fmt.Errorf("signatory record hash must be 32 bytes, got %d", len(batchMetadataProto.GetSignatoryRecordHash()))
+	}
+	fee := new(big.Int).SetBytes(batchMetadataProto.GetFee())
+	if len(fee.Bytes()) > verifier.FeeLength {
+		return rollupbindings.EigenDABlobUtilsBlobVerificationProof{}, errors.New("fee does not fit in uint96")
+	}
+
+	var batchRoot [32]byte
+	copy(batchRoot[:], batchHeaderProto.GetBatchRoot())
+	var signatoryRecordHash [32]byte
+	copy(signatoryRecordHash[:], batchMetadataProto.GetSignatoryRecordHash())
+
+	return rollupbindings.EigenDABlobUtilsBlobVerificationProof{
+		BatchId:   verificationProof.GetBatchId(),
+		BlobIndex: uint8(verificationProof.GetBlobIndex()),
+		BatchMetadata: rollupbindings.IEigenDAServiceManagerBatchMetadata{
+			BatchHeader: rollupbindings.IEigenDAServiceManagerBatchHeader{
+				BlobHeadersRoot:            batchRoot,
+				QuorumNumbers:              batchHeaderProto.GetQuorumNumbers(),
+				QuorumThresholdPercentages: batchHeaderProto.GetQuorumSignedPercentages(),
+				ReferenceBlockNumber:       batchHeaderProto.GetReferenceBlockNumber(),
+			},
+			SignatoryRecordHash:     signatoryRecordHash,
+			Fee:                     fee,
+			ConfirmationBlockNumber: batchMetadataProto.GetConfirmationBlockNumber(),
+		},
+		InclusionProof:         verificationProof.GetInclusionProof(),
+		QuorumThresholdIndexes: verificationProof.GetQuorumIndexes(),
+	}, nil
+}
+
+// EncodeBlobHeader returns the ABI encoding of an IEigenDAServiceManager.BlobHeader
+func EncodeBlobHeader(blobHeader rollupbindings.IEigenDAServiceManagerBlobHeader) ([]byte, error) {
+	arguments, _, err := postCommitmentArguments()
+	if err != nil {
+		return nil, err
+	}
+	return arguments.Pack(blobHeader)
+}
+
+// DecodeBlobHeader decodes an ABI encoded IEigenDAServiceManager.BlobHeader
+func DecodeBlobHeader(data []byte) (rollupbindings.IEigenDAServiceManagerBlobHeader, error) {
+	arguments, _, err := postCommitmentArguments()
+	if err != nil {
+		return rollupbindings.IEigenDAServiceManagerBlobHeader{}, err
+	}
+	values, err := arguments.Unpack(data)
+	if err != nil {
+		return rollupbindings.IEigenDAServiceManagerBlobHeader{}, err
+	}
+	blobHeader := *abi.ConvertType(values[0], new(rollupbindings.IEigenDAServiceManagerBlobHeader)).(*rollupbindings.IEigenDAServiceManagerBlobHeader)
+	return blobHeader, nil
+}
+
+// EncodeBlobVerificationProof returns the ABI encoding of an EigenDABlobUtils.BlobVerificationProof
+func EncodeBlobVerificationProof(verificationProof rollupbindings.EigenDABlobUtilsBlobVerificationProof) ([]byte, error) {
+	_, arguments, err := postCommitmentArguments()
+	if err != nil {
+		return nil, err
+	}
+	return arguments.Pack(verificationProof)
+}
+
+// DecodeBlobVerificationProof decodes an ABI encoded EigenDABlobUtils.BlobVerificationProof
+func DecodeBlobVerificationProof(data []byte) (rollupbindings.EigenDABlobUtilsBlobVerificationProof, error) {
+	_, arguments, err := postCommitmentArguments()
+	if err != nil {
+		return rollupbindings.EigenDABlobUtilsBlobVerificationProof{}, err
+	}
+	values, err := arguments.Unpack(data)
+	if err != nil {
+		return rollupbindings.EigenDABlobUtilsBlobVerificationProof{}, err
+	}
+	verificationProof := *abi.ConvertType(values[0], new(rollupbindings.EigenDABlobUtilsBlobVerificationProof)).(*rollupbindings.EigenDABlobUtilsBlobVerificationProof)
+	return verificationProof, nil
+}
+
+// BuildCalldata returns the calldata for calling the given method of a rollup contract with the blob header and
+// verification proof of a confirmed blob. The method must take an IEigenDAServiceManager.BlobHeader and an
+// EigenDABlobUtils.BlobVerificationProof as its only arguments (e.g. MockRollup.postCommitment).
+func BuildCalldata(contractABI *abi.ABI, method string, reply *disperser_rpc.BlobStatusReply) ([]byte, error) {
+	blobHeader, verificationProof, err := FromBlobStatusReply(reply)
+	if err != nil {
+		return nil, err
+	}
+	data, err := contractABI.Pack(method, blobHeader, verificationProof)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack calldata for %s: %w", method, err)
+	}
+	return data, nil
+}
@@ -0,0 +1,168 @@
+package calldata_test
+
+import (
+	"math/big"
+	"testing"
+
+	disperser_rpc "github.com/Layr-Labs/eigenda/api/grpc/disperser"
+	"github.com/Layr-Labs/eigenda/clients/calldata"
+	rollupbindings "github.com/Layr-Labs/eigenda/contracts/bindings/MockRollup"
+	"github.com/Layr-Labs/eigenda/core"
+	kzgbn254 "github.com/Layr-Labs/eigenda/pkg/kzg/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/stretchr/testify/assert"
+)
+
+func makeBlobStatusReply(t *testing.T) *disperser_rpc.BlobStatusReply {
+	var commitX, commitY fp.Element
+	commitX.SetBigInt(big.NewInt(1))
+	commitY.SetBigInt(big.NewInt(2))
+	commitment, err := core.Commitment{
+		G1Point: &kzgbn254.G1Point{X: commitX, Y: commitY},
+	}.Serialize()
+	assert.NoError(t, err)
+
+	batchRoot := make([]byte, 32)
+	batchRoot[0] = 1
+	signatoryRecordHash := make([]byte, 32)
+	signatoryRecordHash[31] = 2
+
+	return &disperser_rpc.BlobStatusReply{
+		Status: disperser_rpc.BlobStatus_CONFIRMED,
+		Info: &disperser_rpc.BlobInfo{
+			BlobHeader: &disperser_rpc.BlobHeader{
+				Commitment: commitment,
+				DataLength: 10,
+				BlobQuorumParams: []*disperser_rpc.BlobQuorumParam{
+					{
+						QuorumNumber:                 0,
+						AdversaryThresholdPercentage: 50,
+						QuorumThresholdPercentage:    80,
+						QuantizationParam:            1,
+						EncodedLength:                100,
+					},
+					{
+						QuorumNumber:                 1,
+						AdversaryThresholdPercentage: 33,
+						QuorumThresholdPercentage:    67,
+						QuantizationParam:            2,
+						EncodedLength:                200,
+					},
+				},
+			},
+			BlobVerificationProof: &disperser_rpc.BlobVerificationProof{
+				BatchId:   3,
+				BlobIndex: 4,
+				BatchMetadata: &disperser_rpc.BatchMetadata{
+					BatchHeader: &disperser_rpc.BatchHeader{
+						BatchRoot:               batchRoot,
+						QuorumNumbers:           []byte{0, 1},
+						QuorumSignedPercentages: []byte{90, 70},
+						ReferenceBlockNumber:    5,
+					},
+					SignatoryRecordHash:     signatoryRecordHash,
+					Fee:                     []byte{1, 0},
+					ConfirmationBlockNumber: 6,
+				},
+				InclusionProof: make([]byte, 64),
+				QuorumIndexes:  []byte{0, 1},
+			},
+		},
+	}
+}
+
+func TestFromBlobStatusReply(t *testing.T) {
+	reply := makeBlobStatusReply(t)
+
+	blobHeader, verificationProof, err := calldata.FromBlobStatusReply(reply)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(1), blobHeader.Commitment.X)
+	assert.Equal(t, big.NewInt(2), blobHeader.Commitment.Y)
+	assert.Equal(t, uint32(10), blobHeader.DataLength)
+	assert.Equal(t, []rollupbindings.IEigenDAServiceManagerQuorumBlobParam{
+		{QuorumNumber: 0, AdversaryThresholdPercentage: 50, QuorumThresholdPercentage: 80, QuantizationParameter: 1},
+		{QuorumNumber: 1, AdversaryThresholdPercentage: 33, QuorumThresholdPercentage: 67, QuantizationParameter: 2},
+	}, blobHeader.QuorumBlobParams)
+
+	assert.Equal(t, uint32(3), verificationProof.BatchId)
+	assert.Equal(t, uint8(4), verificationProof.BlobIndex)
+	assert.Equal(t, byte(1), verificationProof.BatchMetadata.BatchHeader.BlobHeadersRoot[0])
+	assert.Equal(t, []byte{0, 1}, verificationProof.BatchMetadata.BatchHeader.QuorumNumbers)
+	assert.Equal(t, []byte{90, 70}, verificationProof.BatchMetadata.BatchHeader.QuorumThresholdPercentages)
+	assert.Equal(t, uint32(5), verificationProof.BatchMetadata.BatchHeader.ReferenceBlockNumber)
+	assert.Equal(t, byte(2), verificationProof.BatchMetadata.SignatoryRecordHash[31])
+	assert.Equal(t, big.NewInt(256), verificationProof.BatchMetadata.Fee)
+	assert.Equal(t, uint32(6), verificationProof.BatchMetadata.ConfirmationBlockNumber)
+	assert.Equal(t, make([]byte, 64), verificationProof.InclusionProof)
+	assert.Equal(t, []byte{0, 1}, verificationProof.QuorumThresholdIndexes)
+}
+
+func TestFromBlobStatusReplyWithInvalidReply(t *testing.T) {
+	reply := makeBlobStatusReply(t)
+	reply.Status = disperser_rpc.BlobStatus_PROCESSING
+	_, _, err := calldata.FromBlobStatusReply(reply)
+	assert.ErrorIs(t, err, calldata.ErrBlobNotConfirmed)
+
+	reply = makeBlobStatusReply(t)
+	reply.Info.BlobVerificationProof.BlobIndex = 256
+	_, _, err = calldata.FromBlobStatusReply(reply)
+	assert.ErrorContains(t, err, "blob index does not fit in uint8")
+
+	reply = makeBlobStatusReply(t)
+	reply.Info.BlobHeader.BlobQuorumParams[1].QuorumThresholdPercentage = 300
+	_, _, err = calldata.FromBlobStatusReply(reply)
+	assert.ErrorContains(t, err, "does not fit in uint8")
+
+	reply = makeBlobStatusReply(t)
+	reply.Info.BlobVerificationProof.BatchMetadata.Fee = make([]byte, 13)
+	reply.Info.BlobVerificationProof.BatchMetadata.Fee[0] = 1
+	_, _, err = calldata.FromBlobStatusReply(reply)
+	assert.ErrorContains(t, err, "fee does not fit in uint96")
+
+	reply = makeBlobStatusReply(t)
+	reply.Info.BlobHeader.Commitment = []byte{1, 2, 3}
+	_, _, err = calldata.FromBlobStatusReply(reply)
+	assert.ErrorContains(t, err, "failed to deserialize commitment")
+}
+
+func TestEncodeDecodeRoundTrip(t *testing.T) {
+	blobHeader, verificationProof, err := calldata.FromBlobStatusReply(makeBlobStatusReply(t))
+	assert.NoError(t, err)
+
+	data, err := calldata.EncodeBlobHeader(blobHeader)
+	assert.NoError(t, err)
+	decodedBlobHeader, err := calldata.DecodeBlobHeader(data)
+	assert.NoError(t, err)
+	assert.Equal(t, blobHeader, decodedBlobHeader)
+
+	data, err = calldata.EncodeBlobVerificationProof(verificationProof)
+	assert.NoError(t, err)
+	decodedVerificationProof, err := calldata.DecodeBlobVerificationProof(data)
+	assert.NoError(t, err)
+	assert.Equal(t, verificationProof, decodedVerificationProof)
+}
+
+func TestBuildCalldata(t *testing.T) {
+	reply := makeBlobStatusReply(t)
+	rollupABI, err := rollupbindings.ContractMockRollupMetaData.GetAbi()
+	assert.NoError(t, err)
+
+	data, err := calldata.BuildCalldata(rollupABI, "postCommitment", reply)
+	assert.NoError(t, err)
+
+	// The calldata must match what the generated bindings would send
+	method := rollupABI.Methods["postCommitment"]
+	assert.Equal(t, method.ID, data[:4])
+	values, err := method.Inputs.Unpack(data[4:])
+	assert.NoError(t, err)
+	assert.Len(t, values, 2)
+
+	blobHeader, verificationProof, err := calldata.FromBlobStatusReply(reply)
+	assert.NoError(t, err)
+	expected, err := rollupABI.Pack("postCommitment", blobHeader, verificationProof)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, data)
+
+	_, err = calldata.BuildCalldata(rollupABI, "registerValidator", reply)
+	assert.Error(t, err)
+}
@@ -0,0 +1,164 @@
+package codec
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// PayloadEncodingVersion identifies the layout of an encoded payload
+type PayloadEncodingVersion byte
+
+// Compression is the algorithm used to compress the payload before it's packed into symbols
+type Compression byte
+
+const (
+	// PayloadEncodingVersion0 prefixes the payload with a header containing the version, the compression
+	// algorithm and the length of the (possibly compressed) payload, then packs it into field-safe symbols
+	PayloadEncodingVersion0 PayloadEncodingVersion = 0
+
+	NoCompression   Compression = 0
+	ZstdCompression Compression = 1
+
+	// SymbolSize is the size of an encoded symbol, i.e. a BN254 field element
+	SymbolSize = 32
+	// BytesPerSymbol is the number of payload bytes carried by each symbol. The first byte of every symbol
+	// is zero so that the symbol is always smaller than the field modulus.
+	BytesPerSymbol = SymbolSize - 1
+
+	// MaxDecompressedPayloadSize is the max size of a compressed payload once decompressed. It bounds the memory used
+	// by DecodePayload on data that decompresses to much more than its own size.
+	MaxDecompressedPayloadSize = 16 * 1024 * 1024
+
+	// headerLength is the length of the payload header: version (1 byte), compression (1 byte) and payload length (4 bytes)
+	headerLength = 6
+)
+
+var (
+	ErrUnsupportedVersion     = errors.New("unsupported payload encoding version")
+	ErrUnsupportedCompression = errors.New("unsupported payload compression")
+	ErrInvalidEncoding        = errors.New("invalid payload encoding")
+	ErrPayloadTooLarge        = errors.New("payload is too large")
+)
+
+// The zstd encoder and decoder are safe for concurrent use with EncodeAll and DecodeAll
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderMaxMemory(MaxDecompressedPayloadSize))
+)
+
+// EncodePayload frames arbitrary bytes into field-safe symbols that can be dispersed as a blob and recovered
+// exactly with DecodePayload
+func EncodePayload(payload []byte, compression Compression) ([]byte, error) {
+	var body []byte
+	switch compression {
+	case NoCompression:
+		body = payload
+	case ZstdCompression:
+		// Larger payloads couldn't be decompressed by DecodePayload
+		if len(payload) > MaxDecompressedPayloadSize {
+			return nil, fmt.Errorf("%w: %d bytes exceeds the max of %d bytes for compressed payloads", ErrPayloadTooLarge, len(payload), MaxDecompressedPayloadSize)
+		}
+		body = zstdEncoder.EncodeAll(payload, nil)
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedCompression, compression)
+	}
+	if uint64(len(body)) > math.MaxUint32 {
+		return nil, fmt.Errorf("%w: %d bytes", ErrPayloadTooLarge, len(body))
+	}
+
+	framed := make([]byte, headerLength+len(body))
+	framed[0] = byte(PayloadEncodingVersion0)
+	framed[1] = byte(compression)
+	binary.BigEndian.PutUint32(framed[2:headerLength], uint32(len(body)))
+	copy(framed[headerLength:], body)
+
+	return toSymbols(framed), nil
+}
+
+// DecodePayload recovers the original payload from data encoded with EncodePayload. The data may be followed by
+// zero padding, e.g. when it's returned by RetrieveBlob.
+// It returns ErrPayloadTooLarge if a compressed payload decompresses to more than MaxDecompressedPayloadSize bytes.
+func DecodePayload(data []byte) ([]byte, error) {
+	framed, err := fromSymbols(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(framed) < headerLength {
+		return nil, fmt.Errorf("%w: data is too short to contain a header", ErrInvalidEncoding)
+	}
+
+	version := PayloadEncodingVersion(framed[0])
+	if version != PayloadEncodingVersion0 {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, version)
+	}
+	compression := Compression(framed[1])
+	length := uint64(binary.BigEndian.Uint32(framed[2:headerLength]))
+	if length > uint64(len(framed)-headerLength) {
+		return nil, fmt.Errorf("%w: payload length %d exceeds the data length %d", ErrInvalidEncoding, length, len(framed)-headerLength)
+	}
+	body := framed[headerLength : headerLength+length]
+	for _, b := range framed[headerLength+length:] {
+		if b != 0 {
+			return nil, fmt.Errorf("%w: non-zero padding after the payload", ErrInvalidEncoding)
+		}
+	}
+
+	switch compression {
+	case NoCompression:
+		return body, nil
+	case ZstdCompression:
+		payload, err := zstdDecoder.DecodeAll(body, nil)
+		if errors.Is(err, zstd.ErrDecoderSizeExceeded) {
+			return nil, fmt.Errorf("%w: decompressed payload exceeds %d bytes", ErrPayloadTooLarge, MaxDecompressedPayloadSize)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to decompress payload: %v", ErrInvalidEncoding, err)
+		}
+		return payload, nil
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedCompression, compression)
+	}
+}
+
+// GetEncodedPayloadLength returns the length of the encoding of a payload of the given length without compression
+func GetEncodedPayloadLength(payloadLength uint) uint {
+	numSymbols := (payloadLength + headerLength + BytesPerSymbol - 1) / BytesPerSymbol
+	return numSymbols * SymbolSize
+}
+
+// toSymbols splits the data into chunks of BytesPerSymbol bytes and prefixes each of them with a zero byte
+func toSymbols(data []byte) []byte {
+	numSymbols := (len(data) + BytesPerSymbol - 1) / BytesPerSymbol
+	symbols := make([]byte, numSymbols*SymbolSize)
+	for i := 0; i < numSymbols; i++ {
+		start := i * BytesPerSymbol
+		end := start + BytesPerSymbol
+		if end > len(data) {
+			end = len(data)
+		}
+		copy(symbols[i*SymbolSize+1:], data[start:end])
+	}
+	return symbols
+}
+
+// fromSymbols removes the zero byte prefixing each symbol. The last symbol may be truncated.
+func fromSymbols(symbols []byte) ([]byte, error) {
+	numSymbols := (len(symbols) + SymbolSize - 1) / SymbolSize
+	data := make([]byte, 0, numSymbols*BytesPerSymbol)
+	for i := 0; i < numSymbols; i++ {
+		start := i * SymbolSize
+		end := start + SymbolSize
+		if end > len(symbols) {
+			end = len(symbols)
+		}
+		if symbols[start] != 0 {
+			return nil, fmt.Errorf("%w: symbol %d is not field-safe", ErrInvalidEncoding, i)
+		}
+		data = append(data, symbols[start+1:end]...)
+	}
+	return data, nil
+}
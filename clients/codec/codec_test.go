@@ -0,0 +1,137 @@
+package codec_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/Layr-Labs/eigenda/clients/codec"
+	"github.com/Layr-Labs/eigenda/pkg/encoding/encoder"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+)
+
+func randomBytes(t *testing.T, n int) []byte {
+	data := make([]byte, n)
+	_, err := rand.Read(data)
+	assert.NoError(t, err)
+	return data
+}
+
+func TestEncodeDecodePayload(t *testing.T) {
+	sizes := []int{0, 1, 24, 25, 26, 30, 31, 32, 62, 1000, 128 * 1024}
+	for _, compression := range []codec.Compression{codec.NoCompression, codec.ZstdCompression} {
+		for _, size := range sizes {
+			// All 0xff bytes would be reduced modulo the field if they weren't framed
+			payloads := [][]byte{randomBytes(t, size), bytes.Repeat([]byte{0xff}, size)}
+			for _, payload := range payloads {
+				encoded, err := codec.EncodePayload(payload, compression)
+				assert.NoError(t, err)
+				assert.Equal(t, 0, len(encoded)%codec.SymbolSize)
+				if compression == codec.NoCompression {
+					assert.Equal(t, codec.GetEncodedPayloadLength(uint(size)), uint(len(encoded)))
+				}
+
+				// Every symbol must be a canonical field element
+				for i := 0; i < len(encoded); i += codec.SymbolSize {
+					symbol := encoded[i : i+codec.SymbolSize]
+					assert.Equal(t, -1, new(big.Int).SetBytes(symbol).Cmp(fr.Modulus()))
+				}
+
+				decoded, err := codec.DecodePayload(encoded)
+				assert.NoError(t, err)
+				assert.Equal(t, len(payload), len(decoded))
+				assert.True(t, bytes.Equal(payload, decoded))
+			}
+		}
+	}
+}
+
+func TestDecodePayloadFromBlob(t *testing.T) {
+	payload := randomBytes(t, 1000)
+	encoded, err := codec.EncodePayload(payload, codec.NoCompression)
+	assert.NoError(t, err)
+
+	// The encoder packs the blob into field elements and RetrieveBlob unpacks them
+	blob := encoder.ToByteArray(encoder.ToFrArray(encoded), uint64(len(encoded)))
+	decoded, err := codec.DecodePayload(blob)
+	assert.NoError(t, err)
+	assert.Equal(t, payload, decoded)
+
+	// Trailing zero padding is ignored, including a truncated symbol
+	decoded, err = codec.DecodePayload(append(blob, make([]byte, 40)...))
+	assert.NoError(t, err)
+	assert.Equal(t, payload, decoded)
+}
+
+func TestDecodeInvalidPayload(t *testing.T) {
+	encoded, err := codec.EncodePayload([]byte("hello"), codec.NoCompression)
+	assert.NoError(t, err)
+
+	_, err = codec.DecodePayload(nil)
+	assert.ErrorIs(t, err, codec.ErrInvalidEncoding)
+
+	invalid := bytes.Clone(encoded)
+	invalid[0] = 1
+	_, err = codec.DecodePayload(invalid)
+	assert.ErrorIs(t, err, codec.ErrInvalidEncoding)
+
+	invalid = bytes.Clone(encoded)
+	invalid[1] = 1
+	_, err = codec.DecodePayload(invalid)
+	assert.ErrorIs(t, err, codec.ErrUnsupportedVersion)
+
+	invalid = bytes.Clone(encoded)
+	invalid[2] = 7
+	_, err = codec.DecodePayload(invalid)
+	assert.ErrorIs(t, err, codec.ErrUnsupportedCompression)
+
+	// Length larger than the data
+	invalid = bytes.Clone(encoded)
+	invalid[6] = 100
+	_, err = codec.DecodePayload(invalid)
+	assert.ErrorIs(t, err, codec.ErrInvalidEncoding)
+
+	// Non-zero bytes after the payload
+	invalid = bytes.Clone(encoded)
+	invalid[20] = 1
+	_, err = codec.DecodePayload(invalid)
+	assert.ErrorIs(t, err, codec.ErrInvalidEncoding)
+
+	// Corrupted compressed payload
+	encoded, err = codec.EncodePayload([]byte("hello"), codec.ZstdCompression)
+	assert.NoError(t, err)
+	encoded[8] ^= 0xff
+	_, err = codec.DecodePayload(encoded)
+	assert.ErrorIs(t, err, codec.ErrInvalidEncoding)
+
+	_, err = codec.EncodePayload([]byte("hello"), codec.Compression(7))
+	assert.ErrorIs(t, err, codec.ErrUnsupportedCompression)
+}
+
+func TestDecodePayloadSizeLimit(t *testing.T) {
+	// A small compressed payload that decompresses to more than the limit
+	encoder, err := zstd.NewWriter(nil)
+	assert.NoError(t, err)
+	compressed := encoder.EncodeAll(make([]byte, codec.MaxDecompressedPayloadSize+1), nil)
+	assert.Less(t, len(compressed), 64*1024)
+
+	// Frame the compressed payload as is and mark it as compressed
+	encoded, err := codec.EncodePayload(compressed, codec.NoCompression)
+	assert.NoError(t, err)
+	encoded[2] = byte(codec.ZstdCompression)
+	_, err = codec.DecodePayload(encoded)
+	assert.ErrorIs(t, err, codec.ErrPayloadTooLarge)
+
+	// Payloads that couldn't be decompressed aren't compressed
+	_, err = codec.EncodePayload(make([]byte, codec.MaxDecompressedPayloadSize+1), codec.ZstdCompression)
+	assert.ErrorIs(t, err, codec.ErrPayloadTooLarge)
+
+	encoded, err = codec.EncodePayload(make([]byte, codec.MaxDecompressedPayloadSize), codec.ZstdCompression)
+	assert.NoError(t, err)
+	decoded, err := codec.DecodePayload(encoded)
+	assert.NoError(t, err)
+	assert.Len(t, decoded, codec.MaxDecompressedPayloadSize)
+}
@@ -0,0 +1,551 @@
+package clients
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	disperser_rpc "github.com/Layr-Labs/eigenda/api/grpc/disperser"
+	"github.com/Layr-Labs/eigenda/core"
+	"github.com/Layr-Labs/eigenda/disperser"
+	"github.com/ethereum/go-ethereum/crypto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// ErrInvalidRequest is returned when the disperser rejects the request as invalid
+	ErrInvalidRequest = errors.New("invalid request")
+	// ErrRateLimited is returned when the request exceeds the rate limits of the disperser
+	ErrRateLimited = errors.New("request rate limited")
+	// ErrBlobNotFound is returned when the disperser doesn't have the requested blob
+	ErrBlobNotFound = errors.New("blob not found")
+	// ErrUnavailable is returned when the disperser can't be reached or doesn't respond in time
+	ErrUnavailable = errors.New("disperser unavailable")
+	// ErrBlobFailed is returned when a blob being waited on fails to be dispersed
+	ErrBlobFailed = errors.New("blob dispersal failed")
+	// ErrBlobInsufficientSignatures is returned when a blob being waited on doesn't get enough signatures
+	ErrBlobInsufficientSignatures = errors.New("blob dispersal got insufficient signatures")
+	// ErrBlobCancelled is returned when a blob being waited on has been cancelled
+	ErrBlobCancelled = errors.New("blob dispersal cancelled")
+)
+
+const (
+	defaultTimeout        = 20 * time.Second
+	defaultInitialBackoff = 1 * time.Second
+	defaultMaxBackoff     = 30 * time.Second
+)
+
+type Config struct {
+	Hostname string
+	Port     string
+	// Timeout is the timeout of a single request to the disperser. It defaults to 20 seconds if it's 0.
+	Timeout           time.Duration
+	UseSecureGrpcFlag bool
+	// MaxRetries is the number of times a request is retried after it fails with
+	// ErrUnavailable or ErrRateLimited. Dispersal requests are only retried when they can't
+	// have been accepted by the disperser, see DisperserClient.DisperseBlob.
+	MaxRetries int
+	// InitialBackoff is the wait before the first retry, and the first interval between
+	// status checks when waiting for a blob. It doubles after every attempt, up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+func NewConfig(hostname, port string, timeout time.Duration, useSecureGrpcFlag bool) *Config {
+	return &Config{
+		Hostname:          hostname,
+		Port:              port,
+		Timeout:           timeout,
+		UseSecureGrpcFlag: useSecureGrpcFlag,
+		MaxRetries:        3,
+		InitialBackoff:    defaultInitialBackoff,
+		MaxBackoff:        defaultMaxBackoff,
+	}
+}
+
+type DisperserClient interface {
+	// DisperseBlob disperses the data and returns the status of the blob and its request ID.
+	// The request is only retried when it's rate limited. A request that timed out or lost its
+	// connection may have been accepted by the disperser, so it isn't retried to avoid dispersing
+	// and paying for the blob twice.
+	DisperseBlob(ctx context.Context, data []byte, securityParams []*core.SecurityParam) (*disperser.BlobStatus, []byte, error)
+	// DisperseBlobAuthenticated is like DisperseBlob, but the request is signed by the client's
+	// signer so that the blob is accounted to the signer's account instead of the client's IP.
+	// Transient errors are also retried as long as they happen before the signed challenge is sent,
+	// since the disperser doesn't accept the blob until then.
+	DisperseBlobAuthenticated(ctx context.Context, data []byte, securityParams []*core.SecurityParam) (*disperser.BlobStatus, []byte, error)
+	// CancelBlob cancels a blob dispersed with DisperseBlob that is still processing.
+	CancelBlob(ctx context.Context, requestID []byte) error
+	// CancelBlobAuthenticated cancels a blob dispersed with DisperseBlobAuthenticated that is still processing.
+	// The disperser's challenge is signed by the client's signer, which must be the signer of the dispersal.
+	CancelBlobAuthenticated(ctx context.Context, requestID []byte) error
+	GetBlobStatus(ctx context.Context, requestID []byte) (*disperser_rpc.BlobStatusReply, error)
+	// GetBlobStatuses looks up the status of multiple blobs in a single request. The results are in the same
+	// order as the request IDs, and a blob that can't be looked up has the error set in its result.
+	GetBlobStatuses(ctx context.Context, requestIDs [][]byte) ([]*disperser_rpc.BlobStatusResult, error)
+	// WaitForBlobStatus polls the status of the blob until it reaches the given status, which must be
+	// Confirmed or Finalized. A Finalized blob also satisfies Confirmed.
+	// It returns ErrBlobFailed, ErrBlobInsufficientSignatures or ErrBlobCancelled if the blob fails, and the context
+	// error once the context is done.
+	WaitForBlobStatus(ctx context.Context, requestID []byte, blobStatus disperser.BlobStatus) (*disperser_rpc.BlobStatusReply, error)
+	RetrieveBlob(ctx context.Context, batchHeaderHash []byte, blobIndex uint32) ([]byte, error)
+	Close() error
+}
+
+type disperserClient struct {
+	config *Config
+	signer core.BlobRequestSigner
+	conn   *grpc.ClientConn
+	client disperser_rpc.DisperserClient
+}
+
+var _ DisperserClient = (*disperserClient)(nil)
+
+// NewDisperserClient creates a client for the disperser at config.Hostname:config.Port.
+// The signer is only needed for DisperseBlobAuthenticated and can be nil.
+func NewDisperserClient(config *Config, signer core.BlobRequestSigner) (DisperserClient, error) {
+	if config.Timeout < 0 {
+		return nil, fmt.Errorf("invalid timeout %v: it must not be negative", config.Timeout)
+	}
+	addr := fmt.Sprintf("%v:%v", config.Hostname, config.Port)
+	conn, err := grpc.Dial(addr, getDialOptions(config.UseSecureGrpcFlag)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial disperser at %s: %w", addr, err)
+	}
+
+	return &disperserClient{
+		config: config,
+		signer: signer,
+		conn:   conn,
+		client: disperser_rpc.NewDisperserClient(conn),
+	}, nil
+}
+
+func getDialOptions(useSecureGrpc bool) []grpc.DialOption {
+	if useSecureGrpc {
+		config := &tls.Config{}
+		credential := credentials.NewTLS(config)
+		return []grpc.DialOption{grpc.WithTransportCredentials(credential)}
+	} else {
+		return []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	}
+}
+
+func (c *disperserClient) DisperseBlob(ctx context.Context, data []byte, securityParams []*core.SecurityParam) (*disperser.BlobStatus, []byte, error) {
+	request := &disperser_rpc.DisperseBlobRequest{
+		Data:           data,
+		SecurityParams: getSecurityParamsProto(securityParams),
+	}
+
+	var reply *disperser_rpc.DisperseBlobReply
+	err := c.retry(ctx, isRetryableDispersalError, func(ctx context.Context) error {
+		var err error
+		reply, err = c.client.DisperseBlob(ctx, request)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blobStatus, err := disperser.FromBlobStatusProto(reply.GetResult())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return blobStatus, reply.GetRequestId(), nil
+}
+
+func (c *disperserClient) DisperseBlobAuthenticated(ctx context.Context, data []byte, securityParams []*core.SecurityParam) (*disperser.BlobStatus, []byte, error) {
+	if c.signer == nil {
+		return nil, nil, errors.New("a signer is required for authenticated dispersal")
+	}
+
+	request := &disperser_rpc.DisperseBlobRequest{
+		Data:           data,
+		SecurityParams: getSecurityParamsProto(securityParams),
+		AccountId:      c.signer.GetAccountID(),
+	}
+
+	var reply *disperser_rpc.DisperseBlobReply
+	err := c.retry(ctx, isRetryableDispersalError, func(ctx context.Context) error {
+		var err error
+		reply, err = c.disperseBlobAuthenticated(ctx, request)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blobStatus, err := disperser.FromBlobStatusProto(reply.GetResult())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return blobStatus, reply.GetRequestId(), nil
+}
+
+// disperseBlobAuthenticated runs a single attempt of the DisperseBlobAuthenticated protocol.
+// The errors that happen before the signed challenge is sent are wrapped in notSentError.
+func (c *disperserClient) disperseBlobAuthenticated(ctx context.Context, request *disperser_rpc.DisperseBlobRequest) (*disperser_rpc.DisperseBlobReply, error) {
+	stream, err := c.client.DisperseBlobAuthenticated(ctx)
+	if err != nil {
+		return nil, notSentError{err}
+	}
+	defer func() { _ = stream.CloseSend() }()
+
+	// Send the request
+	err = stream.Send(&disperser_rpc.AuthenticatedRequest{Payload: &disperser_rpc.AuthenticatedRequest_DisperseRequest{
+		DisperseRequest: request,
+	}})
+	if err != nil {
+		return nil, notSentError{err}
+	}
+
+	// Get the challenge from the disperser
+	reply, err := stream.Recv()
+	if err != nil {
+		return nil, notSentError{err}
+	}
+	authHeaderReply, ok := reply.GetPayload().(*disperser_rpc.AuthenticatedReply_BlobAuthHeader)
+	if !ok {
+		return nil, errors.New("expected challenge from the disperser")
+	}
+
+	// Sign the challenge and send it back
+	signature, err := c.signer.SignBlobRequest(core.BlobAuthHeader{
+		AccountID: request.GetAccountId(),
+		BlobHash:  crypto.Keccak256Hash(request.GetData()),
+		Nonce:     authHeaderReply.BlobAuthHeader.GetChallengeParameter(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign the challenge: %w", err)
+	}
+	err = stream.Send(&disperser_rpc.AuthenticatedRequest{Payload: &disperser_rpc.AuthenticatedRequest_AuthenticationData{
+		AuthenticationData: &disperser_rpc.AuthenticationData{
+			AuthenticationData: signature,
+		},
+	}})
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the result of the dispersal
+	reply, err = stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	disperseReply, ok := reply.GetPayload().(*disperser_rpc.AuthenticatedReply_DisperseReply)
+	if !ok {
+		return nil, errors.New("expected dispersal reply from the disperser")
+	}
+
+	return disperseReply.DisperseReply, nil
+}
+
+func (c *disperserClient) CancelBlob(ctx context.Context, requestID []byte) error {
+	request := &disperser_rpc.CancelBlobRequest{
+		RequestId: requestID,
+	}
+
+	return c.retry(ctx, isTransientError, func(ctx context.Context) error {
+		_, err := c.client.CancelBlob(ctx, request)
+		return err
+	})
+}
+
+func (c *disperserClient) CancelBlobAuthenticated(ctx context.Context, requestID []byte) error {
+	if c.signer == nil {
+		return errors.New("a signer is required for authenticated cancellation")
+	}
+
+	// Each attempt signs a new challenge, and cancelling a blob again fails without side effects
+	return c.retry(ctx, isTransientError, func(ctx context.Context) error {
+		return c.cancelBlobAuthenticated(ctx, requestID)
+	})
+}
+
+// cancelBlobAuthenticated runs a single attempt of the CancelBlobAuthenticated protocol
+func (c *disperserClient) cancelBlobAuthenticated(ctx context.Context, requestID []byte) error {
+	stream, err := c.client.CancelBlobAuthenticated(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = stream.CloseSend() }()
+
+	// Send the request
+	err = stream.Send(&disperser_rpc.AuthenticatedCancelRequest{Payload: &disperser_rpc.AuthenticatedCancelRequest_CancelRequest{
+		CancelRequest: &disperser_rpc.CancelBlobRequest{
+			RequestId: requestID,
+		},
+	}})
+	if err != nil {
+		return err
+	}
+
+	// Get the challenge from the disperser
+	reply, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	authHeaderReply, ok := reply.GetPayload().(*disperser_rpc.AuthenticatedCancelReply_BlobAuthHeader)
+	if !ok {
+		return errors.New("expected challenge from the disperser")
+	}
+
+	// Sign the challenge and send it back
+	signature, err := c.signer.SignBlobRequest(core.NewCancelBlobAuthHeader(c.signer.GetAccountID(), requestID, authHeaderReply.BlobAuthHeader.GetChallengeParameter(), nil))
+	if err != nil {
+		return fmt.Errorf("failed to sign the challenge: %w", err)
+	}
+	err = stream.Send(&disperser_rpc.AuthenticatedCancelRequest{Payload: &disperser_rpc.AuthenticatedCancelRequest_AuthenticationData{
+		AuthenticationData: &disperser_rpc.AuthenticationData{
+			AuthenticationData: signature,
+		},
+	}})
+	if err != nil {
+		return err
+	}
+
+	// Get the result of the cancellation
+	reply, err = stream.Recv()
+	if err != nil {
+		return err
+	}
+	if _, ok := reply.GetPayload().(*disperser_rpc.AuthenticatedCancelReply_CancelReply); !ok {
+		return errors.New("expected cancellation reply from the disperser")
+	}
+	return nil
+}
+
+func (c *disperserClient) GetBlobStatus(ctx context.Context, requestID []byte) (*disperser_rpc.BlobStatusReply, error) {
+	request := &disperser_rpc.BlobStatusRequest{
+		RequestId: requestID,
+	}
+
+	var reply *disperser_rpc.BlobStatusReply
+	err := c.retry(ctx, isTransientError, func(ctx context.Context) error {
+		var err error
+		reply, err = c.client.GetBlobStatus(ctx, request)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reply, nil
+}
+
+func (c *disperserClient) GetBlobStatuses(ctx context.Context, requestIDs [][]byte) ([]*disperser_rpc.BlobStatusResult, error) {
+	request := &disperser_rpc.BlobStatusesRequest{
+		RequestIds: requestIDs,
+	}
+
+	var reply *disperser_rpc.BlobStatusesReply
+	err := c.retry(ctx, isTransientError, func(ctx context.Context) error {
+		var err error
+		reply, err = c.client.GetBlobStatuses(ctx, request)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(reply.GetResults()) != len(requestIDs) {
+		return nil, fmt.Errorf("expected %d results, got %d", len(requestIDs), len(reply.GetResults()))
+	}
+
+	return reply.GetResults(), nil
+}
+
+func (c *disperserClient) WaitForBlobStatus(ctx context.Context, requestID []byte, blobStatus disperser.BlobStatus) (*disperser_rpc.BlobStatusReply, error) {
+	if blobStatus != disperser.Confirmed && blobStatus != disperser.Finalized {
+		return nil, fmt.Errorf("can only wait for a blob to be confirmed or finalized, but got status %v", blobStatus)
+	}
+
+	backoff := c.initialBackoff()
+	for {
+		reply, err := c.GetBlobStatus(ctx, requestID)
+		if err != nil {
+			return nil, err
+		}
+
+		switch reply.GetStatus() {
+		case disperser_rpc.BlobStatus_FINALIZED:
+			return reply, nil
+		case disperser_rpc.BlobStatus_CONFIRMED:
+			if blobStatus == disperser.Confirmed {
+				return reply, nil
+			}
+		case disperser_rpc.BlobStatus_FAILED:
+			if reason := reply.GetDetails().GetFailureReason(); reason != "" {
+				return nil, fmt.Errorf("%w: %s", ErrBlobFailed, reason)
+			}
+			return nil, ErrBlobFailed
+		case disperser_rpc.BlobStatus_INSUFFICIENT_SIGNATURES:
+			return nil, ErrBlobInsufficientSignatures
+		case disperser_rpc.BlobStatus_CANCELLED:
+			return nil, ErrBlobCancelled
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff = c.nextBackoff(backoff)
+	}
+}
+
+func (c *disperserClient) RetrieveBlob(ctx context.Context, batchHeaderHash []byte, blobIndex uint32) ([]byte, error) {
+	request := &disperser_rpc.RetrieveBlobRequest{
+		BatchHeaderHash: batchHeaderHash,
+		BlobIndex:       blobIndex,
+	}
+
+	var reply *disperser_rpc.RetrieveBlobReply
+	err := c.retry(ctx, isTransientError, func(ctx context.Context) error {
+		var err error
+		reply, err = c.client.RetrieveBlob(ctx, request)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reply.GetData(), nil
+}
+
+func (c *disperserClient) Close() error {
+	return c.conn.Close()
+}
+
+// notSentError wraps the errors of a dispersal that happen before the disperser can accept the blob
+type notSentError struct {
+	err error
+}
+
+func (e notSentError) Error() string {
+	return e.err.Error()
+}
+
+func (e notSentError) Unwrap() error {
+	return e.err
+}
+
+// isTransientError returns whether err is transient, so that an idempotent request that failed with it can be retried
+func isTransientError(err error) bool {
+	return errors.Is(err, ErrUnavailable) || errors.Is(err, ErrRateLimited)
+}
+
+// isRetryableDispersalError returns whether a dispersal that failed with err can be retried without dispersing the
+// blob twice, i.e. it was rate limited or it failed before the disperser could accept the blob
+func isRetryableDispersalError(err error) bool {
+	if errors.Is(err, ErrRateLimited) {
+		return true
+	}
+	var notSent notSentError
+	return errors.As(err, &notSent) && errors.Is(err, ErrUnavailable)
+}
+
+// retry calls fn with a per-request timeout until it succeeds, fails with an error that isRetryable
+// rejects, or runs out of retries. The returned error is converted by toClientError.
+func (c *disperserClient) retry(ctx context.Context, isRetryable func(err error) bool, fn func(ctx context.Context) error) error {
+	backoff := c.initialBackoff()
+	for attempt := 0; ; attempt++ {
+		requestCtx, cancel := context.WithTimeout(ctx, c.timeout())
+		err := fn(requestCtx)
+		cancel()
+		if err == nil {
+			return nil
+		}
+
+		// The caller gave up, so there's no point in retrying
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		err = toClientError(err)
+		if attempt >= c.config.MaxRetries || !isRetryable(err) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff = c.nextBackoff(backoff)
+	}
+}
+
+func (c *disperserClient) timeout() time.Duration {
+	if c.config.Timeout <= 0 {
+		return defaultTimeout
+	}
+	return c.config.Timeout
+}
+
+func (c *disperserClient) initialBackoff() time.Duration {
+	if c.config.InitialBackoff <= 0 {
+		return defaultInitialBackoff
+	}
+	return c.config.InitialBackoff
+}
+
+func (c *disperserClient) nextBackoff(backoff time.Duration) time.Duration {
+	maxBackoff := c.config.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = defaultMaxBackoff
+	}
+	backoff *= 2
+	if backoff > maxBackoff {
+		return maxBackoff
+	}
+	return backoff
+}
+
+// toClientError maps an error returned by the disperser to one of the errors defined in this package.
+// The disperser doesn't set status codes for most errors, so its error messages are checked as well.
+// Errors that can't be mapped are returned unchanged.
+func toClientError(err error) error {
+	var notSent notSentError
+	if errors.As(err, &notSent) {
+		return notSentError{toClientError(notSent.err)}
+	}
+
+	s, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	msg := s.Message()
+	switch {
+	case s.Code() == codes.Unavailable || s.Code() == codes.DeadlineExceeded:
+		return fmt.Errorf("%w: %s", ErrUnavailable, msg)
+	case s.Code() == codes.ResourceExhausted || strings.HasPrefix(msg, "request ratelimited"):
+		return fmt.Errorf("%w: %s", ErrRateLimited, msg)
+	case s.Code() == codes.NotFound || strings.Contains(msg, disperser.ErrBlobNotFound.Error()):
+		return fmt.Errorf("%w: %s", ErrBlobNotFound, msg)
+	case s.Code() == codes.InvalidArgument || strings.HasPrefix(msg, "invalid request") || strings.HasPrefix(msg, "blob size"):
+		return fmt.Errorf("%w: %s", ErrInvalidRequest, msg)
+	}
+	return err
+}
+
+func getSecurityParamsProto(securityParams []*core.SecurityParam) []*disperser_rpc.SecurityParams {
+	params := make([]*disperser_rpc.SecurityParams, len(securityParams))
+	for i, param := range securityParams {
+		params[i] = &disperser_rpc.SecurityParams{
+			QuorumId:           uint32(param.QuorumID),
+			AdversaryThreshold: uint32(param.AdversaryThreshold),
+			QuorumThreshold:    uint32(param.QuorumThreshold),
+		}
+	}
+	return params
+}
@@ -0,0 +1,98 @@
+package mock
+
+import (
+	"context"
+
+	disperser_rpc "github.com/Layr-Labs/eigenda/api/grpc/disperser"
+	"github.com/Layr-Labs/eigenda/clients"
+	"github.com/Layr-Labs/eigenda/core"
+	"github.com/Layr-Labs/eigenda/disperser"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockDisperserClient struct {
+	mock.Mock
+}
+
+var _ clients.DisperserClient = (*MockDisperserClient)(nil)
+
+func NewDisperserClient() *MockDisperserClient {
+	return &MockDisperserClient{}
+}
+
+func (c *MockDisperserClient) DisperseBlob(ctx context.Context, data []byte, securityParams []*core.SecurityParam) (*disperser.BlobStatus, []byte, error) {
+	args := c.Called(data, securityParams)
+	var status *disperser.BlobStatus
+	if args.Get(0) != nil {
+		status = args.Get(0).(*disperser.BlobStatus)
+	}
+	var requestID []byte
+	if args.Get(1) != nil {
+		requestID = args.Get(1).([]byte)
+	}
+	return status, requestID, args.Error(2)
+}
+
+func (c *MockDisperserClient) DisperseBlobAuthenticated(ctx context.Context, data []byte, securityParams []*core.SecurityParam) (*disperser.BlobStatus, []byte, error) {
+	args := c.Called(data, securityParams)
+	var status *disperser.BlobStatus
+	if args.Get(0) != nil {
+		status = args.Get(0).(*disperser.BlobStatus)
+	}
+	var requestID []byte
+	if args.Get(1) != nil {
+		requestID = args.Get(1).([]byte)
+	}
+	return status, requestID, args.Error(2)
+}
+
+func (c *MockDisperserClient) CancelBlob(ctx context.Context, requestID []byte) error {
+	args := c.Called(requestID)
+	return args.Error(0)
+}
+
+func (c *MockDisperserClient) CancelBlobAuthenticated(ctx context.Context, requestID []byte) error {
+	args := c.Called(requestID)
+	return args.Error(0)
+}
+
+func (c *MockDisperserClient) GetBlobStatuses(ctx context.Context, requestIDs [][]byte) ([]*disperser_rpc.BlobStatusResult, error) {
+	args := c.Called(requestIDs)
+	var results []*disperser_rpc.BlobStatusResult
+	if args.Get(0) != nil {
+		results = args.Get(0).([]*disperser_rpc.BlobStatusResult)
+	}
+	return results, args.Error(1)
+}
+
+func (c *MockDisperserClient) GetBlobStatus(ctx context.Context, requestID []byte) (*disperser_rpc.BlobStatusReply, error) {
+	args := c.Called(requestID)
+	var reply *disperser_rpc.BlobStatusReply
+	if args.Get(0) != nil {
+		reply = args.Get(0).(*disperser_rpc.BlobStatusReply)
+	}
+	return reply, args.Error(1)
+}
+
+func (c *MockDisperserClient) WaitForBlobStatus(ctx context.Context, requestID []byte, blobStatus disperser.BlobStatus) (*disperser_rpc.BlobStatusReply, error) {
+	args := c.Called(requestID, blobStatus)
+	var reply *disperser_rpc.BlobStatusReply
+	if args.Get(0) != nil {
+		reply = args.Get(0).(*disperser_rpc.BlobStatusReply)
+	}
+	return reply, args.Error(1)
+}
+
+func (c *MockDisperserClient) RetrieveBlob(ctx context.Context, batchHeaderHash []byte, blobIndex uint32) ([]byte, error) {
+	args := c.Called(batchHeaderHash, blobIndex)
+	var data []byte
+	if args.Get(0) != nil {
+		data = args.Get(0).([]byte)
+	}
+	return data, args.Error(1)
+}
+
+func (c *MockDisperserClient) Close() error {
+	args := c.Called()
+	return args.Error(0)
+}
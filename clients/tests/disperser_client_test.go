@@ -0,0 +1,409 @@
+package retriever_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	disperser_rpc "github.com/Layr-Labs/eigenda/api/grpc/disperser"
+	"github.com/Layr-Labs/eigenda/clients"
+	"github.com/Layr-Labs/eigenda/core"
+	"github.com/Layr-Labs/eigenda/core/auth"
+	"github.com/Layr-Labs/eigenda/disperser"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeDisperser is a disperser server that replays a scripted list of results per API
+type fakeDisperser struct {
+	disperser_rpc.UnimplementedDisperserServer
+
+	mu               sync.Mutex
+	disperseErrors   []error
+	statuses         []disperser_rpc.BlobStatus
+	statusErrors     []error
+	numDisperseCalls int
+	numStatusCalls   int
+	authenticator    core.BlobRequestAuthenticator
+	// cancelAccountID is the account that dispersed the blobs cancelled with CancelBlobAuthenticated
+	cancelAccountID string
+	// authErrors are returned by DisperseBlobAuthenticated before it sends the challenge
+	authErrors      []error
+	numAuthAttempts int
+}
+
+func (f *fakeDisperser) DisperseBlob(ctx context.Context, req *disperser_rpc.DisperseBlobRequest) (*disperser_rpc.DisperseBlobReply, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.numDisperseCalls++
+	if len(f.disperseErrors) > 0 {
+		err := f.disperseErrors[0]
+		f.disperseErrors = f.disperseErrors[1:]
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &disperser_rpc.DisperseBlobReply{
+		Result:    disperser_rpc.BlobStatus_PROCESSING,
+		RequestId: []byte(fmt.Sprintf("request-%d", len(req.GetData()))),
+	}, nil
+}
+
+func (f *fakeDisperser) DisperseBlobAuthenticated(stream disperser_rpc.Disperser_DisperseBlobAuthenticatedServer) error {
+	in, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	f.mu.Lock()
+	f.numAuthAttempts++
+	if len(f.authErrors) > 0 {
+		err := f.authErrors[0]
+		f.authErrors = f.authErrors[1:]
+		f.mu.Unlock()
+		return err
+	}
+	f.mu.Unlock()
+	request := in.GetDisperseRequest()
+
+	nonce := uint32(42)
+	err = stream.Send(&disperser_rpc.AuthenticatedReply{Payload: &disperser_rpc.AuthenticatedReply_BlobAuthHeader{
+		BlobAuthHeader: &disperser_rpc.BlobAuthHeader{ChallengeParameter: nonce},
+	}})
+	if err != nil {
+		return err
+	}
+
+	in, err = stream.Recv()
+	if err != nil {
+		return err
+	}
+	err = f.authenticator.AuthenticateBlobRequest(core.BlobAuthHeader{
+		AccountID:          request.GetAccountId(),
+		BlobHash:           crypto.Keccak256Hash(request.GetData()),
+		Nonce:              nonce,
+		AuthenticationData: in.GetAuthenticationData().GetAuthenticationData(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return stream.Send(&disperser_rpc.AuthenticatedReply{Payload: &disperser_rpc.AuthenticatedReply_DisperseReply{
+		DisperseReply: &disperser_rpc.DisperseBlobReply{
+			Result:    disperser_rpc.BlobStatus_PROCESSING,
+			RequestId: []byte(request.GetAccountId()),
+		},
+	}})
+}
+
+func (f *fakeDisperser) GetBlobStatus(ctx context.Context, req *disperser_rpc.BlobStatusRequest) (*disperser_rpc.BlobStatusReply, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.numStatusCalls++
+	if len(f.statusErrors) > 0 {
+		err := f.statusErrors[0]
+		f.statusErrors = f.statusErrors[1:]
+		return nil, err
+	}
+	if string(req.GetRequestId()) == "missing" {
+		return nil, errors.New("blob not found")
+	}
+	blobStatus := f.statuses[0]
+	if len(f.statuses) > 1 {
+		f.statuses = f.statuses[1:]
+	}
+	reply := &disperser_rpc.BlobStatusReply{Status: blobStatus}
+	if blobStatus == disperser_rpc.BlobStatus_FAILED {
+		reply.Details = &disperser_rpc.BlobStatusDetails{FailureReason: "error confirming batch"}
+	}
+	return reply, nil
+}
+
+func (f *fakeDisperser) GetBlobStatuses(ctx context.Context, req *disperser_rpc.BlobStatusesRequest) (*disperser_rpc.BlobStatusesReply, error) {
+	results := make([]*disperser_rpc.BlobStatusResult, len(req.GetRequestIds()))
+	for i, requestID := range req.GetRequestIds() {
+		if string(requestID) == "missing" {
+			results[i] = &disperser_rpc.BlobStatusResult{Error: "blob not found"}
+			continue
+		}
+		results[i] = &disperser_rpc.BlobStatusResult{Reply: &disperser_rpc.BlobStatusReply{
+			Status:  disperser_rpc.BlobStatus_PROCESSING,
+			Details: &disperser_rpc.BlobStatusDetails{NumRetries: uint32(i)},
+		}}
+	}
+	return &disperser_rpc.BlobStatusesReply{Results: results}, nil
+}
+
+func (f *fakeDisperser) CancelBlob(ctx context.Context, req *disperser_rpc.CancelBlobRequest) (*disperser_rpc.CancelBlobReply, error) {
+	if string(req.GetRequestId()) == "confirmed" {
+		return nil, errors.New("invalid request: blob is not processing")
+	}
+	return &disperser_rpc.CancelBlobReply{Status: disperser_rpc.BlobStatus_CANCELLED}, nil
+}
+
+func (f *fakeDisperser) CancelBlobAuthenticated(stream disperser_rpc.Disperser_CancelBlobAuthenticatedServer) error {
+	in, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	requestID := in.GetCancelRequest().GetRequestId()
+
+	nonce := uint32(42)
+	err = stream.Send(&disperser_rpc.AuthenticatedCancelReply{Payload: &disperser_rpc.AuthenticatedCancelReply_BlobAuthHeader{
+		BlobAuthHeader: &disperser_rpc.BlobAuthHeader{ChallengeParameter: nonce},
+	}})
+	if err != nil {
+		return err
+	}
+
+	in, err = stream.Recv()
+	if err != nil {
+		return err
+	}
+	err = f.authenticator.AuthenticateBlobRequest(core.NewCancelBlobAuthHeader(f.cancelAccountID, requestID, nonce, in.GetAuthenticationData().GetAuthenticationData()))
+	if err != nil {
+		return fmt.Errorf("permission denied: %w", err)
+	}
+
+	return stream.Send(&disperser_rpc.AuthenticatedCancelReply{Payload: &disperser_rpc.AuthenticatedCancelReply_CancelReply{
+		CancelReply: &disperser_rpc.CancelBlobReply{Status: disperser_rpc.BlobStatus_CANCELLED},
+	}})
+}
+
+func (f *fakeDisperser) RetrieveBlob(ctx context.Context, req *disperser_rpc.RetrieveBlobRequest) (*disperser_rpc.RetrieveBlobReply, error) {
+	return &disperser_rpc.RetrieveBlobReply{Data: req.GetBatchHeaderHash()}, nil
+}
+
+func newTestDisperserClient(t *testing.T, fake *fakeDisperser, signer core.BlobRequestSigner) clients.DisperserClient {
+	return newTestDisperserClientWithTimeout(t, fake, signer, time.Second)
+}
+
+func newTestDisperserClientWithTimeout(t *testing.T, fake *fakeDisperser, signer core.BlobRequestSigner, timeout time.Duration) clients.DisperserClient {
+	listener, err := net.Listen("tcp", "localhost:0")
+	assert.NoError(t, err)
+
+	server := grpc.NewServer()
+	disperser_rpc.RegisterDisperserServer(server, fake)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	host, port, err := net.SplitHostPort(listener.Addr().String())
+	assert.NoError(t, err)
+	config := clients.NewConfig(host, port, timeout, false)
+	config.InitialBackoff = 10 * time.Millisecond
+	config.MaxBackoff = 20 * time.Millisecond
+
+	client, err := clients.NewDisperserClient(config, signer)
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+var testSecurityParams = []*core.SecurityParam{
+	{
+		QuorumID:           0,
+		AdversaryThreshold: 50,
+		QuorumThreshold:    100,
+	},
+}
+
+func TestDisperserClientDisperseBlobWithRetries(t *testing.T) {
+	fake := &fakeDisperser{
+		disperseErrors: []error{
+			errors.New("request ratelimited: system limit"),
+			status.Error(codes.ResourceExhausted, "account limit"),
+		},
+	}
+	client := newTestDisperserClient(t, fake, nil)
+
+	blobStatus, requestID, err := client.DisperseBlob(context.Background(), []byte("hello"), testSecurityParams)
+	assert.NoError(t, err)
+	assert.Equal(t, disperser.Processing, *blobStatus)
+	assert.Equal(t, []byte("request-5"), requestID)
+	assert.Equal(t, 3, fake.numDisperseCalls)
+
+	// A dispersal that may have been accepted isn't retried, so the blob isn't dispersed twice
+	fake.disperseErrors = []error{status.Error(codes.Unavailable, "unavailable")}
+	fake.numDisperseCalls = 0
+	_, _, err = client.DisperseBlob(context.Background(), []byte("hello"), testSecurityParams)
+	assert.ErrorIs(t, err, clients.ErrUnavailable)
+	assert.Equal(t, 1, fake.numDisperseCalls)
+}
+
+func TestDisperserClientRetriesIdempotentRequests(t *testing.T) {
+	fake := &fakeDisperser{
+		statuses: []disperser_rpc.BlobStatus{disperser_rpc.BlobStatus_PROCESSING},
+		statusErrors: []error{
+			status.Error(codes.Unavailable, "unavailable"),
+			status.Error(codes.DeadlineExceeded, "deadline exceeded"),
+		},
+	}
+	client := newTestDisperserClient(t, fake, nil)
+
+	reply, err := client.GetBlobStatus(context.Background(), []byte("request"))
+	assert.NoError(t, err)
+	assert.Equal(t, disperser_rpc.BlobStatus_PROCESSING, reply.GetStatus())
+	assert.Equal(t, 3, fake.numStatusCalls)
+}
+
+func TestDisperserClientDefaultTimeout(t *testing.T) {
+	fake := &fakeDisperser{}
+	client := newTestDisperserClientWithTimeout(t, fake, nil, 0)
+
+	_, _, err := client.DisperseBlob(context.Background(), []byte("hello"), testSecurityParams)
+	assert.NoError(t, err)
+
+	_, err = clients.NewDisperserClient(clients.NewConfig("localhost", "32001", -time.Second, false), nil)
+	assert.Error(t, err)
+}
+
+func TestDisperserClientTypedErrors(t *testing.T) {
+	fake := &fakeDisperser{
+		disperseErrors: []error{
+			errors.New("invalid request: security_params must not be empty"),
+		},
+	}
+	client := newTestDisperserClient(t, fake, nil)
+
+	// Invalid requests aren't retried
+	_, _, err := client.DisperseBlob(context.Background(), []byte("hello"), nil)
+	assert.ErrorIs(t, err, clients.ErrInvalidRequest)
+	assert.Equal(t, 1, fake.numDisperseCalls)
+
+	_, err = client.GetBlobStatus(context.Background(), []byte("missing"))
+	assert.ErrorIs(t, err, clients.ErrBlobNotFound)
+
+	// Give up after the max number of retries
+	fake.disperseErrors = []error{
+		errors.New("request ratelimited: account limit"),
+		errors.New("request ratelimited: account limit"),
+		errors.New("request ratelimited: account limit"),
+		errors.New("request ratelimited: account limit"),
+	}
+	fake.numDisperseCalls = 0
+	_, _, err = client.DisperseBlob(context.Background(), []byte("hello"), testSecurityParams)
+	assert.ErrorIs(t, err, clients.ErrRateLimited)
+	assert.Equal(t, 4, fake.numDisperseCalls)
+}
+
+func TestDisperserClientWaitForBlobStatus(t *testing.T) {
+	fake := &fakeDisperser{
+		statuses: []disperser_rpc.BlobStatus{
+			disperser_rpc.BlobStatus_PROCESSING,
+			disperser_rpc.BlobStatus_PROCESSING,
+			disperser_rpc.BlobStatus_CONFIRMED,
+			disperser_rpc.BlobStatus_FINALIZED,
+		},
+	}
+	client := newTestDisperserClient(t, fake, nil)
+
+	reply, err := client.WaitForBlobStatus(context.Background(), []byte("request"), disperser.Confirmed)
+	assert.NoError(t, err)
+	assert.Equal(t, disperser_rpc.BlobStatus_CONFIRMED, reply.GetStatus())
+	assert.Equal(t, 3, fake.numStatusCalls)
+
+	reply, err = client.WaitForBlobStatus(context.Background(), []byte("request"), disperser.Finalized)
+	assert.NoError(t, err)
+	assert.Equal(t, disperser_rpc.BlobStatus_FINALIZED, reply.GetStatus())
+
+	fake.statuses = []disperser_rpc.BlobStatus{disperser_rpc.BlobStatus_INSUFFICIENT_SIGNATURES}
+	_, err = client.WaitForBlobStatus(context.Background(), []byte("request"), disperser.Confirmed)
+	assert.ErrorIs(t, err, clients.ErrBlobInsufficientSignatures)
+
+	fake.statuses = []disperser_rpc.BlobStatus{disperser_rpc.BlobStatus_FAILED}
+	_, err = client.WaitForBlobStatus(context.Background(), []byte("request"), disperser.Confirmed)
+	assert.ErrorIs(t, err, clients.ErrBlobFailed)
+	assert.ErrorContains(t, err, "error confirming batch")
+
+	fake.statuses = []disperser_rpc.BlobStatus{disperser_rpc.BlobStatus_CANCELLED}
+	_, err = client.WaitForBlobStatus(context.Background(), []byte("request"), disperser.Confirmed)
+	assert.ErrorIs(t, err, clients.ErrBlobCancelled)
+
+	// Stop waiting once the deadline is reached
+	fake.statuses = []disperser_rpc.BlobStatus{disperser_rpc.BlobStatus_PROCESSING}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = client.WaitForBlobStatus(ctx, []byte("request"), disperser.Confirmed)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestDisperserClientGetBlobStatuses(t *testing.T) {
+	client := newTestDisperserClient(t, &fakeDisperser{}, nil)
+
+	results, err := client.GetBlobStatuses(context.Background(), [][]byte{[]byte("request-1"), []byte("missing"), []byte("request-2")})
+	assert.NoError(t, err)
+	assert.Len(t, results, 3)
+	assert.Equal(t, disperser_rpc.BlobStatus_PROCESSING, results[0].GetReply().GetStatus())
+	assert.Equal(t, "blob not found", results[1].GetError())
+	assert.Nil(t, results[1].GetReply())
+	assert.Equal(t, uint32(2), results[2].GetReply().GetDetails().GetNumRetries())
+}
+
+func TestDisperserClientDisperseBlobAuthenticated(t *testing.T) {
+	signer, err := auth.NewSigner("fcdfdf11f9d6f1d41deb7fdc4fd4c3b1c4c8b2e0b5cc0a1bb8fed5a8a91a6fd6")
+	assert.NoError(t, err)
+	fake := &fakeDisperser{authenticator: auth.NewAuthenticator()}
+	client := newTestDisperserClient(t, fake, signer)
+
+	blobStatus, requestID, err := client.DisperseBlobAuthenticated(context.Background(), []byte("hello"), testSecurityParams)
+	assert.NoError(t, err)
+	assert.Equal(t, disperser.Processing, *blobStatus)
+	assert.Equal(t, []byte(signer.GetAccountID()), requestID)
+
+	// Transient errors before the challenge is signed are retried, as the blob can't have been accepted yet
+	fake.authErrors = []error{status.Error(codes.Unavailable, "unavailable")}
+	fake.numAuthAttempts = 0
+	_, _, err = client.DisperseBlobAuthenticated(context.Background(), []byte("hello"), testSecurityParams)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, fake.numAuthAttempts)
+
+	// A signer is required
+	client = newTestDisperserClient(t, fake, nil)
+	_, _, err = client.DisperseBlobAuthenticated(context.Background(), []byte("hello"), testSecurityParams)
+	assert.Error(t, err)
+}
+
+func TestDisperserClientRetrieveBlob(t *testing.T) {
+	client := newTestDisperserClient(t, &fakeDisperser{}, nil)
+
+	data, err := client.RetrieveBlob(context.Background(), []byte("data"), 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("data"), data)
+}
+
+func TestDisperserClientCancelBlob(t *testing.T) {
+	signer, err := auth.NewSigner("fcdfdf11f9d6f1d41deb7fdc4fd4c3b1c4c8b2e0b5cc0a1bb8fed5a8a91a6fd6")
+	assert.NoError(t, err)
+	fake := &fakeDisperser{authenticator: auth.NewAuthenticator(), cancelAccountID: signer.GetAccountID()}
+
+	// Without a signer, the cancellation is authorized by the client address
+	client := newTestDisperserClient(t, fake, nil)
+	err = client.CancelBlob(context.Background(), []byte("request"))
+	assert.NoError(t, err)
+
+	err = client.CancelBlob(context.Background(), []byte("confirmed"))
+	assert.ErrorIs(t, err, clients.ErrInvalidRequest)
+
+	// Authenticated cancellations require a signer
+	err = client.CancelBlobAuthenticated(context.Background(), []byte("request"))
+	assert.Error(t, err)
+
+	client = newTestDisperserClient(t, fake, signer)
+	err = client.CancelBlobAuthenticated(context.Background(), []byte("request"))
+	assert.NoError(t, err)
+
+	// The signature must come from the account that dispersed the blob
+	otherSigner, err := auth.NewSigner("0b2d9f1a9f7a5c4a6d1e4b7c9a8e3f2d1c0b9a8f7e6d5c4b3a291807f6e5d4c3")
+	assert.NoError(t, err)
+	client = newTestDisperserClient(t, fake, otherSigner)
+	err = client.CancelBlobAuthenticated(context.Background(), []byte("request"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,212 @@
+package verifier
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	disperser_rpc "github.com/Layr-Labs/eigenda/api/grpc/disperser"
+	binding "github.com/Layr-Labs/eigenda/contracts/bindings/EigenDAServiceManager"
+	"github.com/Layr-Labs/eigenda/core"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	ErrBatchMetadataMismatch     = errors.New("batchMetadata does not match stored metadata")
+	ErrInvalidInclusionProof     = errors.New("inclusion proof is invalid")
+	ErrQuorumNumberMismatch      = errors.New("quorumNumber does not match")
+	ErrInvalidAdversaryThreshold = errors.New("adversaryThresholdPercentage is not valid")
+	ErrQuorumThresholdNotMet     = errors.New("quorumThresholdPercentage is not met")
+	ErrInvalidVerificationData   = errors.New("invalid verification data")
+)
+
+// FeeLength is the length in bytes of the uint96 fee field of the onchain BatchMetadata
+const FeeLength = 12
+
+// BatchMetadataHashGetter returns the hash of the metadata of a confirmed batch as stored onchain.
+// It's satisfied by the EigenDAServiceManager contract bindings.
+type BatchMetadataHashGetter interface {
+	BatchIdToBatchMetadataHash(opts *bind.CallOpts, batchId uint32) ([32]byte, error)
+}
+
+// BlobVerifier performs the same checks as EigenDABlobUtils.verifyBlob without submitting a transaction
+type BlobVerifier struct {
+	serviceManager BatchMetadataHashGetter
+}
+
+func NewBlobVerifier(serviceManager BatchMetadataHashGetter) *BlobVerifier {
+	return &BlobVerifier{
+		serviceManager: serviceManager,
+	}
+}
+
+// NewBlobVerifierFromAddress creates a BlobVerifier that reads batch metadata hashes from the EigenDAServiceManager deployed at the given address
+func NewBlobVerifierFromAddress(eigenDAServiceManagerAddr gethcommon.Address, client bind.ContractCaller) (*BlobVerifier, error) {
+	serviceManager, err := binding.NewContractEigenDAServiceManagerCaller(eigenDAServiceManagerAddr, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind EigenDAServiceManager: %w", err)
+	}
+	return NewBlobVerifier(serviceManager), nil
+}
+
+// VerifyBlob verifies that the blob was included in a batch confirmed onchain and that the batch met the blob's security parameters.
+// The checks are performed in the same order as EigenDABlobUtils.verifyBlob.
+func (v *BlobVerifier) VerifyBlob(ctx context.Context, blobHeader *disperser_rpc.BlobHeader, proof *disperser_rpc.BlobVerificationProof) error {
+	if blobHeader == nil || proof == nil || proof.GetBatchMetadata() == nil || proof.GetBatchMetadata().GetBatchHeader() == nil {
+		return fmt.Errorf("%w: blob header, verification proof, batch metadata and batch header are required", ErrInvalidVerificationData)
+	}
+
+	// Check the batch metadata against the hash stored onchain
+	batchMetadataHash, err := HashBatchMetadata(proof.GetBatchMetadata())
+	if err != nil {
+		return err
+	}
+	storedHash, err := v.serviceManager.BatchIdToBatchMetadataHash(&bind.CallOpts{Context: ctx}, proof.GetBatchId())
+	if err != nil {
+		return fmt.Errorf("failed to get batch metadata hash for batch %d: %w", proof.GetBatchId(), err)
+	}
+	if batchMetadataHash != storedHash {
+		return ErrBatchMetadataMismatch
+	}
+
+	// Check the inclusion of the blob header in the batch
+	blobHeaderHash, err := HashBlobHeader(blobHeader)
+	if err != nil {
+		return err
+	}
+	var batchRoot [32]byte
+	copy(batchRoot[:], proof.GetBatchMetadata().GetBatchHeader().GetBatchRoot())
+	leaf := crypto.Keccak256Hash(blobHeaderHash[:])
+	verified, err := verifyInclusionKeccak(proof.GetInclusionProof(), batchRoot, leaf, uint64(proof.GetBlobIndex()))
+	if err != nil {
+		return err
+	}
+	if !verified {
+		return ErrInvalidInclusionProof
+	}
+
+	// Check that the security params of each quorum are met
+	return verifySecurityParams(blobHeader.GetBlobQuorumParams(), proof.GetBatchMetadata().GetBatchHeader(), proof.GetQuorumIndexes())
+}
+
+// HashBatchMetadata computes the hash of the batch metadata the same way as EigenDAHasher.hashBatchMetadata
+func HashBatchMetadata(batchMetadata *disperser_rpc.BatchMetadata) ([32]byte, error) {
+	batchHeader := batchMetadata.GetBatchHeader()
+	var batchRoot [32]byte
+	copy(batchRoot[:], batchHeader.GetBatchRoot())
+	batchHeaderHash, err := core.HashBatchHeader(binding.IEigenDAServiceManagerBatchHeader{
+		BlobHeadersRoot:            batchRoot,
+		QuorumNumbers:              batchHeader.GetQuorumNumbers(),
+		QuorumThresholdPercentages: batchHeader.GetQuorumSignedPercentages(),
+		ReferenceBlockNumber:       batchHeader.GetReferenceBlockNumber(),
+	})
+	if err != nil {
+		return [32]byte{}, fmt.Errorf("failed to hash batch header: %w", err)
+	}
+
+	if len(batchMetadata.GetSignatoryRecordHash()) != 32 {
+		return [32]byte{}, fmt.Errorf("%w: signatory record hash must be 32 bytes, got %d", ErrInvalidVerificationData, len(batchMetadata.GetSignatoryRecordHash()))
+	}
+	fee := new(big.Int).SetBytes(batchMetadata.GetFee())
+	if len(fee.Bytes()) > FeeLength {
+		return [32]byte{}, fmt.Errorf("%w: fee does not fit in uint96", ErrInvalidVerificationData)
+	}
+	confirmationBlockNumber := make([]byte, 4)
+	binary.BigEndian.PutUint32(confirmationBlockNumber, batchMetadata.GetConfirmationBlockNumber())
+
+	// abi.encodePacked(batchHeaderHash, signatoryRecordHash, uint96 fee, uint32 confirmationBlockNumber)
+	return crypto.Keccak256Hash(
+		batchHeaderHash[:],
+		batchMetadata.GetSignatoryRecordHash(),
+		fee.FillBytes(make([]byte, FeeLength)),
+		confirmationBlockNumber,
+	), nil
+}
+
+// HashBlobHeader computes the hash of the blob header the same way as EigenDAHasher.hashBlobHeader
+func HashBlobHeader(blobHeader *disperser_rpc.BlobHeader) ([32]byte, error) {
+	commitment, err := new(core.Commitment).Deserialize(blobHeader.GetCommitment())
+	if err != nil {
+		return [32]byte{}, fmt.Errorf("%w: failed to deserialize commitment: %v", ErrInvalidVerificationData, err)
+	}
+
+	quorumInfos := make([]*core.BlobQuorumInfo, len(blobHeader.GetBlobQuorumParams()))
+	for i, param := range blobHeader.GetBlobQuorumParams() {
+		quorumInfos[i] = &core.BlobQuorumInfo{
+			SecurityParam: core.SecurityParam{
+				QuorumID:           core.QuorumID(param.GetQuorumNumber()),
+				AdversaryThreshold: uint8(param.GetAdversaryThresholdPercentage()),
+				QuorumThreshold:    uint8(param.GetQuorumThresholdPercentage()),
+			},
+			QuantizationFactor: uint(param.GetQuantizationParam()),
+		}
+	}
+
+	header := core.BlobHeader{
+		BlobCommitments: core.BlobCommitments{
+			Commitment: commitment,
+			Length:     uint(blobHeader.GetDataLength()),
+		},
+		QuorumInfos: quorumInfos,
+	}
+	hash, err := header.GetBlobHeaderHash()
+	if err != nil {
+		return [32]byte{}, fmt.Errorf("failed to hash blob header: %w", err)
+	}
+	return hash, nil
+}
+
+// verifyInclusionKeccak mirrors Merkle.verifyInclusionKeccak: the proof is the concatenation of the sibling hashes from the leaf up to the root
+func verifyInclusionKeccak(proof []byte, root [32]byte, leaf [32]byte, index uint64) (bool, error) {
+	if len(proof)%32 != 0 {
+		return false, fmt.Errorf("%w: proof length should be a multiple of 32", ErrInvalidInclusionProof)
+	}
+
+	computedHash := leaf
+	for i := 0; i < len(proof); i += 32 {
+		if index%2 == 0 {
+			computedHash = crypto.Keccak256Hash(computedHash[:], proof[i:i+32])
+		} else {
+			computedHash = crypto.Keccak256Hash(proof[i:i+32], computedHash[:])
+		}
+		index = index / 2
+	}
+
+	return computedHash == root, nil
+}
+
+func verifySecurityParams(params []*disperser_rpc.BlobQuorumParam, batchHeader *disperser_rpc.BatchHeader, quorumIndexes []byte) error {
+	quorumNumbers := batchHeader.GetQuorumNumbers()
+	signedPercentages := batchHeader.GetQuorumSignedPercentages()
+	if len(quorumIndexes) < len(params) {
+		return fmt.Errorf("%w: expected %d quorum indexes, got %d", ErrInvalidVerificationData, len(params), len(quorumIndexes))
+	}
+
+	for i, param := range params {
+		quorumIndex := int(quorumIndexes[i])
+		if quorumIndex >= len(quorumNumbers) || quorumIndex >= len(signedPercentages) {
+			return fmt.Errorf("%w: quorum index %d is out of range", ErrInvalidVerificationData, quorumIndex)
+		}
+
+		// make sure that the quorumIndex matches the given quorumNumber
+		if uint32(quorumNumbers[quorumIndex]) != param.GetQuorumNumber() {
+			return fmt.Errorf("%w: expected quorum %d at index %d, got %d", ErrQuorumNumberMismatch, param.GetQuorumNumber(), quorumIndex, quorumNumbers[quorumIndex])
+		}
+
+		// make sure that the adversaryThresholdPercentage is less than the given quorumThresholdPercentage
+		if param.GetAdversaryThresholdPercentage() >= param.GetQuorumThresholdPercentage() {
+			return fmt.Errorf("%w: quorum %d", ErrInvalidAdversaryThreshold, param.GetQuorumNumber())
+		}
+
+		// make sure that the stake signed for is greater than the given quorumThresholdPercentage
+		if uint32(signedPercentages[quorumIndex]) < param.GetQuorumThresholdPercentage() {
+			return fmt.Errorf("%w: quorum %d signed %d%%, required %d%%", ErrQuorumThresholdNotMet, param.GetQuorumNumber(), signedPercentages[quorumIndex], param.GetQuorumThresholdPercentage())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package verifier_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	disperser_rpc "github.com/Layr-Labs/eigenda/api/grpc/disperser"
+	"github.com/Layr-Labs/eigenda/clients/verifier"
+	"github.com/Layr-Labs/eigenda/core"
+	kzgbn254 "github.com/Layr-Labs/eigenda/pkg/kzg/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockServiceManager struct {
+	batchMetadataHashes map[uint32][32]byte
+}
+
+func (m *mockServiceManager) BatchIdToBatchMetadataHash(opts *bind.CallOpts, batchId uint32) ([32]byte, error) {
+	hash, ok := m.batchMetadataHashes[batchId]
+	if !ok {
+		return [32]byte{}, errors.New("batch not found")
+	}
+	return hash, nil
+}
+
+func makeBlobHeader(t *testing.T, x int64, length uint) (*core.BlobHeader, *disperser_rpc.BlobHeader) {
+	var commitX, commitY fp.Element
+	commitX.SetBigInt(big.NewInt(x))
+	commitY.SetBigInt(big.NewInt(x + 1))
+	commitment := &core.Commitment{
+		G1Point: &kzgbn254.G1Point{X: commitX, Y: commitY},
+	}
+	header := &core.BlobHeader{
+		BlobCommitments: core.BlobCommitments{
+			Commitment: commitment,
+			Length:     length,
+		},
+		QuorumInfos: []*core.BlobQuorumInfo{
+			{
+				SecurityParam: core.SecurityParam{
+					QuorumID:           0,
+					AdversaryThreshold: 50,
+					QuorumThreshold:    80,
+				},
+				QuantizationFactor: 1,
+			},
+			{
+				SecurityParam: core.SecurityParam{
+					QuorumID:           1,
+					AdversaryThreshold: 33,
+					QuorumThreshold:    67,
+				},
+				QuantizationFactor: 1,
+			},
+		},
+	}
+
+	commitmentBytes, err := commitment.Serialize()
+	assert.NoError(t, err)
+	quorumParams := make([]*disperser_rpc.BlobQuorumParam, len(header.QuorumInfos))
+	for i, info := range header.QuorumInfos {
+		quorumParams[i] = &disperser_rpc.BlobQuorumParam{
+			QuorumNumber:                 uint32(info.QuorumID),
+			AdversaryThresholdPercentage: uint32(info.AdversaryThreshold),
+			QuorumThresholdPercentage:    uint32(info.QuorumThreshold),
+			QuantizationParam:            uint32(info.QuantizationFactor),
+		}
+	}
+	return header, &disperser_rpc.BlobHeader{
+		Commitment:       commitmentBytes,
+		DataLength:       uint32(length),
+		BlobQuorumParams: quorumParams,
+	}
+}
+
+// makeBatch returns the blob headers of a batch of numBlobs blobs and their verification proofs
+func makeBatch(t *testing.T, numBlobs int) ([]*disperser_rpc.BlobHeader, []*disperser_rpc.BlobVerificationProof, *mockServiceManager) {
+	blobHeaders := make([]*core.BlobHeader, numBlobs)
+	protoHeaders := make([]*disperser_rpc.BlobHeader, numBlobs)
+	for i := 0; i < numBlobs; i++ {
+		blobHeaders[i], protoHeaders[i] = makeBlobHeader(t, int64(2*i+1), uint(100+i))
+	}
+
+	batchHeader := &core.BatchHeader{ReferenceBlockNumber: 10}
+	tree, err := batchHeader.SetBatchRoot(blobHeaders)
+	assert.NoError(t, err)
+
+	batchMetadata := &disperser_rpc.BatchMetadata{
+		BatchHeader: &disperser_rpc.BatchHeader{
+			BatchRoot:               batchHeader.BatchRoot[:],
+			QuorumNumbers:           []byte{0, 1},
+			QuorumSignedPercentages: []byte{90, 70},
+			ReferenceBlockNumber:    10,
+		},
+		SignatoryRecordHash:     make([]byte, 32),
+		Fee:                     []byte{0},
+		ConfirmationBlockNumber: 20,
+	}
+	batchMetadataHash, err := verifier.HashBatchMetadata(batchMetadata)
+	assert.NoError(t, err)
+
+	proofs := make([]*disperser_rpc.BlobVerificationProof, numBlobs)
+	for i, header := range blobHeaders {
+		blobHeaderHash, err := header.GetBlobHeaderHash()
+		assert.NoError(t, err)
+		merkleProof, err := tree.GenerateProof(blobHeaderHash[:], 0)
+		assert.NoError(t, err)
+		inclusionProof := make([]byte, 0)
+		for _, hash := range merkleProof.Hashes {
+			inclusionProof = append(inclusionProof, hash...)
+		}
+		proofs[i] = &disperser_rpc.BlobVerificationProof{
+			BatchId:        7,
+			BlobIndex:      uint32(i),
+			BatchMetadata:  batchMetadata,
+			InclusionProof: inclusionProof,
+			QuorumIndexes:  []byte{0, 1},
+		}
+	}
+
+	return protoHeaders, proofs, &mockServiceManager{
+		batchMetadataHashes: map[uint32][32]byte{7: batchMetadataHash},
+	}
+}
+
+func TestVerifyBlob(t *testing.T) {
+	for _, numBlobs := range []int{1, 2, 5} {
+		blobHeaders, proofs, serviceManager := makeBatch(t, numBlobs)
+		v := verifier.NewBlobVerifier(serviceManager)
+		for i := range blobHeaders {
+			assert.NoError(t, v.VerifyBlob(context.Background(), blobHeaders[i], proofs[i]))
+		}
+	}
+}
+
+func TestVerifyBlobWithInvalidBatchMetadata(t *testing.T) {
+	blobHeaders, proofs, serviceManager := makeBatch(t, 2)
+	v := verifier.NewBlobVerifier(serviceManager)
+
+	proofs[0].BatchMetadata.ConfirmationBlockNumber = 21
+	err := v.VerifyBlob(context.Background(), blobHeaders[0], proofs[0])
+	assert.ErrorIs(t, err, verifier.ErrBatchMetadataMismatch)
+
+	proofs[0].BatchMetadata.ConfirmationBlockNumber = 20
+	proofs[0].BatchId = 8
+	err = v.VerifyBlob(context.Background(), blobHeaders[0], proofs[0])
+	assert.ErrorContains(t, err, "batch not found")
+
+	proofs[0].BatchId = 7
+	proofs[0].BatchMetadata.Fee = make([]byte, 13)
+	proofs[0].BatchMetadata.Fee[0] = 1
+	err = v.VerifyBlob(context.Background(), blobHeaders[0], proofs[0])
+	assert.ErrorIs(t, err, verifier.ErrInvalidVerificationData)
+}
+
+func TestVerifyBlobWithInvalidInclusionProof(t *testing.T) {
+	blobHeaders, proofs, serviceManager := makeBatch(t, 4)
+	v := verifier.NewBlobVerifier(serviceManager)
+
+	// Proof for a different blob
+	err := v.VerifyBlob(context.Background(), blobHeaders[0], proofs[1])
+	assert.ErrorIs(t, err, verifier.ErrInvalidInclusionProof)
+
+	// Blob header that doesn't match the one in the batch
+	blobHeaders[2].DataLength++
+	err = v.VerifyBlob(context.Background(), blobHeaders[2], proofs[2])
+	assert.ErrorIs(t, err, verifier.ErrInvalidInclusionProof)
+
+	proofs[3].InclusionProof = proofs[3].InclusionProof[1:]
+	err = v.VerifyBlob(context.Background(), blobHeaders[3], proofs[3])
+	assert.ErrorIs(t, err, verifier.ErrInvalidInclusionProof)
+}
+
+func TestVerifyBlobWithInvalidSecurityParams(t *testing.T) {
+	blobHeaders, proofs, serviceManager := makeBatch(t, 1)
+	v := verifier.NewBlobVerifier(serviceManager)
+
+	proofs[0].QuorumIndexes = []byte{1, 0}
+	err := v.VerifyBlob(context.Background(), blobHeaders[0], proofs[0])
+	assert.ErrorIs(t, err, verifier.ErrQuorumNumberMismatch)
+
+	proofs[0].QuorumIndexes = []byte{0, 2}
+	err = v.VerifyBlob(context.Background(), blobHeaders[0], proofs[0])
+	assert.ErrorIs(t, err, verifier.ErrInvalidVerificationData)
+
+	// The batch metadata is committed onchain, so the batch has to be rebuilt with a lower signed percentage
+	blobHeaders, proofs, serviceManager = makeBatch(t, 1)
+	proofs[0].BatchMetadata.BatchHeader.QuorumSignedPercentages = []byte{90, 60}
+	batchMetadataHash, err := verifier.HashBatchMetadata(proofs[0].BatchMetadata)
+	assert.NoError(t, err)
+	serviceManager.batchMetadataHashes[7] = batchMetadataHash
+	err = verifier.NewBlobVerifier(serviceManager).VerifyBlob(context.Background(), blobHeaders[0], proofs[0])
+	assert.ErrorIs(t, err, verifier.ErrQuorumThresholdNotMet)
+}
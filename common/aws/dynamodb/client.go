@@ -2,9 +2,11 @@ package dynamodb
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"sync"
+	"time"
 
 	"github.com/Layr-Labs/eigenda/common"
 	commonaws "github.com/Layr-Labs/eigenda/common/aws"
@@ -19,6 +21,14 @@ import (
 const (
 	// dynamoBatchLimit is the maximum number of items that can be written in a single batch
 	dynamoBatchLimit = 25
+	// dynamoBatchReadLimit is the maximum number of items that can be read in a single batch
+	dynamoBatchReadLimit = 100
+	// maxBatchReadAttempts is the maximum number of requests made to read a single batch, as DynamoDB leaves keys
+	// unprocessed when the table is throttled
+	maxBatchReadAttempts = 8
+	// batchReadBaseBackoff is the delay before the unprocessed keys of a batch are read again for the first time.
+	// It doubles with each attempt.
+	batchReadBaseBackoff = 50 * time.Millisecond
 )
 
 type batchOperation uint
@@ -31,12 +41,22 @@ const (
 var (
 	once      sync.Once
 	clientRef *Client
+
+	// ErrConditionFailed is returned when the condition of a conditional write doesn't hold
+	ErrConditionFailed = errors.New("condition failed")
 )
 
 type Item = map[string]types.AttributeValue
 type Key = map[string]types.AttributeValue
 type ExpresseionValues = map[string]types.AttributeValue
 
+// QueryResult is a single page of query results
+type QueryResult struct {
+	Items []Item
+	// LastEvaluatedKey is the key to start the next page from. It's nil if there are no more items.
+	LastEvaluatedKey Key
+}
+
 type Client struct {
 	dynamoClient *dynamodb.Client
 	logger       common.Logger
@@ -137,6 +157,94 @@ func (c *Client) UpdateItem(ctx context.Context, tableName string, key Key, item
 	return resp.Attributes, err
 }
 
+// UpdateItemWithCondition updates the item only if the condition holds for the existing item.
+// It returns ErrConditionFailed if the condition doesn't hold.
+func (c *Client) UpdateItemWithCondition(ctx context.Context, tableName string, key Key, item Item, condition expression.ConditionBuilder) (Item, error) {
+	return c.UpdateItemWithConditionAndRemove(ctx, tableName, key, item, nil, condition)
+}
+
+// UpdateItemWithConditionAndRemove updates the item and removes the given attributes from it only if the condition
+// holds for the existing item.
+// It returns ErrConditionFailed if the condition doesn't hold.
+func (c *Client) UpdateItemWithConditionAndRemove(ctx context.Context, tableName string, key Key, item Item, removedAttributes []string, condition expression.ConditionBuilder) (Item, error) {
+	update := expression.UpdateBuilder{}
+	for itemKey, itemValue := range item {
+		if _, ok := key[itemKey]; ok {
+			// Cannot update the key
+			continue
+		}
+		update = update.Set(expression.Name(itemKey), expression.Value(itemValue))
+	}
+	for _, attribute := range removedAttributes {
+		if _, ok := key[attribute]; ok {
+			// Cannot remove the key
+			continue
+		}
+		update = update.Remove(expression.Name(attribute))
+	}
+
+	return c.updateItem(ctx, tableName, key, update, &condition)
+}
+
+// UpdateItemAndAppend updates the item and appends the elements of the lists in appended to the list attributes of
+// the same name, creating the lists that don't exist yet, in a single update. This doesn't lose the elements appended
+// by concurrent updates, as the existing lists aren't read first.
+// If condition isn't nil, the update is only made if the condition holds for the existing item, and ErrConditionFailed
+// is returned if it doesn't.
+func (c *Client) UpdateItemAndAppend(ctx context.Context, tableName string, key Key, item Item, appended Item, condition *expression.ConditionBuilder) (Item, error) {
+	update := expression.UpdateBuilder{}
+	for itemKey, itemValue := range item {
+		if _, ok := key[itemKey]; ok {
+			// Cannot update the key
+			continue
+		}
+		update = update.Set(expression.Name(itemKey), expression.Value(itemValue))
+	}
+	emptyList := &types.AttributeValueMemberL{Value: []types.AttributeValue{}}
+	for itemKey, itemValue := range appended {
+		if _, ok := key[itemKey]; ok {
+			// Cannot update the key
+			continue
+		}
+		name := expression.Name(itemKey)
+		update = update.Set(name, expression.ListAppend(expression.IfNotExists(name, expression.Value(emptyList)), expression.Value(itemValue)))
+	}
+
+	return c.updateItem(ctx, tableName, key, update, condition)
+}
+
+// updateItem applies the update to the item if the condition is nil or holds for the existing item
+func (c *Client) updateItem(ctx context.Context, tableName string, key Key, update expression.UpdateBuilder, condition *expression.ConditionBuilder) (Item, error) {
+	builder := expression.NewBuilder().WithUpdate(update)
+	if condition != nil {
+		builder = builder.WithCondition(*condition)
+	}
+	expr, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.dynamoClient.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:                 aws.String(tableName),
+		Key:                       key,
+		ConditionExpression:       expr.Condition(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		UpdateExpression:          expr.Update(),
+		ReturnValues:              types.ReturnValueUpdatedNew,
+	})
+
+	var conditionFailed *types.ConditionalCheckFailedException
+	if errors.As(err, &conditionFailed) {
+		return nil, ErrConditionFailed
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Attributes, err
+}
+
 func (c *Client) GetItem(ctx context.Context, tableName string, key Key) (Item, error) {
 	resp, err := c.dynamoClient.GetItem(ctx, &dynamodb.GetItemInput{Key: key, TableName: aws.String(tableName)})
 	if err != nil {
@@ -146,19 +254,108 @@ func (c *Client) GetItem(ctx context.Context, tableName string, key Key) (Item,
 	return resp.Item, nil
 }
 
+// GetItems reads the items with the given keys in batches of 100 keys (which is a limit DynamoDB imposes)
+// The keys that DynamoDB leaves unprocessed are read again with exponential backoff, and an error is returned if some
+// of them are still unprocessed after maxBatchReadAttempts requests.
+// The items that don't exist are left out, and the order of the items isn't guaranteed to match the order of the keys.
+func (c *Client) GetItems(ctx context.Context, tableName string, keys []Key) ([]Item, error) {
+	items := make([]Item, 0, len(keys))
+	for startIndex := 0; startIndex < len(keys); startIndex += dynamoBatchReadLimit {
+		endIndex := startIndex + dynamoBatchReadLimit
+		if endIndex > len(keys) {
+			endIndex = len(keys)
+		}
+		requestItems := map[string]types.KeysAndAttributes{
+			tableName: {Keys: keys[startIndex:endIndex]},
+		}
+		backoff := batchReadBaseBackoff
+		for attempt := 1; len(requestItems) > 0; attempt++ {
+			if attempt > 1 {
+				if attempt > maxBatchReadAttempts {
+					return nil, fmt.Errorf("%d keys are still unprocessed after %d attempts", len(requestItems[tableName].Keys), maxBatchReadAttempts)
+				}
+				select {
+				case <-time.After(backoff):
+				case <-ctx.Done():
+					return nil, ctx.Err()
+				}
+				backoff *= 2
+			}
+
+			output, err := c.dynamoClient.BatchGetItem(ctx, &dynamodb.BatchGetItemInput{RequestItems: requestItems})
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, output.Responses[tableName]...)
+			requestItems = output.UnprocessedKeys
+		}
+	}
+
+	return items, nil
+}
+
+// Query returns all items in the table that match the given key
+func (c *Client) Query(ctx context.Context, tableName string, keyCondition string, expAttributeValues ExpresseionValues) ([]Item, error) {
+	return c.queryAll(ctx, &dynamodb.QueryInput{
+		TableName:                 aws.String(tableName),
+		KeyConditionExpression:    aws.String(keyCondition),
+		ExpressionAttributeValues: expAttributeValues,
+	})
+}
+
 // QueryIndex returns all items in the index that match the given key
 func (c *Client) QueryIndex(ctx context.Context, tableName string, indexName string, keyCondition string, expAttributeValues ExpresseionValues) ([]Item, error) {
-	response, err := c.dynamoClient.Query(ctx, &dynamodb.QueryInput{
+	return c.queryAll(ctx, &dynamodb.QueryInput{
 		TableName:                 aws.String(tableName),
 		IndexName:                 aws.String(indexName),
 		KeyConditionExpression:    aws.String(keyCondition),
 		ExpressionAttributeValues: expAttributeValues,
 	})
+}
+
+// QueryIndexWithPagination returns a single page of at most limit items in the index that match the given key,
+// starting after exclusiveStartKey (from the beginning if it's nil).
+// A limit of 0 or less returns as many items as DynamoDB returns in a single response (up to 1 MB).
+// The returned LastEvaluatedKey is nil once there are no more items to read.
+func (c *Client) QueryIndexWithPagination(ctx context.Context, tableName string, indexName string, keyCondition string, expAttributeValues ExpresseionValues, limit int32, exclusiveStartKey Key) (QueryResult, error) {
+	queryInput := &dynamodb.QueryInput{
+		TableName:                 aws.String(tableName),
+		IndexName:                 aws.String(indexName),
+		KeyConditionExpression:    aws.String(keyCondition),
+		ExpressionAttributeValues: expAttributeValues,
+		ExclusiveStartKey:         exclusiveStartKey,
+	}
+	if limit > 0 {
+		queryInput.Limit = aws.Int32(limit)
+	}
+
+	response, err := c.dynamoClient.Query(ctx, queryInput)
 	if err != nil {
-		return nil, err
+		return QueryResult{}, err
 	}
 
-	return response.Items, nil
+	return QueryResult{
+		Items:            response.Items,
+		LastEvaluatedKey: response.LastEvaluatedKey,
+	}, nil
+}
+
+// queryAll follows LastEvaluatedKey until all the items matching the query are read,
+// since a single query response is limited to 1 MB of items
+func (c *Client) queryAll(ctx context.Context, queryInput *dynamodb.QueryInput) ([]Item, error) {
+	items := make([]Item, 0)
+	for {
+		response, err := c.dynamoClient.Query(ctx, queryInput)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, response.Items...)
+
+		if len(response.LastEvaluatedKey) == 0 {
+			return items, nil
+		}
+		queryInput.ExclusiveStartKey = response.LastEvaluatedKey
+	}
 }
 
 func (c *Client) DeleteItem(ctx context.Context, tableName string, key Key) error {
@@ -12,6 +12,7 @@ import (
 	"github.com/Layr-Labs/eigenda/common/logging"
 	"github.com/Layr-Labs/eigenda/inabox/deploy"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 	"github.com/ory/dockertest/v3"
@@ -180,6 +181,48 @@ func TestBasicOperations(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestUpdateItemAndAppend(t *testing.T) {
+	tableName := "Append"
+	createTable(t, tableName)
+
+	ctx := context.Background()
+	key := commondynamodb.Key{
+		"MetadataKey": &types.AttributeValueMemberS{Value: "key"},
+	}
+	err := dynamoClient.PutItem(ctx, tableName, commondynamodb.Item{
+		"MetadataKey": &types.AttributeValueMemberS{Value: "key"},
+		"Status":      &types.AttributeValueMemberS{Value: "Processing"},
+	})
+	assert.NoError(t, err)
+
+	// The list is created by the first append
+	for _, value := range []string{"a", "b"} {
+		_, err = dynamoClient.UpdateItemAndAppend(ctx, tableName, key, commondynamodb.Item{
+			"Retries": &types.AttributeValueMemberN{Value: "1"},
+		}, commondynamodb.Item{
+			"Failures": &types.AttributeValueMemberL{Value: []types.AttributeValue{&types.AttributeValueMemberS{Value: value}}},
+		}, nil)
+		assert.NoError(t, err)
+	}
+
+	condition := expression.Name("Status").Equal(expression.Value("Confirmed"))
+	_, err = dynamoClient.UpdateItemAndAppend(ctx, tableName, key, nil, commondynamodb.Item{
+		"Failures": &types.AttributeValueMemberL{Value: []types.AttributeValue{&types.AttributeValueMemberS{Value: "c"}}},
+	}, &condition)
+	assert.ErrorIs(t, err, commondynamodb.ErrConditionFailed)
+
+	item, err := dynamoClient.GetItem(ctx, tableName, key)
+	assert.NoError(t, err)
+	assert.Equal(t, "1", item["Retries"].(*types.AttributeValueMemberN).Value)
+	assert.Equal(t, []types.AttributeValue{
+		&types.AttributeValueMemberS{Value: "a"},
+		&types.AttributeValueMemberS{Value: "b"},
+	}, item["Failures"].(*types.AttributeValueMemberL).Value)
+
+	err = dynamoClient.DeleteTable(ctx, tableName)
+	assert.NoError(t, err)
+}
+
 func TestBatchOperations(t *testing.T) {
 	tableName := "Processing"
 	createTable(t, tableName)
@@ -218,6 +261,19 @@ func TestBatchOperations(t *testing.T) {
 		}
 	}
 
+	// Keys that don't exist are left out
+	fetchedItems, err := dynamoClient.GetItems(ctx, tableName, append(keys, commondynamodb.Key{
+		"MetadataKey": &types.AttributeValueMemberS{Value: "missing"},
+	}))
+	assert.NoError(t, err)
+	assert.Len(t, fetchedItems, numItems)
+	blobKeys := make([]string, len(fetchedItems))
+	for i, item := range fetchedItems {
+		blobKeys[i] = item["BlobKey"].(*types.AttributeValueMemberS).Value
+	}
+	assert.Contains(t, blobKeys, "blob0")
+	assert.Contains(t, blobKeys, fmt.Sprintf("blob%d", numItems-1))
+
 	unprocessedKeys, err := dynamoClient.DeleteItems(ctx, tableName, keys)
 	assert.NoError(t, err)
 	assert.Len(t, unprocessedKeys, 0)
@@ -234,3 +290,103 @@ func TestBatchOperations(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Len(t, fetchedItem, 0)
 }
+
+func TestQueryIndexWithPagination(t *testing.T) {
+	tableName := "ProcessingWithPagination"
+	indexName := "StatusIndex"
+	ctx := context.Background()
+	tableDescription, err := test_utils.CreateTable(ctx, clientConfig, tableName, &dynamodb.CreateTableInput{
+		AttributeDefinitions: []types.AttributeDefinition{
+			{
+				AttributeName: aws.String("MetadataKey"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+			{
+				AttributeName: aws.String("BlobStatus"),
+				AttributeType: types.ScalarAttributeTypeN,
+			},
+			{
+				AttributeName: aws.String("RequestedAt"),
+				AttributeType: types.ScalarAttributeTypeN,
+			},
+		},
+		KeySchema: []types.KeySchemaElement{{
+			AttributeName: aws.String("MetadataKey"),
+			KeyType:       types.KeyTypeHash,
+		}},
+		GlobalSecondaryIndexes: []types.GlobalSecondaryIndex{
+			{
+				IndexName: aws.String(indexName),
+				KeySchema: []types.KeySchemaElement{
+					{
+						AttributeName: aws.String("BlobStatus"),
+						KeyType:       types.KeyTypeHash,
+					},
+					{
+						AttributeName: aws.String("RequestedAt"),
+						KeyType:       types.KeyTypeRange,
+					},
+				},
+				Projection: &types.Projection{
+					ProjectionType: types.ProjectionTypeAll,
+				},
+				ProvisionedThroughput: &types.ProvisionedThroughput{
+					ReadCapacityUnits:  aws.Int64(10),
+					WriteCapacityUnits: aws.Int64(10),
+				},
+			},
+		},
+		TableName: aws.String(tableName),
+		ProvisionedThroughput: &types.ProvisionedThroughput{
+			ReadCapacityUnits:  aws.Int64(10),
+			WriteCapacityUnits: aws.Int64(10),
+		},
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, tableDescription)
+
+	numItems := 30
+	items := make([]commondynamodb.Item, numItems)
+	for i := 0; i < numItems; i += 1 {
+		items[i] = commondynamodb.Item{
+			"MetadataKey": &types.AttributeValueMemberS{Value: fmt.Sprintf("key%d", i)},
+			"BlobKey":     &types.AttributeValueMemberS{Value: fmt.Sprintf("blob%d", i)},
+			"BlobStatus":  &types.AttributeValueMemberN{Value: "0"},
+			"RequestedAt": &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", i)},
+		}
+	}
+	unprocessed, err := dynamoClient.PutItems(ctx, tableName, items)
+	assert.NoError(t, err)
+	assert.Len(t, unprocessed, 0)
+
+	keyCondition := "BlobStatus = :status"
+	values := commondynamodb.ExpresseionValues{
+		":status": &types.AttributeValueMemberN{Value: "0"},
+	}
+
+	// Read the index in pages of 7 items
+	var startKey commondynamodb.Key
+	fetched := make([]commondynamodb.Item, 0)
+	for {
+		result, err := dynamoClient.QueryIndexWithPagination(ctx, tableName, indexName, keyCondition, values, 7, startKey)
+		assert.NoError(t, err)
+		assert.LessOrEqual(t, len(result.Items), 7)
+		fetched = append(fetched, result.Items...)
+		if result.LastEvaluatedKey == nil {
+			break
+		}
+		startKey = result.LastEvaluatedKey
+	}
+	assert.Len(t, fetched, numItems)
+	for i, item := range fetched {
+		assert.Equal(t, fmt.Sprintf("%d", i), item["RequestedAt"].(*types.AttributeValueMemberN).Value)
+	}
+
+	// QueryIndex reads all the pages
+	all, err := dynamoClient.QueryIndex(ctx, tableName, indexName, keyCondition, values)
+	assert.NoError(t, err)
+	assert.Len(t, all, numItems)
+
+	err = dynamoClient.DeleteTable(ctx, tableName)
+	assert.NoError(t, err)
+}
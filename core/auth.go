@@ -0,0 +1,39 @@
+package core
+
+import "github.com/ethereum/go-ethereum/crypto"
+
+// BlobAuthHeader contains the information about a blob request that is signed by the
+// client to prove that it controls the account the request is made on behalf of.
+type BlobAuthHeader struct {
+	// AccountID is the account claimed by the client, i.e. the hex encoded Ethereum address of the signer
+	AccountID AccountID
+	// BlobHash is the keccak256 hash of the blob data
+	BlobHash [32]byte
+	// Nonce is the challenge parameter generated by the disperser for this request
+	Nonce uint32
+	// AuthenticationData is the signature over the blob hash and the nonce
+	AuthenticationData []byte
+}
+
+// BlobRequestAuthenticator verifies that a blob request was signed by the account it claims to come from
+type BlobRequestAuthenticator interface {
+	AuthenticateBlobRequest(header BlobAuthHeader) error
+}
+
+// BlobRequestSigner signs blob requests on behalf of a single account
+type BlobRequestSigner interface {
+	SignBlobRequest(header BlobAuthHeader) ([]byte, error)
+	GetAccountID() AccountID
+}
+
+// NewCancelBlobAuthHeader returns the header that's signed by the client to authorize the cancellation of the blob
+// with the given request ID, under the challenge nonce generated by the disperser for the cancellation. The request ID
+// is domain separated so that the signature can't be mistaken for the signature of a dispersal.
+func NewCancelBlobAuthHeader(accountID AccountID, requestID []byte, nonce uint32, authenticationData []byte) BlobAuthHeader {
+	return BlobAuthHeader{
+		AccountID:          accountID,
+		BlobHash:           crypto.Keccak256Hash([]byte("CancelBlob"), requestID),
+		Nonce:              nonce,
+		AuthenticationData: authenticationData,
+	}
+}
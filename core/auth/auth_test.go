@@ -0,0 +1,74 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/Layr-Labs/eigenda/core"
+	"github.com/Layr-Labs/eigenda/core/auth"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+const privateKeyHex = "0x0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+func TestAuthentication(t *testing.T) {
+	signer, err := auth.NewSigner(privateKeyHex[2:])
+	assert.NoError(t, err)
+
+	header := core.BlobAuthHeader{
+		AccountID: signer.GetAccountID(),
+		BlobHash:  crypto.Keccak256Hash([]byte("hello")),
+		Nonce:     1234,
+	}
+	header.AuthenticationData, err = signer.SignBlobRequest(header)
+	assert.NoError(t, err)
+
+	authenticator := auth.NewAuthenticator()
+	err = authenticator.AuthenticateBlobRequest(header)
+	assert.NoError(t, err)
+}
+
+func TestAuthenticationFail(t *testing.T) {
+	signer, err := auth.NewSigner(privateKeyHex[2:])
+	assert.NoError(t, err)
+
+	header := core.BlobAuthHeader{
+		AccountID: signer.GetAccountID(),
+		BlobHash:  crypto.Keccak256Hash([]byte("hello")),
+		Nonce:     1234,
+	}
+	header.AuthenticationData, err = signer.SignBlobRequest(header)
+	assert.NoError(t, err)
+
+	authenticator := auth.NewAuthenticator()
+
+	// Signature over a different nonce
+	replayed := header
+	replayed.Nonce = 5678
+	err = authenticator.AuthenticateBlobRequest(replayed)
+	assert.ErrorIs(t, err, auth.ErrInvalidSignature)
+
+	// Signature over a different blob
+	otherBlob := header
+	otherBlob.BlobHash = crypto.Keccak256Hash([]byte("world"))
+	err = authenticator.AuthenticateBlobRequest(otherBlob)
+	assert.ErrorIs(t, err, auth.ErrInvalidSignature)
+
+	// Signature by a different account
+	otherAccount := header
+	otherAccount.AccountID = "0x0000000000000000000000000000000000000001"
+	err = authenticator.AuthenticateBlobRequest(otherAccount)
+	assert.ErrorIs(t, err, auth.ErrInvalidSignature)
+
+	// Malformed signature
+	malformed := header
+	malformed.AuthenticationData = header.AuthenticationData[:32]
+	err = authenticator.AuthenticateBlobRequest(malformed)
+	assert.ErrorIs(t, err, auth.ErrInvalidSignature)
+
+	// Invalid account ID
+	invalidAccount := header
+	invalidAccount.AccountID = "ip:0.0.0.0"
+	err = authenticator.AuthenticateBlobRequest(invalidAccount)
+	assert.Error(t, err)
+}
@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/Layr-Labs/eigenda/core"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var ErrInvalidSignature = errors.New("invalid signature")
+
+type authenticator struct{}
+
+var _ core.BlobRequestAuthenticator = (*authenticator)(nil)
+
+func NewAuthenticator() core.BlobRequestAuthenticator {
+	return &authenticator{}
+}
+
+// AuthenticateBlobRequest recovers the address of the signer from the authentication data and
+// checks that it matches the account ID in the header
+func (*authenticator) AuthenticateBlobRequest(header core.BlobAuthHeader) error {
+	if !gethcommon.IsHexAddress(header.AccountID) {
+		return fmt.Errorf("invalid account ID: %s", header.AccountID)
+	}
+
+	sig := header.AuthenticationData
+	if len(sig) != crypto.SignatureLength {
+		return fmt.Errorf("%w: signature length is %d, expected %d", ErrInvalidSignature, len(sig), crypto.SignatureLength)
+	}
+
+	// Accept signatures with the Ethereum style recovery ID (27/28) as well
+	sigCopy := make([]byte, len(sig))
+	copy(sigCopy, sig)
+	if sigCopy[crypto.RecoveryIDOffset] >= 27 {
+		sigCopy[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(blobAuthMessageHash(header), sigCopy)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+
+	signer := crypto.PubkeyToAddress(*pubKey)
+	if signer != gethcommon.HexToAddress(header.AccountID) {
+		return fmt.Errorf("%w: signer %s does not match account %s", ErrInvalidSignature, signer.Hex(), header.AccountID)
+	}
+
+	return nil
+}
+
+// blobAuthMessageHash returns the hash signed by the client: keccak256(blobHash || nonce)
+func blobAuthMessageHash(header core.BlobAuthHeader) []byte {
+	nonce := make([]byte, 4)
+	binary.BigEndian.PutUint32(nonce, header.Nonce)
+	return crypto.Keccak256(header.BlobHash[:], nonce)
+}
@@ -0,0 +1,39 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+
+	"github.com/Layr-Labs/eigenda/core"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+type LocalBlobRequestSigner struct {
+	PrivateKey *ecdsa.PrivateKey
+}
+
+var _ core.BlobRequestSigner = (*LocalBlobRequestSigner)(nil)
+
+func NewSigner(privateKeyHex string) (*LocalBlobRequestSigner, error) {
+	privateKey, err := crypto.HexToECDSA(privateKeyHex)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+
+	return &LocalBlobRequestSigner{
+		PrivateKey: privateKey,
+	}, nil
+}
+
+func (s *LocalBlobRequestSigner) SignBlobRequest(header core.BlobAuthHeader) ([]byte, error) {
+	sig, err := crypto.Sign(blobAuthMessageHash(header), s.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign blob request: %w", err)
+	}
+
+	return sig, nil
+}
+
+func (s *LocalBlobRequestSigner) GetAccountID() core.AccountID {
+	return crypto.PubkeyToAddress(s.PrivateKey.PublicKey).Hex()
+}
@@ -48,6 +48,9 @@ type BlobRequestHeader struct {
 	SecurityParams []*SecurityParam `json:"security_params"`
 	// AccountID is the account that is paying for the blob to be stored
 	AccountID AccountID `json:"account_id"`
+	// CallbackURL is notified by the disperser when the status of the blob changes. It's empty if the
+	// client didn't ask for notifications.
+	CallbackURL string `json:"callback_url"`
 }
 
 func (h *BlobRequestHeader) Validate() error {
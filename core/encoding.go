@@ -35,6 +35,11 @@ type Encoder interface {
 	// reconstruct the blob.
 	Encode(data []byte, params EncodingParams) (BlobCommitments, []*Chunk, error)
 
+	// EncodeBlobs encodes blobs that share the same encoding parameters, setting up the encoding only once for all of them.
+	// The i-th result is the encoding of the i-th blob; a blob that fails to encode doesn't fail the others. An error is
+	// returned if the encoding can't be set up for the parameters.
+	EncodeBlobs(blobs [][]byte, params EncodingParams) ([]BlobEncodingResult, error)
+
 	// VerifyChunks takes in the chunks, indices, commitments, and encoding parameters and returns an error if the chunks are invalid.
 	VerifyChunks(chunks []*Chunk, indices []ChunkNumber, commitments BlobCommitments, params EncodingParams) error
 
@@ -45,6 +50,13 @@ type Encoder interface {
 	Decode(chunks []*Chunk, indices []ChunkNumber, params EncodingParams, inputSize uint64) ([]byte, error)
 }
 
+// BlobEncodingResult is the encoding of one of the blobs passed to Encoder.EncodeBlobs
+type BlobEncodingResult struct {
+	Commitments BlobCommitments
+	Chunks      []*Chunk
+	Err         error
+}
+
 // GetBlobLength converts from blob size in bytes to blob size in symbols
 func GetBlobLength(blobSize uint) uint {
 	symSize := uint(bn254.BYTES_PER_COEFFICIENT)
@@ -2,10 +2,12 @@ package encoding
 
 import (
 	"crypto/sha256"
+	"runtime"
 
 	"github.com/Layr-Labs/eigenda/core"
 	"github.com/Layr-Labs/eigenda/pkg/encoding/encoder"
 	"github.com/Layr-Labs/eigenda/pkg/encoding/kzgEncoder"
+	"github.com/gammazero/workerpool"
 	lru "github.com/hashicorp/golang-lru/v2"
 )
 
@@ -66,6 +68,46 @@ func (e *Encoder) Encode(data []byte, params core.EncodingParams) (core.BlobComm
 		return core.BlobCommitments{}, nil, err
 	}
 
+	return e.encode(enc, data, cacheKey)
+}
+
+// EncodeBlobs encodes the blobs in parallel with the same KzgEncoder, so that its FFT settings and SRS tables are
+// looked up once for all the blobs
+func (e *Encoder) EncodeBlobs(blobs [][]byte, params core.EncodingParams) ([]core.BlobEncodingResult, error) {
+	enc, err := e.EncoderGroup.GetKzgEncoder(toEncParams(params))
+	if err != nil {
+		return nil, err
+	}
+
+	numWorkers := runtime.GOMAXPROCS(0)
+	if len(blobs) < numWorkers {
+		numWorkers = len(blobs)
+	}
+	pool := workerpool.New(numWorkers)
+	results := make([]core.BlobEncodingResult, len(blobs))
+	for i := range blobs {
+		i := i
+		pool.Submit(func() {
+			var cacheKey string = ""
+			if e.Config.CacheEncodedBlobs {
+				cacheKey = hashBlob(blobs[i], params)
+				if v, ok := e.Cache.Get(cacheKey); ok {
+					results[i] = core.BlobEncodingResult{Commitments: v.commitments, Chunks: v.chunks, Err: v.err}
+					return
+				}
+			}
+
+			commitments, chunks, err := e.encode(enc, blobs[i], cacheKey)
+			results[i] = core.BlobEncodingResult{Commitments: commitments, Chunks: chunks, Err: err}
+		})
+	}
+	pool.StopWait()
+
+	return results, nil
+}
+
+// encode encodes the data with the given KzgEncoder, and caches the result under cacheKey if caching is enabled
+func (e *Encoder) encode(enc *kzgEncoder.KzgEncoder, data []byte, cacheKey string) (core.BlobCommitments, []*core.Chunk, error) {
 	commit, lowDegreeProof, kzgFrames, _, err := enc.EncodeBytes(data)
 	if err != nil {
 		return core.BlobCommitments{}, nil, err
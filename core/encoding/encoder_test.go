@@ -106,3 +106,30 @@ func BenchmarkEncode(b *testing.B) {
 		_, _, _ = enc.Encode(blobs[i%numSamples], params)
 	}
 }
+
+func TestEncodeBlobs(t *testing.T) {
+	params := core.EncodingParams{
+		ChunkLength: 5,
+		NumChunks:   5,
+	}
+	smallBlob := []byte("small blob")
+	// The blob is too large to be encoded with the params
+	largeBlob := make([]byte, 10*len(gettysburgAddressBytes))
+	_, err := rand.Read(largeBlob)
+	assert.NoError(t, err)
+
+	results, err := enc.EncodeBlobs([][]byte{gettysburgAddressBytes, largeBlob, smallBlob}, params)
+	assert.NoError(t, err)
+	assert.Len(t, results, 3)
+	assert.Error(t, results[1].Err)
+
+	for i, blob := range [][]byte{gettysburgAddressBytes, smallBlob} {
+		result := results[2*i]
+		assert.NoError(t, result.Err)
+
+		commitments, chunks, err := enc.Encode(blob, params)
+		assert.NoError(t, err)
+		assert.Equal(t, commitments, result.Commitments)
+		assert.Equal(t, chunks, result.Chunks)
+	}
+}
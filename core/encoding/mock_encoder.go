@@ -21,6 +21,16 @@ func (e *MockEncoder) Encode(data []byte, params core.EncodingParams) (core.Blob
 	return args.Get(0).(core.BlobCommitments), args.Get(1).([]*core.Chunk), args.Error(2)
 }
 
+func (e *MockEncoder) EncodeBlobs(blobs [][]byte, params core.EncodingParams) ([]core.BlobEncodingResult, error) {
+	args := e.Called(blobs, params)
+	time.Sleep(e.Delay)
+	var results []core.BlobEncodingResult
+	if args.Get(0) != nil {
+		results = args.Get(0).([]core.BlobEncodingResult)
+	}
+	return results, args.Error(1)
+}
+
 func (e *MockEncoder) VerifyChunks(chunks []*core.Chunk, indices []core.ChunkNumber, commitments core.BlobCommitments, params core.EncodingParams) error {
 	args := e.Called(chunks, indices, commitments, params)
 	time.Sleep(e.Delay)
@@ -0,0 +1,53 @@
+package admin
+
+import (
+	"errors"
+
+	"github.com/Layr-Labs/eigenda/common"
+	"github.com/urfave/cli"
+)
+
+const (
+	GrpcPortFlagName = "admin-grpc-port"
+	APIKeyFlagName   = "admin-api-key"
+)
+
+type Config struct {
+	// GrpcPort is the port at which the admin server listens. The admin server is disabled if it's empty.
+	GrpcPort string
+	// APIKey is the key that clients must present as a bearer token in the "authorization" metadata
+	APIKey string
+}
+
+func CLIFlags(envPrefix string, flagPrefix string) []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:     common.PrefixFlag(flagPrefix, GrpcPortFlagName),
+			Usage:    "Port at which the admin server listens for grpc calls. The admin server is disabled if it's not set",
+			Required: false,
+			EnvVar:   common.PrefixEnvVar(envPrefix, "ADMIN_GRPC_PORT"),
+		},
+		cli.StringFlag{
+			Name:     common.PrefixFlag(flagPrefix, APIKeyFlagName),
+			Usage:    "API key required to call the admin server. Must be set if the admin server is enabled",
+			Required: false,
+			EnvVar:   common.PrefixEnvVar(envPrefix, "ADMIN_API_KEY"),
+		},
+	}
+}
+
+func ReadCLIConfig(ctx *cli.Context, flagPrefix string) (Config, error) {
+	config := Config{
+		GrpcPort: ctx.GlobalString(common.PrefixFlag(flagPrefix, GrpcPortFlagName)),
+		APIKey:   ctx.GlobalString(common.PrefixFlag(flagPrefix, APIKeyFlagName)),
+	}
+	if config.Enabled() && config.APIKey == "" {
+		return Config{}, errors.New("admin API key must be set when the admin server is enabled")
+	}
+	return config, nil
+}
+
+// Enabled returns whether the admin server should be started
+func (c Config) Enabled() bool {
+	return c.GrpcPort != ""
+}
@@ -0,0 +1,277 @@
+package admin
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/Layr-Labs/eigenda/common"
+	"github.com/Layr-Labs/eigenda/disperser"
+	pb "github.com/Layr-Labs/eigenda/disperser/api/grpc/admin"
+	"github.com/Layr-Labs/eigenda/disperser/batcher"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultListBlobsLimit = 100
+	maxListBlobsLimit     = 1000
+
+	authorizationHeader = "authorization"
+	bearerPrefix        = "Bearer "
+)
+
+// IntakeController pauses and resumes the intake of new blobs. It's implemented by the DispersalServer.
+type IntakeController interface {
+	PauseIntake()
+	ResumeIntake()
+	IsIntakePaused() bool
+}
+
+// BatchController controls the creation of batches. It's implemented by the Batcher.
+type BatchController interface {
+	PauseBatching()
+	ResumeBatching()
+	IsBatchingPaused() bool
+	// TriggerBatch handles a single batch right away
+	TriggerBatch(ctx context.Context) error
+	GetEncodedBlobBacklog() batcher.EncodedBlobBacklog
+	// RemoveBlob drops the pending encoding requests and the encoded results of a blob
+	RemoveBlob(metadata *disperser.BlobMetadata)
+}
+
+// Server implements the admin API. The intake and batch controllers are optional, and the
+// operations that need a missing controller return codes.Unimplemented.
+type Server struct {
+	pb.UnimplementedAdminServer
+
+	config    Config
+	blobStore disperser.BlobStore
+	intake    IntakeController
+	batches   BatchController
+	logger    common.Logger
+}
+
+func NewServer(config Config, blobStore disperser.BlobStore, intake IntakeController, batches BatchController, logger common.Logger) *Server {
+	return &Server{
+		config:    config,
+		blobStore: blobStore,
+		intake:    intake,
+		batches:   batches,
+		logger:    logger,
+	}
+}
+
+func (s *Server) PauseIntake(ctx context.Context, req *pb.PauseIntakeRequest) (*pb.IntakeStatusReply, error) {
+	if s.intake == nil {
+		return nil, status.Error(codes.Unimplemented, "intake is controlled by the apiserver")
+	}
+	s.intake.PauseIntake()
+	return &pb.IntakeStatusReply{Paused: s.intake.IsIntakePaused()}, nil
+}
+
+func (s *Server) ResumeIntake(ctx context.Context, req *pb.ResumeIntakeRequest) (*pb.IntakeStatusReply, error) {
+	if s.intake == nil {
+		return nil, status.Error(codes.Unimplemented, "intake is controlled by the apiserver")
+	}
+	s.intake.ResumeIntake()
+	return &pb.IntakeStatusReply{Paused: s.intake.IsIntakePaused()}, nil
+}
+
+func (s *Server) PauseBatching(ctx context.Context, req *pb.PauseBatchingRequest) (*pb.BatchingStatusReply, error) {
+	if s.batches == nil {
+		return nil, status.Error(codes.Unimplemented, "batching is controlled by the batcher")
+	}
+	s.batches.PauseBatching()
+	return &pb.BatchingStatusReply{Paused: s.batches.IsBatchingPaused()}, nil
+}
+
+func (s *Server) ResumeBatching(ctx context.Context, req *pb.ResumeBatchingRequest) (*pb.BatchingStatusReply, error) {
+	if s.batches == nil {
+		return nil, status.Error(codes.Unimplemented, "batching is controlled by the batcher")
+	}
+	s.batches.ResumeBatching()
+	return &pb.BatchingStatusReply{Paused: s.batches.IsBatchingPaused()}, nil
+}
+
+func (s *Server) TriggerBatch(ctx context.Context, req *pb.TriggerBatchRequest) (*pb.TriggerBatchReply, error) {
+	if s.batches == nil {
+		return nil, status.Error(codes.Unimplemented, "batching is controlled by the batcher")
+	}
+	s.logger.Info("triggering a batch through the admin API")
+	if err := s.batches.TriggerBatch(ctx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to handle batch: %v", err)
+	}
+	return &pb.TriggerBatchReply{}, nil
+}
+
+func (s *Server) GetEncodedBlobBacklog(ctx context.Context, req *pb.GetEncodedBlobBacklogRequest) (*pb.GetEncodedBlobBacklogReply, error) {
+	if s.batches == nil {
+		return nil, status.Error(codes.Unimplemented, "the encoded blob backlog is held by the batcher")
+	}
+	backlog := s.batches.GetEncodedBlobBacklog()
+	return &pb.GetEncodedBlobBacklogReply{
+		NumPendingRequests: uint32(backlog.NumPendingRequests),
+		NumEncodedResults:  uint32(backlog.NumEncodedResults),
+		EncodedResultSize:  uint64(backlog.EncodedResultSize),
+		BatchingPaused:     s.batches.IsBatchingPaused(),
+	}, nil
+}
+
+// ListBlobs returns the blobs with the given status ordered by request time, one page of the status index at a
+// time. The page token identifies the last blob of the previous page, so pages stay consistent while blobs are
+// added or change status.
+func (s *Server) ListBlobs(ctx context.Context, req *pb.ListBlobsRequest) (*pb.ListBlobsReply, error) {
+	blobStatus, err := fromProtoStatus(req.GetStatus())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	limit := int32(req.GetLimit())
+	if limit == 0 {
+		limit = defaultListBlobsLimit
+	}
+	if limit > maxListBlobsLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must not exceed %d", maxListBlobsLimit)
+	}
+	var exclusiveStartKey *disperser.BlobStoreExclusiveStartKey
+	if req.GetPageToken() != "" {
+		exclusiveStartKey, err = disperser.ParsePageToken(req.GetPageToken(), blobStatus)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	metadatas, lastEvaluatedKey, err := s.blobStore.GetBlobMetadataByStatusWithPagination(ctx, blobStatus, limit, exclusiveStartKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get blobs: %v", err)
+	}
+
+	blobs := make([]*pb.BlobSummary, 0, len(metadatas))
+	for _, metadata := range metadatas {
+		blobs = append(blobs, toBlobSummary(metadata))
+	}
+	nextPageToken := ""
+	if lastEvaluatedKey != nil {
+		nextPageToken = disperser.FormatPageToken(lastEvaluatedKey)
+	}
+
+	return &pb.ListBlobsReply{
+		Blobs:         blobs,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// SetBlobStatus forces a processing blob into the Failed status, or a blob that wasn't cancelled
+// into the Processing status. A failed blob is requeued with its retry count reset. A blob that's
+// forced into Failed is dropped from the batcher's encoded results when the request is served by
+// the batcher.
+func (s *Server) SetBlobStatus(ctx context.Context, req *pb.SetBlobStatusRequest) (*pb.SetBlobStatusReply, error) {
+	blobKey, err := disperser.ParseBlobKey(req.GetBlobKey())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid blob key: %v", err)
+	}
+	metadata, err := s.blobStore.GetBlobMetadata(ctx, blobKey)
+	if errors.Is(err, disperser.ErrBlobNotFound) || (err == nil && metadata.BlobHash == "") {
+		return nil, status.Errorf(codes.NotFound, "blob %s not found", blobKey.String())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get blob metadata: %v", err)
+	}
+
+	switch req.GetStatus() {
+	case pb.BlobStatus_FAILED:
+		reason := req.GetReason()
+		if reason == "" {
+			reason = "failed through the admin API"
+		}
+		err = s.blobStore.MarkBlobFailed(ctx, blobKey, &disperser.BlobFailure{
+			Stage:     disperser.AdminStage,
+			Error:     reason,
+			Attempt:   metadata.NumRetries,
+			Timestamp: uint64(time.Now().UnixNano()),
+		})
+		if err == nil && s.batches != nil {
+			s.batches.RemoveBlob(metadata)
+		}
+	case pb.BlobStatus_PROCESSING:
+		if metadata.BlobStatus == disperser.Failed {
+			err = s.blobStore.RequeueBlob(ctx, blobKey)
+		} else {
+			err = s.blobStore.MarkBlobProcessing(ctx, blobKey)
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "blob status can only be set to %s or %s, but found %s", pb.BlobStatus_FAILED, pb.BlobStatus_PROCESSING, req.GetStatus())
+	}
+	if errors.Is(err, disperser.ErrBlobNotProcessing) || errors.Is(err, disperser.ErrBlobCancelled) {
+		return nil, status.Errorf(codes.FailedPrecondition, "blob %s can't be set to %s from %s", blobKey.String(), req.GetStatus(), metadata.BlobStatus.String())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update blob status: %v", err)
+	}
+
+	s.logger.Info("set blob status through the admin API", "blobKey", blobKey.String(), "from", metadata.BlobStatus, "to", req.GetStatus().String())
+	return &pb.SetBlobStatusReply{}, nil
+}
+
+func (s *Server) Start(ctx context.Context) error {
+	addr := fmt.Sprintf("%s:%s", disperser.Localhost, s.config.GrpcPort)
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("could not start tcp listener: %w", err)
+	}
+
+	gs := grpc.NewServer(grpc.UnaryInterceptor(s.authenticate))
+	pb.RegisterAdminServer(gs, s)
+
+	s.logger.Info("port", s.config.GrpcPort, "address", listener.Addr().String(), "Admin GRPC Listening")
+	if err := gs.Serve(listener); err != nil {
+		return fmt.Errorf("could not start admin GRPC server: %w", err)
+	}
+	return nil
+}
+
+// authenticate rejects the requests that don't carry the admin API key as a bearer token
+func (s *Server) authenticate(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if s.config.APIKey == "" {
+		return nil, status.Error(codes.PermissionDenied, "admin API key is not configured")
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get(authorizationHeader)) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "missing authorization metadata")
+	}
+	token, found := strings.CutPrefix(md.Get(authorizationHeader)[0], bearerPrefix)
+	if !found || subtle.ConstantTimeCompare([]byte(token), []byte(s.config.APIKey)) != 1 {
+		return nil, status.Error(codes.Unauthenticated, "invalid admin API key")
+	}
+	return handler(ctx, req)
+}
+
+// fromProtoStatus converts a blob status of the admin API into a disperser.BlobStatus.
+// The values of the admin API enum are the same as the ones of disperser.BlobStatus.
+func fromProtoStatus(blobStatus pb.BlobStatus) (disperser.BlobStatus, error) {
+	if _, ok := pb.BlobStatus_name[int32(blobStatus)]; !ok {
+		return 0, fmt.Errorf("unknown blob status: %d", blobStatus)
+	}
+	return disperser.BlobStatus(blobStatus), nil
+}
+
+func toBlobSummary(metadata *disperser.BlobMetadata) *pb.BlobSummary {
+	summary := &pb.BlobSummary{
+		BlobKey:     metadata.GetBlobKey().String(),
+		Status:      pb.BlobStatus(metadata.BlobStatus),
+		NumRetries:  uint32(metadata.NumRetries),
+		RequestedAt: metadata.RequestMetadata.RequestedAt,
+		BlobSize:    uint32(metadata.RequestMetadata.BlobSize),
+		AccountId:   metadata.RequestMetadata.AccountID,
+	}
+	if failure := metadata.LastFailure(); failure != nil {
+		summary.LastFailure = failure.Error
+	}
+	return summary
+}
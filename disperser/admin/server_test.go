@@ -0,0 +1,227 @@
+package admin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Layr-Labs/eigenda/common/logging"
+	"github.com/Layr-Labs/eigenda/core"
+	"github.com/Layr-Labs/eigenda/disperser"
+	pb "github.com/Layr-Labs/eigenda/disperser/api/grpc/admin"
+	"github.com/Layr-Labs/eigenda/disperser/batcher"
+	"github.com/Layr-Labs/eigenda/disperser/common/inmem"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const testAPIKey = "secret"
+
+type mockIntakeController struct {
+	paused bool
+}
+
+func (m *mockIntakeController) PauseIntake()         { m.paused = true }
+func (m *mockIntakeController) ResumeIntake()        { m.paused = false }
+func (m *mockIntakeController) IsIntakePaused() bool { return m.paused }
+
+type mockBatchController struct {
+	paused       bool
+	numTriggered int
+	removed      []disperser.BlobKey
+}
+
+func (m *mockBatchController) PauseBatching()         { m.paused = true }
+func (m *mockBatchController) ResumeBatching()        { m.paused = false }
+func (m *mockBatchController) IsBatchingPaused() bool { return m.paused }
+
+func (m *mockBatchController) TriggerBatch(ctx context.Context) error {
+	m.numTriggered++
+	return nil
+}
+
+func (m *mockBatchController) GetEncodedBlobBacklog() batcher.EncodedBlobBacklog {
+	return batcher.EncodedBlobBacklog{NumPendingRequests: 1, NumEncodedResults: 2, EncodedResultSize: 1024}
+}
+
+func (m *mockBatchController) RemoveBlob(metadata *disperser.BlobMetadata) {
+	m.removed = append(m.removed, metadata.GetBlobKey())
+}
+
+func newTestServer(t *testing.T, blobStore disperser.BlobStore, intake IntakeController, batches BatchController) *Server {
+	logger, err := logging.GetLogger(logging.DefaultCLIConfig())
+	assert.NoError(t, err)
+	return NewServer(Config{GrpcPort: "0", APIKey: testAPIKey}, blobStore, intake, batches, logger)
+}
+
+func storeBlobs(t *testing.T, blobStore disperser.BlobStore, numBlobs int) []disperser.BlobKey {
+	keys := make([]disperser.BlobKey, numBlobs)
+	requestedAt := uint64(time.Now().UnixNano())
+	for i := 0; i < numBlobs; i++ {
+		key, err := blobStore.StoreBlob(context.Background(), &core.Blob{
+			RequestHeader: core.BlobRequestHeader{
+				SecurityParams: []*core.SecurityParam{{QuorumID: 0, AdversaryThreshold: 50, QuorumThreshold: 100}},
+			},
+			Data: []byte{byte(i)},
+		}, requestedAt)
+		assert.NoError(t, err)
+		keys[i] = key
+	}
+	return keys
+}
+
+func TestIntakeAndBatchingControls(t *testing.T) {
+	ctx := context.Background()
+	intake := &mockIntakeController{}
+	batches := &mockBatchController{}
+
+	// The apiserver only controls the intake
+	s := newTestServer(t, inmem.NewBlobStore(), intake, nil)
+	intakeReply, err := s.PauseIntake(ctx, &pb.PauseIntakeRequest{})
+	assert.NoError(t, err)
+	assert.True(t, intakeReply.GetPaused())
+	assert.True(t, intake.paused)
+	intakeReply, err = s.ResumeIntake(ctx, &pb.ResumeIntakeRequest{})
+	assert.NoError(t, err)
+	assert.False(t, intakeReply.GetPaused())
+	_, err = s.PauseBatching(ctx, &pb.PauseBatchingRequest{})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+	_, err = s.TriggerBatch(ctx, &pb.TriggerBatchRequest{})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	// The batcher only controls the batches
+	s = newTestServer(t, inmem.NewBlobStore(), nil, batches)
+	batchingReply, err := s.PauseBatching(ctx, &pb.PauseBatchingRequest{})
+	assert.NoError(t, err)
+	assert.True(t, batchingReply.GetPaused())
+	backlog, err := s.GetEncodedBlobBacklog(ctx, &pb.GetEncodedBlobBacklogRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(1), backlog.GetNumPendingRequests())
+	assert.Equal(t, uint32(2), backlog.GetNumEncodedResults())
+	assert.Equal(t, uint64(1024), backlog.GetEncodedResultSize())
+	assert.True(t, backlog.GetBatchingPaused())
+	_, err = s.TriggerBatch(ctx, &pb.TriggerBatchRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, batches.numTriggered)
+	batchingReply, err = s.ResumeBatching(ctx, &pb.ResumeBatchingRequest{})
+	assert.NoError(t, err)
+	assert.False(t, batchingReply.GetPaused())
+	_, err = s.PauseIntake(ctx, &pb.PauseIntakeRequest{})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestListBlobs(t *testing.T) {
+	ctx := context.Background()
+	blobStore := inmem.NewBlobStore()
+	keys := storeBlobs(t, blobStore, 5)
+	s := newTestServer(t, blobStore, nil, nil)
+
+	seen := make(map[string]bool)
+	pageToken := ""
+	numPages := 0
+	for {
+		reply, err := s.ListBlobs(ctx, &pb.ListBlobsRequest{Status: pb.BlobStatus_PROCESSING, Limit: 2, PageToken: pageToken})
+		assert.NoError(t, err)
+		numPages++
+		for _, blob := range reply.GetBlobs() {
+			assert.Equal(t, pb.BlobStatus_PROCESSING, blob.GetStatus())
+			assert.False(t, seen[blob.GetBlobKey()])
+			seen[blob.GetBlobKey()] = true
+		}
+		pageToken = reply.GetNextPageToken()
+		if pageToken == "" {
+			break
+		}
+	}
+	assert.Equal(t, 3, numPages)
+	assert.Len(t, seen, len(keys))
+	for _, key := range keys {
+		assert.True(t, seen[key.String()])
+	}
+
+	reply, err := s.ListBlobs(ctx, &pb.ListBlobsRequest{Status: pb.BlobStatus_FAILED})
+	assert.NoError(t, err)
+	assert.Len(t, reply.GetBlobs(), 0)
+	assert.Empty(t, reply.GetNextPageToken())
+
+	_, err = s.ListBlobs(ctx, &pb.ListBlobsRequest{Status: pb.BlobStatus(100)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.ListBlobs(ctx, &pb.ListBlobsRequest{Limit: maxListBlobsLimit + 1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.ListBlobs(ctx, &pb.ListBlobsRequest{PageToken: "invalid"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSetBlobStatus(t *testing.T) {
+	ctx := context.Background()
+	blobStore := inmem.NewBlobStore()
+	key := storeBlobs(t, blobStore, 1)[0]
+	batches := &mockBatchController{}
+	s := newTestServer(t, blobStore, nil, batches)
+
+	metadata, err := blobStore.GetBlobMetadata(ctx, key)
+	assert.NoError(t, err)
+	err = blobStore.IncrementBlobRetryCount(ctx, metadata, &disperser.BlobFailure{Stage: disperser.DispersalStage, Error: "timeout"}, 0)
+	assert.NoError(t, err)
+
+	_, err = s.SetBlobStatus(ctx, &pb.SetBlobStatusRequest{BlobKey: key.String(), Status: pb.BlobStatus_FAILED, Reason: "stuck"})
+	assert.NoError(t, err)
+	metadata, err = blobStore.GetBlobMetadata(ctx, key)
+	assert.NoError(t, err)
+	assert.Equal(t, disperser.Failed, metadata.BlobStatus)
+	assert.Equal(t, disperser.AdminStage, metadata.LastFailure().Stage)
+	assert.Equal(t, "stuck", metadata.LastFailure().Error)
+	assert.Equal(t, []disperser.BlobKey{key}, batches.removed)
+
+	listReply, err := s.ListBlobs(ctx, &pb.ListBlobsRequest{Status: pb.BlobStatus_FAILED})
+	assert.NoError(t, err)
+	assert.Len(t, listReply.GetBlobs(), 1)
+	assert.Equal(t, "stuck", listReply.GetBlobs()[0].GetLastFailure())
+
+	// Forcing a failed blob into processing requeues it
+	_, err = s.SetBlobStatus(ctx, &pb.SetBlobStatusRequest{BlobKey: key.String(), Status: pb.BlobStatus_PROCESSING})
+	assert.NoError(t, err)
+	metadata, err = blobStore.GetBlobMetadata(ctx, key)
+	assert.NoError(t, err)
+	assert.Equal(t, disperser.Processing, metadata.BlobStatus)
+	assert.Equal(t, uint(0), metadata.NumRetries)
+	assert.Len(t, metadata.FailureHistory, 2)
+
+	_, err = s.SetBlobStatus(ctx, &pb.SetBlobStatusRequest{BlobKey: key.String(), Status: pb.BlobStatus_CONFIRMED})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.SetBlobStatus(ctx, &pb.SetBlobStatusRequest{BlobKey: "invalid", Status: pb.BlobStatus_FAILED})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.SetBlobStatus(ctx, &pb.SetBlobStatusRequest{BlobKey: "hash-metadata", Status: pb.BlobStatus_FAILED})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestAuthenticate(t *testing.T) {
+	s := newTestServer(t, inmem.NewBlobStore(), &mockIntakeController{}, nil)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/admin.Admin/PauseIntake"}
+
+	withAuthorization := func(value string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationHeader, value))
+	}
+
+	reply, err := s.authenticate(withAuthorization("Bearer "+testAPIKey), nil, info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", reply)
+
+	_, err = s.authenticate(context.Background(), nil, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = s.authenticate(withAuthorization("Bearer wrong"), nil, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = s.authenticate(withAuthorization(testAPIKey), nil, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Every request is rejected if no key is configured
+	s.config.APIKey = ""
+	_, err = s.authenticate(withAuthorization("Bearer "), nil, info, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -0,0 +1,386 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.23.4
+// source: admin/admin.proto
+
+package admin
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Admin_PauseIntake_FullMethodName           = "/admin.Admin/PauseIntake"
+	Admin_ResumeIntake_FullMethodName          = "/admin.Admin/ResumeIntake"
+	Admin_PauseBatching_FullMethodName         = "/admin.Admin/PauseBatching"
+	Admin_ResumeBatching_FullMethodName        = "/admin.Admin/ResumeBatching"
+	Admin_TriggerBatch_FullMethodName          = "/admin.Admin/TriggerBatch"
+	Admin_GetEncodedBlobBacklog_FullMethodName = "/admin.Admin/GetEncodedBlobBacklog"
+	Admin_ListBlobs_FullMethodName             = "/admin.Admin/ListBlobs"
+	Admin_SetBlobStatus_FullMethodName         = "/admin.Admin/SetBlobStatus"
+)
+
+// AdminClient is the client API for Admin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminClient interface {
+	// PauseIntake makes the apiserver reject new blobs until ResumeIntake is called (apiserver only)
+	PauseIntake(ctx context.Context, in *PauseIntakeRequest, opts ...grpc.CallOption) (*IntakeStatusReply, error)
+	// ResumeIntake makes the apiserver accept new blobs again (apiserver only)
+	ResumeIntake(ctx context.Context, in *ResumeIntakeRequest, opts ...grpc.CallOption) (*IntakeStatusReply, error)
+	// PauseBatching stops the batcher from creating new batches until ResumeBatching is called.
+	// Blobs keep being encoded in the meantime. (batcher only)
+	PauseBatching(ctx context.Context, in *PauseBatchingRequest, opts ...grpc.CallOption) (*BatchingStatusReply, error)
+	// ResumeBatching makes the batcher create batches again (batcher only)
+	ResumeBatching(ctx context.Context, in *ResumeBatchingRequest, opts ...grpc.CallOption) (*BatchingStatusReply, error)
+	// TriggerBatch creates, disperses and confirms a single batch right away, even if batching is paused (batcher only)
+	TriggerBatch(ctx context.Context, in *TriggerBatchRequest, opts ...grpc.CallOption) (*TriggerBatchReply, error)
+	// GetEncodedBlobBacklog returns the state of the batcher's queue of encoded blobs (batcher only)
+	GetEncodedBlobBacklog(ctx context.Context, in *GetEncodedBlobBacklogRequest, opts ...grpc.CallOption) (*GetEncodedBlobBacklogReply, error)
+	// ListBlobs returns the blobs with the given status, one page at a time
+	ListBlobs(ctx context.Context, in *ListBlobsRequest, opts ...grpc.CallOption) (*ListBlobsReply, error)
+	// SetBlobStatus forces a blob into the FAILED or PROCESSING status
+	SetBlobStatus(ctx context.Context, in *SetBlobStatusRequest, opts ...grpc.CallOption) (*SetBlobStatusReply, error)
+}
+
+type adminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminClient(cc grpc.ClientConnInterface) AdminClient {
+	return &adminClient{cc}
+}
+
+func (c *adminClient) PauseIntake(ctx context.Context, in *PauseIntakeRequest, opts ...grpc.CallOption) (*IntakeStatusReply, error) {
+	out := new(IntakeStatusReply)
+	err := c.cc.Invoke(ctx, Admin_PauseIntake_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ResumeIntake(ctx context.Context, in *ResumeIntakeRequest, opts ...grpc.CallOption) (*IntakeStatusReply, error) {
+	out := new(IntakeStatusReply)
+	err := c.cc.Invoke(ctx, Admin_ResumeIntake_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) PauseBatching(ctx context.Context, in *PauseBatchingRequest, opts ...grpc.CallOption) (*BatchingStatusReply, error) {
+	out := new(BatchingStatusReply)
+	err := c.cc.Invoke(ctx, Admin_PauseBatching_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ResumeBatching(ctx context.Context, in *ResumeBatchingRequest, opts ...grpc.CallOption) (*BatchingStatusReply, error) {
+	out := new(BatchingStatusReply)
+	err := c.cc.Invoke(ctx, Admin_ResumeBatching_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) TriggerBatch(ctx context.Context, in *TriggerBatchRequest, opts ...grpc.CallOption) (*TriggerBatchReply, error) {
+	out := new(TriggerBatchReply)
+	err := c.cc.Invoke(ctx, Admin_TriggerBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetEncodedBlobBacklog(ctx context.Context, in *GetEncodedBlobBacklogRequest, opts ...grpc.CallOption) (*GetEncodedBlobBacklogReply, error) {
+	out := new(GetEncodedBlobBacklogReply)
+	err := c.cc.Invoke(ctx, Admin_GetEncodedBlobBacklog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ListBlobs(ctx context.Context, in *ListBlobsRequest, opts ...grpc.CallOption) (*ListBlobsReply, error) {
+	out := new(ListBlobsReply)
+	err := c.cc.Invoke(ctx, Admin_ListBlobs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) SetBlobStatus(ctx context.Context, in *SetBlobStatusRequest, opts ...grpc.CallOption) (*SetBlobStatusReply, error) {
+	out := new(SetBlobStatusReply)
+	err := c.cc.Invoke(ctx, Admin_SetBlobStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServer is the server API for Admin service.
+// All implementations must embed UnimplementedAdminServer
+// for forward compatibility
+type AdminServer interface {
+	// PauseIntake makes the apiserver reject new blobs until ResumeIntake is called (apiserver only)
+	PauseIntake(context.Context, *PauseIntakeRequest) (*IntakeStatusReply, error)
+	// ResumeIntake makes the apiserver accept new blobs again (apiserver only)
+	ResumeIntake(context.Context, *ResumeIntakeRequest) (*IntakeStatusReply, error)
+	// PauseBatching stops the batcher from creating new batches until ResumeBatching is called.
+	// Blobs keep being encoded in the meantime. (batcher only)
+	PauseBatching(context.Context, *PauseBatchingRequest) (*BatchingStatusReply, error)
+	// ResumeBatching makes the batcher create batches again (batcher only)
+	ResumeBatching(context.Context, *ResumeBatchingRequest) (*BatchingStatusReply, error)
+	// TriggerBatch creates, disperses and confirms a single batch right away, even if batching is paused (batcher only)
+	TriggerBatch(context.Context, *TriggerBatchRequest) (*TriggerBatchReply, error)
+	// GetEncodedBlobBacklog returns the state of the batcher's queue of encoded blobs (batcher only)
+	GetEncodedBlobBacklog(context.Context, *GetEncodedBlobBacklogRequest) (*GetEncodedBlobBacklogReply, error)
+	// ListBlobs returns the blobs with the given status, one page at a time
+	ListBlobs(context.Context, *ListBlobsRequest) (*ListBlobsReply, error)
+	// SetBlobStatus forces a blob into the FAILED or PROCESSING status
+	SetBlobStatus(context.Context, *SetBlobStatusRequest) (*SetBlobStatusReply, error)
+	mustEmbedUnimplementedAdminServer()
+}
+
+// UnimplementedAdminServer must be embedded to have forward compatible implementations.
+type UnimplementedAdminServer struct {
+}
+
+func (UnimplementedAdminServer) PauseIntake(context.Context, *PauseIntakeRequest) (*IntakeStatusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseIntake not implemented")
+}
+func (UnimplementedAdminServer) ResumeIntake(context.Context, *ResumeIntakeRequest) (*IntakeStatusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeIntake not implemented")
+}
+func (UnimplementedAdminServer) PauseBatching(context.Context, *PauseBatchingRequest) (*BatchingStatusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseBatching not implemented")
+}
+func (UnimplementedAdminServer) ResumeBatching(context.Context, *ResumeBatchingRequest) (*BatchingStatusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeBatching not implemented")
+}
+func (UnimplementedAdminServer) TriggerBatch(context.Context, *TriggerBatchRequest) (*TriggerBatchReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerBatch not implemented")
+}
+func (UnimplementedAdminServer) GetEncodedBlobBacklog(context.Context, *GetEncodedBlobBacklogRequest) (*GetEncodedBlobBacklogReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEncodedBlobBacklog not implemented")
+}
+func (UnimplementedAdminServer) ListBlobs(context.Context, *ListBlobsRequest) (*ListBlobsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlobs not implemented")
+}
+func (UnimplementedAdminServer) SetBlobStatus(context.Context, *SetBlobStatusRequest) (*SetBlobStatusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBlobStatus not implemented")
+}
+func (UnimplementedAdminServer) mustEmbedUnimplementedAdminServer() {}
+
+// UnsafeAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServer will
+// result in compilation errors.
+type UnsafeAdminServer interface {
+	mustEmbedUnimplementedAdminServer()
+}
+
+func RegisterAdminServer(s grpc.ServiceRegistrar, srv AdminServer) {
+	s.RegisterService(&Admin_ServiceDesc, srv)
+}
+
+func _Admin_PauseIntake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseIntakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).PauseIntake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_PauseIntake_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).PauseIntake(ctx, req.(*PauseIntakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ResumeIntake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeIntakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ResumeIntake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_ResumeIntake_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ResumeIntake(ctx, req.(*ResumeIntakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_PauseBatching_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseBatchingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).PauseBatching(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_PauseBatching_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).PauseBatching(ctx, req.(*PauseBatchingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ResumeBatching_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeBatchingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ResumeBatching(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_ResumeBatching_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ResumeBatching(ctx, req.(*ResumeBatchingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_TriggerBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).TriggerBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_TriggerBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).TriggerBatch(ctx, req.(*TriggerBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetEncodedBlobBacklog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEncodedBlobBacklogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetEncodedBlobBacklog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_GetEncodedBlobBacklog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetEncodedBlobBacklog(ctx, req.(*GetEncodedBlobBacklogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ListBlobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ListBlobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_ListBlobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ListBlobs(ctx, req.(*ListBlobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_SetBlobStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBlobStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SetBlobStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_SetBlobStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SetBlobStatus(ctx, req.(*SetBlobStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Admin_ServiceDesc is the grpc.ServiceDesc for Admin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Admin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "admin.Admin",
+	HandlerType: (*AdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PauseIntake",
+			Handler:    _Admin_PauseIntake_Handler,
+		},
+		{
+			MethodName: "ResumeIntake",
+			Handler:    _Admin_ResumeIntake_Handler,
+		},
+		{
+			MethodName: "PauseBatching",
+			Handler:    _Admin_PauseBatching_Handler,
+		},
+		{
+			MethodName: "ResumeBatching",
+			Handler:    _Admin_ResumeBatching_Handler,
+		},
+		{
+			MethodName: "TriggerBatch",
+			Handler:    _Admin_TriggerBatch_Handler,
+		},
+		{
+			MethodName: "GetEncodedBlobBacklog",
+			Handler:    _Admin_GetEncodedBlobBacklog_Handler,
+		},
+		{
+			MethodName: "ListBlobs",
+			Handler:    _Admin_ListBlobs_Handler,
+		},
+		{
+			MethodName: "SetBlobStatus",
+			Handler:    _Admin_SetBlobStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "admin/admin.proto",
+}
@@ -253,6 +253,211 @@ func (x *EncodeBlobReply) GetChunks() [][]byte {
 	return nil
 }
 
+// EncodeBlobStreamReply is a frame of the streamed reply of EncodeBlobStream.
+// The first frame carries the BlobCommitment and the total number of chunks, and the following frames carry the
+// chunks in order, as many as fit in the server's frame size.
+type EncodeBlobStreamReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Commitment *BlobCommitment `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	NumChunks  uint32          `protobuf:"varint,2,opt,name=num_chunks,json=numChunks,proto3" json:"num_chunks,omitempty"`
+	Chunks     [][]byte        `protobuf:"bytes,3,rep,name=chunks,proto3" json:"chunks,omitempty"`
+}
+
+func (x *EncodeBlobStreamReply) Reset() {
+	*x = EncodeBlobStreamReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_encoder_encoder_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EncodeBlobStreamReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncodeBlobStreamReply) ProtoMessage() {}
+
+func (x *EncodeBlobStreamReply) ProtoReflect() protoreflect.Message {
+	mi := &file_encoder_encoder_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncodeBlobStreamReply.ProtoReflect.Descriptor instead.
+func (*EncodeBlobStreamReply) Descriptor() ([]byte, []int) {
+	return file_encoder_encoder_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EncodeBlobStreamReply) GetCommitment() *BlobCommitment {
+	if x != nil {
+		return x.Commitment
+	}
+	return nil
+}
+
+func (x *EncodeBlobStreamReply) GetNumChunks() uint32 {
+	if x != nil {
+		return x.NumChunks
+	}
+	return 0
+}
+
+func (x *EncodeBlobStreamReply) GetChunks() [][]byte {
+	if x != nil {
+		return x.Chunks
+	}
+	return nil
+}
+
+// EncodeBlobsRequest contains the data of blobs that are encoded with the same encoding params
+type EncodeBlobsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data           [][]byte        `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	EncodingParams *EncodingParams `protobuf:"bytes,2,opt,name=encoding_params,json=encodingParams,proto3" json:"encoding_params,omitempty"`
+}
+
+func (x *EncodeBlobsRequest) Reset() {
+	*x = EncodeBlobsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_encoder_encoder_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EncodeBlobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncodeBlobsRequest) ProtoMessage() {}
+
+func (x *EncodeBlobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_encoder_encoder_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncodeBlobsRequest.ProtoReflect.Descriptor instead.
+func (*EncodeBlobsRequest) Descriptor() ([]byte, []int) {
+	return file_encoder_encoder_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EncodeBlobsRequest) GetData() [][]byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *EncodeBlobsRequest) GetEncodingParams() *EncodingParams {
+	if x != nil {
+		return x.EncodingParams
+	}
+	return nil
+}
+
+// EncodeBlobsReply is a frame of the streamed reply of EncodeBlobs. The blobs are sent in the order of the request.
+// The first frame of a blob carries its index in the request, and either its BlobCommitment and total number of
+// chunks or the error that prevented encoding it. The following frames carry the index and the chunks of the blob in
+// order, as many as fit in the server's frame size.
+type EncodeBlobsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlobIndex  uint32          `protobuf:"varint,1,opt,name=blob_index,json=blobIndex,proto3" json:"blob_index,omitempty"`
+	Commitment *BlobCommitment `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	NumChunks  uint32          `protobuf:"varint,3,opt,name=num_chunks,json=numChunks,proto3" json:"num_chunks,omitempty"`
+	Chunks     [][]byte        `protobuf:"bytes,4,rep,name=chunks,proto3" json:"chunks,omitempty"`
+	Error      string          `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *EncodeBlobsReply) Reset() {
+	*x = EncodeBlobsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_encoder_encoder_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EncodeBlobsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncodeBlobsReply) ProtoMessage() {}
+
+func (x *EncodeBlobsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_encoder_encoder_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncodeBlobsReply.ProtoReflect.Descriptor instead.
+func (*EncodeBlobsReply) Descriptor() ([]byte, []int) {
+	return file_encoder_encoder_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EncodeBlobsReply) GetBlobIndex() uint32 {
+	if x != nil {
+		return x.BlobIndex
+	}
+	return 0
+}
+
+func (x *EncodeBlobsReply) GetCommitment() *BlobCommitment {
+	if x != nil {
+		return x.Commitment
+	}
+	return nil
+}
+
+func (x *EncodeBlobsReply) GetNumChunks() uint32 {
+	if x != nil {
+		return x.NumChunks
+	}
+	return 0
+}
+
+func (x *EncodeBlobsReply) GetChunks() [][]byte {
+	if x != nil {
+		return x.Chunks
+	}
+	return nil
+}
+
+func (x *EncodeBlobsReply) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 var File_encoder_encoder_proto protoreflect.FileDescriptor
 
 var file_encoder_encoder_proto_rawDesc = []byte{
@@ -283,12 +488,49 @@ var file_encoder_encoder_proto_rawDesc = []byte{
 	0x6f, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x6f,
 	0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x75, 0x6e,
 	0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73,
-	0x32, 0x4f, 0x0a, 0x07, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x0a, 0x45,
-	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x1a, 0x2e, 0x65, 0x6e, 0x63, 0x6f,
-	0x64, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x2e,
-	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x00, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x22, 0x87, 0x01, 0x0a, 0x15, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x43, 0x68, 0x75, 0x6e,
+	0x6b, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x06, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x22, 0x6a, 0x0a, 0x12, 0x45, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x40, 0x0a, 0x0f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x0e, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xb7, 0x01, 0x0a, 0x10, 0x45, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
+	0x6c, 0x6f, 0x62, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x62, 0x6c, 0x6f, 0x62, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x37, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x43, 0x68, 0x75, 0x6e,
+	0x6b, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x06, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x32, 0xee, 0x01, 0x0a, 0x07, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x0a,
+	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x1a, 0x2e, 0x65, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72,
+	0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x52, 0x0a, 0x10, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x6c, 0x6f, 0x62,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1a, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72,
+	0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x30, 0x01, 0x12, 0x49, 0x0a, 0x0b, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x42, 0x6c, 0x6f, 0x62, 0x73, 0x12, 0x1b, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x2e,
+	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x30,
+	0x01, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
 	0x4c, 0x61, 0x79, 0x72, 0x2d, 0x4c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x69, 0x67, 0x65, 0x6e, 0x64,
 	0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x65, 0x6e, 0x63, 0x6f, 0x64,
 	0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
@@ -306,23 +548,33 @@ func file_encoder_encoder_proto_rawDescGZIP() []byte {
 	return file_encoder_encoder_proto_rawDescData
 }
 
-var file_encoder_encoder_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_encoder_encoder_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_encoder_encoder_proto_goTypes = []interface{}{
-	(*BlobCommitment)(nil),    // 0: encoder.BlobCommitment
-	(*EncodingParams)(nil),    // 1: encoder.EncodingParams
-	(*EncodeBlobRequest)(nil), // 2: encoder.EncodeBlobRequest
-	(*EncodeBlobReply)(nil),   // 3: encoder.EncodeBlobReply
+	(*BlobCommitment)(nil),        // 0: encoder.BlobCommitment
+	(*EncodingParams)(nil),        // 1: encoder.EncodingParams
+	(*EncodeBlobRequest)(nil),     // 2: encoder.EncodeBlobRequest
+	(*EncodeBlobReply)(nil),       // 3: encoder.EncodeBlobReply
+	(*EncodeBlobStreamReply)(nil), // 4: encoder.EncodeBlobStreamReply
+	(*EncodeBlobsRequest)(nil),    // 5: encoder.EncodeBlobsRequest
+	(*EncodeBlobsReply)(nil),      // 6: encoder.EncodeBlobsReply
 }
 var file_encoder_encoder_proto_depIdxs = []int32{
 	1, // 0: encoder.EncodeBlobRequest.encoding_params:type_name -> encoder.EncodingParams
 	0, // 1: encoder.EncodeBlobReply.commitment:type_name -> encoder.BlobCommitment
-	2, // 2: encoder.Encoder.EncodeBlob:input_type -> encoder.EncodeBlobRequest
-	3, // 3: encoder.Encoder.EncodeBlob:output_type -> encoder.EncodeBlobReply
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	0, // 2: encoder.EncodeBlobStreamReply.commitment:type_name -> encoder.BlobCommitment
+	1, // 3: encoder.EncodeBlobsRequest.encoding_params:type_name -> encoder.EncodingParams
+	0, // 4: encoder.EncodeBlobsReply.commitment:type_name -> encoder.BlobCommitment
+	2, // 5: encoder.Encoder.EncodeBlob:input_type -> encoder.EncodeBlobRequest
+	2, // 6: encoder.Encoder.EncodeBlobStream:input_type -> encoder.EncodeBlobRequest
+	5, // 7: encoder.Encoder.EncodeBlobs:input_type -> encoder.EncodeBlobsRequest
+	3, // 8: encoder.Encoder.EncodeBlob:output_type -> encoder.EncodeBlobReply
+	4, // 9: encoder.Encoder.EncodeBlobStream:output_type -> encoder.EncodeBlobStreamReply
+	6, // 10: encoder.Encoder.EncodeBlobs:output_type -> encoder.EncodeBlobsReply
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_encoder_encoder_proto_init() }
@@ -379,6 +631,42 @@ func file_encoder_encoder_proto_init() {
 				return nil
 			}
 		}
+		file_encoder_encoder_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncodeBlobStreamReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_encoder_encoder_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncodeBlobsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_encoder_encoder_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncodeBlobsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -386,7 +674,7 @@ func file_encoder_encoder_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_encoder_encoder_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -2,10 +2,18 @@ package apiserver
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"io"
 	"net"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	pb "github.com/Layr-Labs/eigenda/api/grpc/disperser"
@@ -13,6 +21,8 @@ import (
 	healthcheck "github.com/Layr-Labs/eigenda/common/healthcheck"
 	"github.com/Layr-Labs/eigenda/core"
 	"github.com/Layr-Labs/eigenda/disperser"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -20,10 +30,20 @@ import (
 
 var errSystemRateLimit = fmt.Errorf("request ratelimited: system limit")
 var errAccountRateLimit = fmt.Errorf("request ratelimited: account limit")
+var errIntakePaused = errors.New("blob intake is paused, try again later")
+var errStatusStreamExpired = errors.New("blob status stream reached its max duration, subscribe again")
 
 const systemAccountKey = "system"
 
-const maxBlobSize = 1024 * 512 // 512 KiB
+const maxNumBlobsPerRequest = 100
+
+const maxCallbackURLLength = 2048
+
+const defaultStatusPollInterval = 1 * time.Second
+
+// authenticationTimeout is how long the server waits for the client to respond to the
+// challenge in DisperseBlobAuthenticated.
+const authenticationTimeout = 30 * time.Second
 
 type DispersalServer struct {
 	pb.UnimplementedDisperserServer
@@ -38,8 +58,16 @@ type DispersalServer struct {
 	rateConfig  RateConfig
 	ratelimiter common.RateLimiter
 
+	authenticator core.BlobRequestAuthenticator
+
 	metrics *disperser.Metrics
 
+	// intakePaused is set through the admin API to reject new blobs
+	intakePaused atomic.Bool
+
+	// statusWatcher polls the status of the blobs with an open SubscribeBlobStatus stream
+	statusWatcher *statusWatcher
+
 	logger common.Logger
 }
 
@@ -54,17 +82,20 @@ func NewDispersalServer(
 	metrics *disperser.Metrics,
 	ratelimiter common.RateLimiter,
 	rateConfig RateConfig,
+	authenticator core.BlobRequestAuthenticator,
 ) *DispersalServer {
 	return &DispersalServer{
-		config:      config,
-		blobStore:   store,
-		tx:          tx,
-		quorumCount: 0,
-		metrics:     metrics,
-		logger:      logger,
-		ratelimiter: ratelimiter,
-		rateConfig:  rateConfig,
-		mu:          &sync.Mutex{},
+		config:        config,
+		blobStore:     store,
+		tx:            tx,
+		quorumCount:   0,
+		metrics:       metrics,
+		logger:        logger,
+		ratelimiter:   ratelimiter,
+		rateConfig:    rateConfig,
+		authenticator: authenticator,
+		statusWatcher: newStatusWatcher(store, config, logger),
+		mu:            &sync.Mutex{},
 	}
 }
 
@@ -74,6 +105,285 @@ func (s *DispersalServer) DisperseBlob(ctx context.Context, req *pb.DisperseBlob
 	}))
 	defer timer.ObserveDuration()
 
+	if s.IsIntakePaused() {
+		return nil, errIntakePaused
+	}
+
+	blob, err := s.validateRequestAndGetBlob(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.disperseBlob(ctx, blob, "", "DisperseBlob")
+}
+
+func (s *DispersalServer) DisperseBlobAuthenticated(stream pb.Disperser_DisperseBlobAuthenticatedServer) error {
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(f float64) {
+		s.metrics.ObserveLatency("DisperseBlobAuthenticated", f*1000) // make milliseconds
+	}))
+	defer timer.ObserveDuration()
+
+	if s.IsIntakePaused() {
+		return errIntakePaused
+	}
+
+	ctx := stream.Context()
+
+	// Process the disperse request
+	in, err := stream.Recv()
+	if err != nil {
+		return fmt.Errorf("error receiving next message: %w", err)
+	}
+
+	request, ok := in.GetPayload().(*pb.AuthenticatedRequest_DisperseRequest)
+	if !ok {
+		return errors.New("invalid request: expected DisperseBlobRequest")
+	}
+
+	blob, err := s.validateRequestAndGetBlob(ctx, request.DisperseRequest)
+	if err != nil {
+		return err
+	}
+
+	accountID := request.DisperseRequest.GetAccountId()
+	if !gethcommon.IsHexAddress(accountID) {
+		return fmt.Errorf("invalid request: account_id must be a hex encoded Ethereum address, but found %s", accountID)
+	}
+
+	// Send back the challenge to the client
+	challenge, err := getChallengeNonce()
+	if err != nil {
+		return fmt.Errorf("failed to generate challenge: %w", err)
+	}
+	err = stream.Send(&pb.AuthenticatedReply{Payload: &pb.AuthenticatedReply_BlobAuthHeader{
+		BlobAuthHeader: &pb.BlobAuthHeader{
+			ChallengeParameter: challenge,
+		},
+	}})
+	if err != nil {
+		return err
+	}
+
+	// Wait for the client to sign the challenge
+	in, err = receiveWithTimeout(stream.Context(), stream.Recv, authenticationTimeout)
+	if err != nil {
+		return fmt.Errorf("error receiving authentication data: %w", err)
+	}
+
+	challengeReply, ok := in.GetPayload().(*pb.AuthenticatedRequest_AuthenticationData)
+	if !ok {
+		return errors.New("invalid request: expected AuthenticationData")
+	}
+
+	blobAuthHeader := core.BlobAuthHeader{
+		AccountID:          accountID,
+		BlobHash:           crypto.Keccak256Hash(blob.Data),
+		Nonce:              challenge,
+		AuthenticationData: challengeReply.AuthenticationData.GetAuthenticationData(),
+	}
+	if err := s.authenticator.AuthenticateBlobRequest(blobAuthHeader); err != nil {
+		s.logger.Warn("failed to authenticate blob request", "accountID", accountID, "err", err)
+		for _, param := range blob.RequestHeader.SecurityParams {
+			s.metrics.HandleFailedRequest(string(param.QuorumID), len(blob.Data), "DisperseBlobAuthenticated")
+		}
+		return fmt.Errorf("failed to authenticate blob request: %w", err)
+	}
+
+	// Use the checksummed address so that the account is keyed consistently
+	reply, err := s.disperseBlob(ctx, blob, gethcommon.HexToAddress(accountID).Hex(), "DisperseBlobAuthenticated")
+	if err != nil {
+		return err
+	}
+
+	return stream.Send(&pb.AuthenticatedReply{Payload: &pb.AuthenticatedReply_DisperseReply{
+		DisperseReply: reply,
+	}})
+}
+
+func (s *DispersalServer) DisperseBlobStream(stream pb.Disperser_DisperseBlobStreamServer) error {
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(f float64) {
+		s.metrics.ObserveLatency("DisperseBlobStream", f*1000) // make milliseconds
+	}))
+	defer timer.ObserveDuration()
+
+	if s.IsIntakePaused() {
+		return errIntakePaused
+	}
+
+	ctx := stream.Context()
+
+	// Process the header
+	in, err := stream.Recv()
+	if err != nil {
+		return fmt.Errorf("error receiving next message: %w", err)
+	}
+
+	header, ok := in.GetPayload().(*pb.DisperseBlobStreamRequest_Header)
+	if !ok {
+		return errors.New("invalid request: expected DisperseBlobStreamHeader")
+	}
+
+	// Reject oversized blobs before receiving any data
+	dataSize := int(header.Header.GetDataSize())
+	if err := s.validateBlobSize(dataSize); err != nil {
+		return err
+	}
+
+	// Receive the data chunks until the client closes the stream
+	data := make([]byte, 0, dataSize)
+	for {
+		in, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("error receiving next message: %w", err)
+		}
+
+		chunk, ok := in.GetPayload().(*pb.DisperseBlobStreamRequest_Chunk)
+		if !ok {
+			return errors.New("invalid request: expected a data chunk")
+		}
+		if len(data)+len(chunk.Chunk) > dataSize {
+			return fmt.Errorf("invalid request: received more than data_size (%d) bytes", dataSize)
+		}
+		data = append(data, chunk.Chunk...)
+	}
+
+	if len(data) != dataSize {
+		return fmt.Errorf("invalid request: received %d bytes, but data_size is %d", len(data), dataSize)
+	}
+
+	blob, err := s.validateRequestAndGetBlob(ctx, &pb.DisperseBlobRequest{
+		Data:           data,
+		SecurityParams: header.Header.GetSecurityParams(),
+		CallbackUrl:    header.Header.GetCallbackUrl(),
+	})
+	if err != nil {
+		return err
+	}
+
+	reply, err := s.disperseBlob(ctx, blob, "", "DisperseBlobStream")
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(reply)
+}
+
+func (s *DispersalServer) DisperseBlobs(ctx context.Context, req *pb.DisperseBlobsRequest) (*pb.DisperseBlobsReply, error) {
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(f float64) {
+		s.metrics.ObserveLatency("DisperseBlobs", f*1000) // make milliseconds
+	}))
+	defer timer.ObserveDuration()
+
+	if s.IsIntakePaused() {
+		return nil, errIntakePaused
+	}
+
+	requests := req.GetBlobs()
+	if len(requests) == 0 {
+		return nil, fmt.Errorf("invalid request: blobs must not be empty")
+	}
+	if len(requests) > maxNumBlobsPerRequest {
+		return nil, fmt.Errorf("invalid request: the number of blobs must not exceed %d", maxNumBlobsPerRequest)
+	}
+
+	origin, err := common.GetClientAddress(ctx, s.rateConfig.ClientIPHeader, 1, true)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Debug("received a new multi-blob request", "origin", origin, "numBlobs", len(requests))
+
+	results := make([]*pb.DisperseBlobResult, len(requests))
+
+	// Validate each blob on its own; invalid blobs are reported but don't fail the request
+	blobs := make([]*core.Blob, 0, len(requests))
+	indices := make([]int, 0, len(requests))
+	for i, request := range requests {
+		blob, err := s.validateRequestAndGetBlob(ctx, request)
+		if err == nil {
+			blob.RequestHeader.AccountID = "ip:" + origin
+			err = blob.RequestHeader.Validate()
+		}
+		if err != nil {
+			for _, param := range request.GetSecurityParams() {
+				s.metrics.HandleFailedRequest(string(uint8(param.GetQuorumId())), len(request.GetData()), "DisperseBlobs")
+			}
+			results[i] = &pb.DisperseBlobResult{Error: err.Error()}
+			continue
+		}
+		if reply := s.getDuplicateBlobReply(ctx, blob); reply != nil {
+			results[i] = &pb.DisperseBlobResult{Reply: reply}
+			continue
+		}
+		blobs = append(blobs, blob)
+		indices = append(indices, i)
+	}
+
+	if s.ratelimiter != nil && len(blobs) > 0 {
+		err := s.checkRateLimitsAndAddRates(ctx, blobs, origin)
+		if err != nil {
+			for _, blob := range blobs {
+				for _, param := range blob.RequestHeader.SecurityParams {
+					quorumId := string(param.QuorumID)
+					if errors.Is(err, errSystemRateLimit) {
+						s.metrics.HandleSystemRateLimitedRequest(quorumId, len(blob.Data), "DisperseBlobs")
+					} else if errors.Is(err, errAccountRateLimit) {
+						s.metrics.HandleAccountRateLimitedRequest(quorumId, len(blob.Data), "DisperseBlobs")
+					} else {
+						s.metrics.HandleFailedRequest(quorumId, len(blob.Data), "DisperseBlobs")
+					}
+				}
+			}
+			return nil, err
+		}
+	}
+
+	// Store the blobs concurrently. Each blob gets a distinct requestedAt so that identical
+	// blobs in the same request get distinct keys.
+	requestedAt := uint64(time.Now().UnixNano())
+	var wg sync.WaitGroup
+	for j, blob := range blobs {
+		wg.Add(1)
+		go func(i int, blob *core.Blob, requestedAt uint64) {
+			defer wg.Done()
+			reply, err := s.storeBlob(ctx, blob, requestedAt, "DisperseBlobs")
+			if err != nil {
+				s.logger.Error("failed to store blob", "err", err)
+				results[i] = &pb.DisperseBlobResult{Error: err.Error()}
+				return
+			}
+			results[i] = &pb.DisperseBlobResult{Reply: reply}
+		}(indices[j], blob, requestedAt+uint64(j))
+	}
+	wg.Wait()
+
+	return &pb.DisperseBlobsReply{
+		Results: results,
+	}, nil
+}
+
+// PauseIntake makes the server reject new blobs until ResumeIntake is called
+func (s *DispersalServer) PauseIntake() {
+	s.intakePaused.Store(true)
+	s.logger.Info("paused blob intake")
+}
+
+// ResumeIntake makes the server accept new blobs again
+func (s *DispersalServer) ResumeIntake() {
+	s.intakePaused.Store(false)
+	s.logger.Info("resumed blob intake")
+}
+
+// IsIntakePaused returns whether the server rejects new blobs
+func (s *DispersalServer) IsIntakePaused() bool {
+	return s.intakePaused.Load()
+}
+
+// validateRequestAndGetBlob checks the security params and the size of the data in the request and
+// converts it into a blob
+func (s *DispersalServer) validateRequestAndGetBlob(ctx context.Context, req *pb.DisperseBlobRequest) (*core.Blob, error) {
 	securityParams := req.GetSecurityParams()
 	if len(securityParams) == 0 {
 		return nil, fmt.Errorf("invalid request: security_params must not be empty")
@@ -103,99 +413,250 @@ func (s *DispersalServer) DisperseBlob(ctx context.Context, req *pb.DisperseBlob
 		}
 	}
 
-	blobSize := len(req.GetData())
-	// The blob size in bytes must be in range [1, maxBlobSize].
+	if err := s.validateBlobSize(len(req.GetData())); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateCallbackURL(req.GetCallbackUrl()); err != nil {
+		return nil, err
+	}
+
+	return getBlobFromRequest(req), nil
+}
+
+// validateCallbackURL checks that the callback URL, if any, is an absolute http(s) URL whose host isn't internal to the
+// disperser's network and whose port isn't blocked. Host names are checked again once they're resolved, when the
+// notification is sent.
+func (s *DispersalServer) validateCallbackURL(callbackURL string) error {
+	if callbackURL == "" {
+		return nil
+	}
+	if len(callbackURL) > maxCallbackURLLength {
+		return fmt.Errorf("invalid request: callback_url must not exceed %d characters", maxCallbackURLLength)
+	}
+	u, err := url.Parse(callbackURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid request: callback_url must be an absolute http or https URL, but found %s", callbackURL)
+	}
+	if err := disperser.ValidateCallbackHost(u.Hostname()); err != nil {
+		return fmt.Errorf("invalid request: callback_url: %w", err)
+	}
+	if port := u.Port(); port != "" && slices.Contains(s.config.BlockedCallbackPorts, port) {
+		return fmt.Errorf("invalid request: callback_url must not use port %s", port)
+	}
+	return nil
+}
+
+// validateBlobSize checks that the blob size in bytes is in range [1, maxBlobSize].
+func (s *DispersalServer) validateBlobSize(blobSize int) error {
+	maxBlobSize := s.config.MaxBlobSize
+	if maxBlobSize <= 0 {
+		maxBlobSize = disperser.DefaultMaxBlobSize
+	}
+
 	if blobSize > maxBlobSize {
-		return nil, fmt.Errorf("blob size cannot exceed 512 KiB")
+		return fmt.Errorf("blob size cannot exceed %d KiB", maxBlobSize/1024)
 	}
 	if blobSize == 0 {
-		return nil, fmt.Errorf("blob size must be greater than 0")
+		return fmt.Errorf("blob size must be greater than 0")
 	}
+	return nil
+}
 
-	blob := getBlobFromRequest(req)
+// disperseBlob rate limits and stores a validated blob.
+// If authenticatedAddress is empty, the request is accounted to the IP address of the client.
+func (s *DispersalServer) disperseBlob(ctx context.Context, blob *core.Blob, authenticatedAddress string, apiMethodName string) (*pb.DisperseBlobReply, error) {
+	securityParams := blob.RequestHeader.SecurityParams
+	blobSize := len(blob.Data)
 
 	origin, err := common.GetClientAddress(ctx, s.rateConfig.ClientIPHeader, 1, true)
 	if err != nil {
 		for _, param := range securityParams {
-			quorumId := string(uint8(param.GetQuorumId()))
-			s.metrics.HandleFailedRequest(quorumId, blobSize, "DisperseBlob")
+			quorumId := string(param.QuorumID)
+			s.metrics.HandleFailedRequest(quorumId, blobSize, apiMethodName)
 		}
 		return nil, err
 	}
 
-	s.logger.Debug("received a new blob request", "origin", origin, "securityParams", securityParams)
+	if authenticatedAddress != "" {
+		blob.RequestHeader.AccountID = authenticatedAddress
+	} else {
+		blob.RequestHeader.AccountID = "ip:" + origin
+	}
+
+	s.logger.Debug("received a new blob request", "origin", origin, "accountID", blob.RequestHeader.AccountID, "securityParams", securityParams)
 
 	if err := blob.RequestHeader.Validate(); err != nil {
 		s.logger.Warn("invalid header", "err", err)
 		for _, param := range securityParams {
-			quorumId := string(uint8(param.GetQuorumId()))
-			s.metrics.HandleFailedRequest(quorumId, blobSize, "DisperseBlob")
+			quorumId := string(param.QuorumID)
+			s.metrics.HandleFailedRequest(quorumId, blobSize, apiMethodName)
 		}
 		return nil, err
 	}
 
+	if reply := s.getDuplicateBlobReply(ctx, blob); reply != nil {
+		return reply, nil
+	}
+
 	if s.ratelimiter != nil {
-		err := s.checkRateLimitsAndAddRates(ctx, blob, origin)
+		err := s.checkRateLimitsAndAddRates(ctx, []*core.Blob{blob}, origin)
 		if err != nil {
 			for _, param := range securityParams {
-				quorumId := string(uint8(param.GetQuorumId()))
+				quorumId := string(param.QuorumID)
 				if errors.Is(err, errSystemRateLimit) {
-					s.metrics.HandleSystemRateLimitedRequest(quorumId, blobSize, "DisperseBlob")
+					s.metrics.HandleSystemRateLimitedRequest(quorumId, blobSize, apiMethodName)
 				} else if errors.Is(err, errAccountRateLimit) {
-					s.metrics.HandleAccountRateLimitedRequest(quorumId, blobSize, "DisperseBlob")
+					s.metrics.HandleAccountRateLimitedRequest(quorumId, blobSize, apiMethodName)
 				} else {
-					s.metrics.HandleFailedRequest(quorumId, blobSize, "DisperseBlob")
+					s.metrics.HandleFailedRequest(quorumId, blobSize, apiMethodName)
 				}
 			}
 			return nil, err
 		}
 	}
 
-	requestedAt := uint64(time.Now().UnixNano())
+	return s.storeBlob(ctx, blob, uint64(time.Now().UnixNano()), apiMethodName)
+}
+
+// getDuplicateBlobReply returns the reply for an earlier request of the same blob if the blob is a
+// duplicate, i.e. it has the same account, data and security params as a blob requested within the
+// duplicate window that hasn't failed. Otherwise, it returns nil.
+// Concurrent duplicates may not be detected, since the blob isn't stored until it passes rate limiting.
+func (s *DispersalServer) getDuplicateBlobReply(ctx context.Context, blob *core.Blob) *pb.DisperseBlobReply {
+	if s.config.DuplicateWindow <= 0 {
+		return nil
+	}
+
+	metadatas, err := s.blobStore.GetAllBlobMetadataByContent(ctx, blob.Data)
+	if err != nil {
+		// Failing to check for duplicates shouldn't fail the request
+		s.logger.Warn("failed to check for duplicate blobs", "err", err)
+		return nil
+	}
+
+	cutoff := uint64(time.Now().Add(-s.config.DuplicateWindow).UnixNano())
+	var latest *disperser.BlobMetadata
+	for _, metadata := range metadatas {
+		if metadata.RequestMetadata == nil || metadata.RequestMetadata.RequestedAt < cutoff {
+			continue
+		}
+		// A blob that failed or was cancelled should be dispersed again
+		if metadata.BlobStatus == disperser.Failed || metadata.BlobStatus == disperser.InsufficientSignatures || metadata.BlobStatus == disperser.Cancelled {
+			continue
+		}
+		if metadata.RequestMetadata.AccountID != blob.RequestHeader.AccountID {
+			continue
+		}
+		if !isSameSecurityParams(metadata.RequestMetadata.SecurityParams, blob.RequestHeader.SecurityParams) {
+			continue
+		}
+		if latest == nil || metadata.RequestMetadata.RequestedAt > latest.RequestMetadata.RequestedAt {
+			latest = metadata
+		}
+	}
+	if latest == nil {
+		return nil
+	}
+
+	metadataKey := latest.GetBlobKey()
+	s.logger.Info("received a duplicate blob", "key", metadataKey.String(), "accountID", blob.RequestHeader.AccountID)
+	return &pb.DisperseBlobReply{
+		Result:    getResponseStatus(latest.BlobStatus),
+		RequestId: []byte(metadataKey.String()),
+	}
+}
+
+// isSameSecurityParams returns whether a and b have the same params for the same quorums, in any order.
+func isSameSecurityParams(a, b []*core.SecurityParam) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	params := make(map[core.QuorumID]*core.SecurityParam, len(a))
+	for _, param := range a {
+		params[param.QuorumID] = param
+	}
+	for _, param := range b {
+		other, ok := params[param.QuorumID]
+		if !ok || other.AdversaryThreshold != param.AdversaryThreshold || other.QuorumThreshold != param.QuorumThreshold {
+			return false
+		}
+	}
+	return true
+}
+
+// storeBlob stores a blob that passed validation and rate limiting, and records the result in the metrics.
+func (s *DispersalServer) storeBlob(ctx context.Context, blob *core.Blob, requestedAt uint64, apiMethodName string) (*pb.DisperseBlobReply, error) {
+	securityParams := blob.RequestHeader.SecurityParams
+	blobSize := len(blob.Data)
+
 	metadataKey, err := s.blobStore.StoreBlob(ctx, blob, requestedAt)
 	if err != nil {
 		for _, param := range securityParams {
-			quorumId := string(uint8(param.GetQuorumId()))
-			s.metrics.HandleFailedRequest(quorumId, blobSize, "DisperseBlob")
+			quorumId := string(param.QuorumID)
+			s.metrics.HandleFailedRequest(quorumId, blobSize, apiMethodName)
 		}
 		return nil, err
 	}
 
 	for _, param := range securityParams {
-		quorumId := string(uint8(param.GetQuorumId()))
-		s.metrics.HandleSuccessfulRequest(quorumId, blobSize, "DisperseBlob")
+		quorumId := string(param.QuorumID)
+		s.metrics.HandleSuccessfulRequest(quorumId, blobSize, apiMethodName)
 	}
 
-	s.logger.Info("received a new blob: ", "key", metadataKey.String())
+	s.logger.Info("received a new blob: ", "key", metadataKey.String(), "accountID", blob.RequestHeader.AccountID)
 	return &pb.DisperseBlobReply{
 		Result:    pb.BlobStatus_PROCESSING,
 		RequestId: []byte(metadataKey.String()),
 	}, nil
 }
 
-func (s *DispersalServer) checkRateLimitsAndAddRates(ctx context.Context, blob *core.Blob, origin string) error {
+// checkRateLimitsAndAddRates checks the system and per-account rate limits for the blobs.
+// The rates are checked against the total encoded size of the blobs in each quorum, so either
+// all blobs are allowed or none are.
+// The account is taken from blob.RequestHeader.AccountID, which must be set by the caller and be
+// the same for all blobs.
+func (s *DispersalServer) checkRateLimitsAndAddRates(ctx context.Context, blobs []*core.Blob, origin string) error {
 
 	// TODO(robert): Remove these locks once we have resolved ratelimiting approach
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	for _, param := range blob.RequestHeader.SecurityParams {
+	if len(blobs) == 0 {
+		return nil
+	}
+	accountID := blobs[0].RequestHeader.AccountID
+
+	// Sum up the encoded size of the blobs in each quorum
+	quorumIDs := make([]core.QuorumID, 0)
+	encodedSizes := make(map[core.QuorumID]uint)
+	for _, blob := range blobs {
+		for _, param := range blob.RequestHeader.SecurityParams {
+			// Get the encoded blob size from the blob header. Calculation is done in a way that nodes can replicate
+			length := core.GetBlobLength(uint(len(blob.Data)))
+			encodedLength := core.GetEncodedBlobLength(length, param.QuorumThreshold, param.AdversaryThreshold)
+
+			if _, ok := encodedSizes[param.QuorumID]; !ok {
+				quorumIDs = append(quorumIDs, param.QuorumID)
+			}
+			encodedSizes[param.QuorumID] += core.GetBlobSize(encodedLength)
+		}
+	}
+
+	for _, quorumID := range quorumIDs {
 
-		rates, ok := s.rateConfig.QuorumRateInfos[param.QuorumID]
+		rates, ok := s.rateConfig.QuorumRateInfos[quorumID]
 		if !ok {
-			return fmt.Errorf("no configured rate exists for quorum %d", param.QuorumID)
+			return fmt.Errorf("no configured rate exists for quorum %d", quorumID)
 		}
 
-		// Get the encoded blob size from the blob header. Calculation is done in a way that nodes can replicate
-		blobSize := len(blob.Data)
-		length := core.GetBlobLength(uint(blobSize))
-		encodedLength := core.GetEncodedBlobLength(length, uint8(blob.RequestHeader.SecurityParams[param.QuorumID].QuorumThreshold), uint8(blob.RequestHeader.SecurityParams[param.QuorumID].AdversaryThreshold))
-		encodedSize := core.GetBlobSize(encodedLength)
+		encodedSize := encodedSizes[quorumID]
 
-		s.logger.Debug("checking rate limits", "origin", origin, "quorum", param.QuorumID, "encodedSize", encodedSize, "blobSize", blobSize)
+		s.logger.Debug("checking rate limits", "origin", origin, "accountID", accountID, "quorum", quorumID, "encodedSize", encodedSize, "numBlobs", len(blobs))
 
 		// Check System Ratelimit
-		systemQuorumKey := fmt.Sprintf("%s:%d", systemAccountKey, param.QuorumID)
+		systemQuorumKey := fmt.Sprintf("%s:%d", systemAccountKey, quorumID)
 		allowed, err := s.ratelimiter.AllowRequest(ctx, systemQuorumKey, encodedSize, rates.TotalUnauthThroughput)
 		if err != nil {
 			return fmt.Errorf("ratelimiter error: %v", err)
@@ -205,9 +666,7 @@ func (s *DispersalServer) checkRateLimitsAndAddRates(ctx context.Context, blob *
 			return errSystemRateLimit
 		}
 
-		blob.RequestHeader.AccountID = "ip:" + origin
-
-		userQuorumKey := fmt.Sprintf("%s:%d", blob.RequestHeader.AccountID, param.QuorumID)
+		userQuorumKey := fmt.Sprintf("%s:%d", accountID, quorumID)
 		allowed, err = s.ratelimiter.AllowRequest(ctx, userQuorumKey, encodedSize, rates.PerUserUnauthThroughput)
 		if err != nil {
 			return fmt.Errorf("ratelimiter error: %v", err)
@@ -217,13 +676,159 @@ func (s *DispersalServer) checkRateLimitsAndAddRates(ctx context.Context, blob *
 			return errAccountRateLimit
 		}
 
-		// Update the quorum rate
-		blob.RequestHeader.SecurityParams[param.QuorumID].QuorumRate = rates.PerUserUnauthThroughput
+	}
+
+	// Update the quorum rates
+	for _, blob := range blobs {
+		for _, param := range blob.RequestHeader.SecurityParams {
+			param.QuorumRate = s.rateConfig.QuorumRateInfos[param.QuorumID].PerUserUnauthThroughput
+		}
 	}
 	return nil
 
 }
 
+func (s *DispersalServer) CancelBlob(ctx context.Context, req *pb.CancelBlobRequest) (*pb.CancelBlobReply, error) {
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(f float64) {
+		s.metrics.ObserveLatency("CancelBlob", f*1000) // make milliseconds
+	}))
+	defer timer.ObserveDuration()
+
+	metadata, err := s.getBlobToCancel(ctx, req, "CancelBlob")
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the address that dispersed the blob can cancel it
+	accountID := metadata.RequestMetadata.AccountID
+	if !strings.HasPrefix(accountID, "ip:") {
+		s.metrics.IncrementFailedBlobRequestNum("", "CancelBlob")
+		return nil, errors.New("invalid request: blobs dispersed with DisperseBlobAuthenticated must be cancelled with CancelBlobAuthenticated")
+	}
+	origin, err := common.GetClientAddress(ctx, s.rateConfig.ClientIPHeader, 1, true)
+	if err != nil {
+		s.metrics.IncrementFailedBlobRequestNum("", "CancelBlob")
+		return nil, err
+	}
+	if accountID != "ip:"+origin {
+		s.logger.Warn("failed to authorize blob cancellation", "requestID", string(req.GetRequestId()), "origin", origin)
+		s.metrics.IncrementFailedBlobRequestNum("", "CancelBlob")
+		return nil, errors.New("permission denied: the blob was dispersed by a different account")
+	}
+
+	return s.cancelBlob(ctx, metadata, "CancelBlob")
+}
+
+func (s *DispersalServer) CancelBlobAuthenticated(stream pb.Disperser_CancelBlobAuthenticatedServer) error {
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(f float64) {
+		s.metrics.ObserveLatency("CancelBlobAuthenticated", f*1000) // make milliseconds
+	}))
+	defer timer.ObserveDuration()
+
+	ctx := stream.Context()
+
+	// Process the cancel request
+	in, err := stream.Recv()
+	if err != nil {
+		return fmt.Errorf("error receiving next message: %w", err)
+	}
+
+	request, ok := in.GetPayload().(*pb.AuthenticatedCancelRequest_CancelRequest)
+	if !ok {
+		return errors.New("invalid request: expected CancelBlobRequest")
+	}
+
+	metadata, err := s.getBlobToCancel(ctx, request.CancelRequest, "CancelBlobAuthenticated")
+	if err != nil {
+		return err
+	}
+	accountID := metadata.RequestMetadata.AccountID
+	if strings.HasPrefix(accountID, "ip:") {
+		s.metrics.IncrementFailedBlobRequestNum("", "CancelBlobAuthenticated")
+		return errors.New("invalid request: blobs that weren't dispersed with DisperseBlobAuthenticated must be cancelled with CancelBlob")
+	}
+
+	// Send back the challenge to the client
+	challenge, err := getChallengeNonce()
+	if err != nil {
+		return fmt.Errorf("failed to generate challenge: %w", err)
+	}
+	err = stream.Send(&pb.AuthenticatedCancelReply{Payload: &pb.AuthenticatedCancelReply_BlobAuthHeader{
+		BlobAuthHeader: &pb.BlobAuthHeader{
+			ChallengeParameter: challenge,
+		},
+	}})
+	if err != nil {
+		return err
+	}
+
+	// Wait for the client to sign the challenge
+	in, err = receiveWithTimeout(stream.Context(), stream.Recv, authenticationTimeout)
+	if err != nil {
+		return fmt.Errorf("error receiving authentication data: %w", err)
+	}
+
+	challengeReply, ok := in.GetPayload().(*pb.AuthenticatedCancelRequest_AuthenticationData)
+	if !ok {
+		return errors.New("invalid request: expected AuthenticationData")
+	}
+
+	requestID := request.CancelRequest.GetRequestId()
+	header := core.NewCancelBlobAuthHeader(accountID, requestID, challenge, challengeReply.AuthenticationData.GetAuthenticationData())
+	if err := s.authenticator.AuthenticateBlobRequest(header); err != nil {
+		s.logger.Warn("failed to authorize blob cancellation", "requestID", string(requestID), "err", err)
+		s.metrics.IncrementFailedBlobRequestNum("", "CancelBlobAuthenticated")
+		return fmt.Errorf("permission denied: failed to authenticate cancellation: %w", err)
+	}
+
+	reply, err := s.cancelBlob(ctx, metadata, "CancelBlobAuthenticated")
+	if err != nil {
+		return err
+	}
+
+	return stream.Send(&pb.AuthenticatedCancelReply{Payload: &pb.AuthenticatedCancelReply_CancelReply{
+		CancelReply: reply,
+	}})
+}
+
+// getBlobToCancel looks up the metadata of the blob that the cancel request refers to
+func (s *DispersalServer) getBlobToCancel(ctx context.Context, req *pb.CancelBlobRequest, apiMethodName string) (*disperser.BlobMetadata, error) {
+	requestID := req.GetRequestId()
+	if len(requestID) == 0 {
+		return nil, fmt.Errorf("invalid request: request_id must not be empty")
+	}
+
+	s.logger.Info("received a new blob cancellation request", "requestID", string(requestID))
+	metadataKey, err := disperser.ParseBlobKey(string(requestID))
+	if err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	metadata, err := s.blobStore.GetBlobMetadata(ctx, metadataKey)
+	if err != nil {
+		s.metrics.IncrementFailedBlobRequestNum("", apiMethodName)
+		return nil, err
+	}
+	return metadata, nil
+}
+
+// cancelBlob cancels a blob once the cancellation is authorized
+func (s *DispersalServer) cancelBlob(ctx context.Context, metadata *disperser.BlobMetadata, apiMethodName string) (*pb.CancelBlobReply, error) {
+	err := s.blobStore.MarkBlobCancelled(ctx, metadata.GetBlobKey())
+	if err != nil {
+		s.metrics.IncrementFailedBlobRequestNum("", apiMethodName)
+		if errors.Is(err, disperser.ErrBlobNotProcessing) {
+			return nil, fmt.Errorf("invalid request: %w", err)
+		}
+		return nil, err
+	}
+
+	s.metrics.IncrementSuccessfulBlobRequestNum("", apiMethodName)
+	return &pb.CancelBlobReply{
+		Status: pb.BlobStatus_CANCELLED,
+	}, nil
+}
+
 func (s *DispersalServer) GetBlobStatus(ctx context.Context, req *pb.BlobStatusRequest) (*pb.BlobStatusReply, error) {
 	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(f float64) {
 		s.metrics.ObserveLatency("GetBlobStatus", f*1000) // make milliseconds
@@ -247,75 +852,152 @@ func (s *DispersalServer) GetBlobStatus(ctx context.Context, req *pb.BlobStatusR
 		return nil, err
 	}
 
-	isConfirmed, err := metadata.IsConfirmed()
-	if err != nil {
-		return nil, err
+	return getBlobStatusReply(metadata)
+}
+
+func (s *DispersalServer) GetBlobStatuses(ctx context.Context, req *pb.BlobStatusesRequest) (*pb.BlobStatusesReply, error) {
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(f float64) {
+		s.metrics.ObserveLatency("GetBlobStatuses", f*1000) // make milliseconds
+	}))
+	defer timer.ObserveDuration()
+
+	requestIDs := req.GetRequestIds()
+	if len(requestIDs) == 0 {
+		return nil, fmt.Errorf("invalid request: request_ids must not be empty")
+	}
+	if len(requestIDs) > maxNumBlobsPerRequest {
+		return nil, fmt.Errorf("invalid request: the number of request_ids must not exceed %d", maxNumBlobsPerRequest)
 	}
 
-	s.logger.Debug("isConfirmed", "metadata", metadata, "isConfirmed", isConfirmed)
-	if isConfirmed {
-		confirmationInfo := metadata.ConfirmationInfo
-		commit, err := confirmationInfo.BlobCommitment.Commitment.Serialize()
+	s.logger.Info("received a new multi-blob status request", "numBlobs", len(requestIDs))
+	results := make([]*pb.BlobStatusResult, len(requestIDs))
+	blobKeys := make([]disperser.BlobKey, len(requestIDs))
+	// The keys are deduplicated, as a batch read of the blob store can't contain the same key twice
+	uniqueKeys := make([]disperser.BlobKey, 0, len(requestIDs))
+	seenKeys := make(map[disperser.BlobKey]struct{}, len(requestIDs))
+	for i, requestID := range requestIDs {
+		if len(requestID) == 0 {
+			results[i] = &pb.BlobStatusResult{Error: "invalid request: request_id must not be empty"}
+			continue
+		}
+		blobKey, err := disperser.ParseBlobKey(string(requestID))
 		if err != nil {
-			return nil, err
+			results[i] = &pb.BlobStatusResult{Error: fmt.Sprintf("invalid request: %v", err)}
+			continue
+		}
+		blobKeys[i] = blobKey
+		if _, ok := seenKeys[blobKey]; !ok {
+			seenKeys[blobKey] = struct{}{}
+			uniqueKeys = append(uniqueKeys, blobKey)
 		}
+	}
 
-		dataLength := uint32(confirmationInfo.BlobCommitment.Length)
-		quorumInfos := confirmationInfo.BlobQuorumInfos
-		blobQuorumParams := make([]*pb.BlobQuorumParam, len(quorumInfos))
-		quorumNumbers := make([]byte, len(quorumInfos))
-		quorumPercentSigned := make([]byte, len(quorumInfos))
-		quorumIndexes := make([]byte, len(quorumInfos))
-		for i, quorumInfo := range quorumInfos {
-			blobQuorumParams[i] = &pb.BlobQuorumParam{
-				QuorumNumber:                 uint32(quorumInfo.QuorumID),
-				AdversaryThresholdPercentage: uint32(quorumInfo.AdversaryThreshold),
-				QuorumThresholdPercentage:    uint32(quorumInfo.QuorumThreshold),
-				QuantizationParam:            uint32(quorumInfo.QuantizationFactor),
-				EncodedLength:                uint64(quorumInfo.EncodedBlobLength),
-			}
-			quorumNumbers[i] = quorumInfo.QuorumID
-			quorumPercentSigned[i] = confirmationInfo.QuorumResults[quorumInfo.QuorumID].PercentSigned
-			quorumIndexes[i] = byte(i)
+	metadatas := make(map[disperser.BlobKey]*disperser.BlobMetadata, len(uniqueKeys))
+	if len(uniqueKeys) > 0 {
+		bulkMetadata, err := s.blobStore.GetBulkBlobMetadata(ctx, uniqueKeys)
+		if err != nil {
+			return nil, err
 		}
+		for _, metadata := range bulkMetadata {
+			metadatas[metadata.GetBlobKey()] = metadata
+		}
+	}
 
-		return &pb.BlobStatusReply{
-			Status: getResponseStatus(metadata.BlobStatus),
-			Info: &pb.BlobInfo{
-				BlobHeader: &pb.BlobHeader{
-					Commitment:       commit,
-					DataLength:       dataLength,
-					BlobQuorumParams: blobQuorumParams,
-				},
-				BlobVerificationProof: &pb.BlobVerificationProof{
-					BatchId:   confirmationInfo.BatchID,
-					BlobIndex: confirmationInfo.BlobIndex,
-					BatchMetadata: &pb.BatchMetadata{
-						BatchHeader: &pb.BatchHeader{
-							BatchRoot:               confirmationInfo.BatchRoot,
-							QuorumNumbers:           quorumNumbers,
-							QuorumSignedPercentages: quorumPercentSigned,
-							ReferenceBlockNumber:    confirmationInfo.ReferenceBlockNumber,
-						},
-						SignatoryRecordHash:     confirmationInfo.SignatoryRecordHash[:],
-						Fee:                     confirmationInfo.Fee,
-						ConfirmationBlockNumber: confirmationInfo.ConfirmationBlockNumber,
-						BatchHeaderHash:         confirmationInfo.BatchHeaderHash[:],
-					},
-					InclusionProof: confirmationInfo.BlobInclusionProof,
-					// ref: api/proto/disperser/disperser.proto:BlobVerificationProof.quorum_indexes
-					QuorumIndexes: quorumIndexes,
-				},
-			},
-		}, nil
+	for i := range requestIDs {
+		if results[i] != nil {
+			continue
+		}
+		metadata, ok := metadatas[blobKeys[i]]
+		if !ok {
+			results[i] = &pb.BlobStatusResult{Error: disperser.ErrBlobNotFound.Error()}
+			continue
+		}
+		reply, err := getBlobStatusReply(metadata)
+		if err != nil {
+			results[i] = &pb.BlobStatusResult{Error: err.Error()}
+			continue
+		}
+		results[i] = &pb.BlobStatusResult{Reply: reply}
 	}
 
-	return &pb.BlobStatusReply{
-		Status: getResponseStatus(metadata.BlobStatus),
-		Info:   &pb.BlobInfo{},
+	return &pb.BlobStatusesReply{
+		Results: results,
 	}, nil
 }
 
+func (s *DispersalServer) SubscribeBlobStatus(req *pb.BlobStatusRequest, stream pb.Disperser_SubscribeBlobStatusServer) error {
+	requestID := req.GetRequestId()
+	if len(requestID) == 0 {
+		return fmt.Errorf("invalid request: request_id must not be empty")
+	}
+
+	s.logger.Info("received a new blob status subscription", "requestID", string(requestID))
+	metadataKey, err := disperser.ParseBlobKey(string(requestID))
+	if err != nil {
+		return err
+	}
+
+	origin, err := common.GetClientAddress(stream.Context(), s.rateConfig.ClientIPHeader, 1, true)
+	if err != nil {
+		s.metrics.IncrementFailedBlobRequestNum("", "SubscribeBlobStatus")
+		return err
+	}
+
+	// The status of the blob is polled for all the streams together, and the stream waits for its updates.
+	// It's subscribed before the first read, so that no transition is missed.
+	sub, err := s.statusWatcher.subscribe(metadataKey, origin)
+	if err != nil {
+		s.metrics.HandleSystemRateLimitedRequest("", 0, "SubscribeBlobStatus")
+		return err
+	}
+	defer s.statusWatcher.unsubscribe(sub)
+
+	maxDuration := s.config.MaxStatusStreamDuration
+	if maxDuration <= 0 {
+		maxDuration = disperser.DefaultMaxStatusStreamDuration
+	}
+	ctx, cancel := context.WithTimeout(stream.Context(), maxDuration)
+	defer cancel()
+
+	metadata, err := s.blobStore.GetBlobMetadata(ctx, metadataKey)
+	if err != nil {
+		s.metrics.IncrementFailedBlobRequestNum("", "SubscribeBlobStatus")
+		return err
+	}
+
+	var lastStatus *disperser.BlobStatus
+	for {
+		// Only push the status to the client when it has transitioned
+		if lastStatus == nil || *lastStatus != metadata.BlobStatus {
+			reply, err := getBlobStatusReply(metadata)
+			if err != nil {
+				s.metrics.IncrementFailedBlobRequestNum("", "SubscribeBlobStatus")
+				return err
+			}
+			if err := stream.Send(reply); err != nil {
+				s.metrics.IncrementFailedBlobRequestNum("", "SubscribeBlobStatus")
+				return err
+			}
+			status := metadata.BlobStatus
+			lastStatus = &status
+		}
+
+		if isTerminalStatus(metadata.BlobStatus) {
+			s.metrics.IncrementSuccessfulBlobRequestNum("", "SubscribeBlobStatus")
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			if stream.Context().Err() == nil {
+				return errStatusStreamExpired
+			}
+			return ctx.Err()
+		case metadata = <-sub.updates:
+		}
+	}
+}
+
 func (s *DispersalServer) RetrieveBlob(ctx context.Context, req *pb.RetrieveBlobRequest) (*pb.RetrieveBlobReply, error) {
 	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(f float64) {
 		s.metrics.ObserveLatency("RetrieveBlob", f*1000) // make milliseconds
@@ -410,11 +1092,126 @@ func getResponseStatus(status disperser.BlobStatus) pb.BlobStatus {
 		return pb.BlobStatus_FINALIZED
 	case disperser.InsufficientSignatures:
 		return pb.BlobStatus_INSUFFICIENT_SIGNATURES
+	case disperser.Cancelled:
+		return pb.BlobStatus_CANCELLED
 	default:
 		return pb.BlobStatus_UNKNOWN
 	}
 }
 
+// getBlobStatusDetails returns the processing details of a blob. The confirmation details are only set if the
+// blob was included in a confirmed batch.
+func getBlobStatusDetails(metadata *disperser.BlobMetadata) *pb.BlobStatusDetails {
+	details := &pb.BlobStatusDetails{
+		NumRetries: uint32(metadata.NumRetries),
+	}
+	if failure := metadata.LastFailure(); failure != nil {
+		details.FailureReason = failure.Error
+	}
+	if metadata.RequestMetadata != nil {
+		details.RequestedAt = metadata.RequestMetadata.RequestedAt
+	}
+
+	confirmationInfo := metadata.ConfirmationInfo
+	if confirmationInfo == nil {
+		return details
+	}
+	details.ConfirmationTxnHash = confirmationInfo.ConfirmationTxnHash.Bytes()
+	details.ConfirmationBlockNumber = confirmationInfo.ConfirmationBlockNumber
+	details.QuorumResults = make([]*pb.QuorumResult, 0, len(confirmationInfo.QuorumResults))
+	for quorumID, result := range confirmationInfo.QuorumResults {
+		details.QuorumResults = append(details.QuorumResults, &pb.QuorumResult{
+			QuorumId:      uint32(quorumID),
+			PercentSigned: uint32(result.PercentSigned),
+		})
+	}
+	sort.Slice(details.QuorumResults, func(i, j int) bool {
+		return details.QuorumResults[i].QuorumId < details.QuorumResults[j].QuorumId
+	})
+	return details
+}
+
+func getBlobStatusReply(metadata *disperser.BlobMetadata) (*pb.BlobStatusReply, error) {
+	isConfirmed, err := metadata.IsConfirmed()
+	if err != nil {
+		return nil, err
+	}
+
+	if isConfirmed {
+		confirmationInfo := metadata.ConfirmationInfo
+		commit, err := confirmationInfo.BlobCommitment.Commitment.Serialize()
+		if err != nil {
+			return nil, err
+		}
+
+		dataLength := uint32(confirmationInfo.BlobCommitment.Length)
+		quorumInfos := confirmationInfo.BlobQuorumInfos
+		blobQuorumParams := make([]*pb.BlobQuorumParam, len(quorumInfos))
+		quorumNumbers := make([]byte, len(quorumInfos))
+		quorumPercentSigned := make([]byte, len(quorumInfos))
+		quorumIndexes := make([]byte, len(quorumInfos))
+		for i, quorumInfo := range quorumInfos {
+			blobQuorumParams[i] = &pb.BlobQuorumParam{
+				QuorumNumber:                 uint32(quorumInfo.QuorumID),
+				AdversaryThresholdPercentage: uint32(quorumInfo.AdversaryThreshold),
+				QuorumThresholdPercentage:    uint32(quorumInfo.QuorumThreshold),
+				QuantizationParam:            uint32(quorumInfo.QuantizationFactor),
+				EncodedLength:                uint64(quorumInfo.EncodedBlobLength),
+			}
+			quorumNumbers[i] = quorumInfo.QuorumID
+			quorumPercentSigned[i] = confirmationInfo.QuorumResults[quorumInfo.QuorumID].PercentSigned
+			quorumIndexes[i] = byte(i)
+		}
+
+		return &pb.BlobStatusReply{
+			Status: getResponseStatus(metadata.BlobStatus),
+			Info: &pb.BlobInfo{
+				BlobHeader: &pb.BlobHeader{
+					Commitment:       commit,
+					DataLength:       dataLength,
+					BlobQuorumParams: blobQuorumParams,
+				},
+				BlobVerificationProof: &pb.BlobVerificationProof{
+					BatchId:   confirmationInfo.BatchID,
+					BlobIndex: confirmationInfo.BlobIndex,
+					BatchMetadata: &pb.BatchMetadata{
+						BatchHeader: &pb.BatchHeader{
+							BatchRoot:               confirmationInfo.BatchRoot,
+							QuorumNumbers:           quorumNumbers,
+							QuorumSignedPercentages: quorumPercentSigned,
+							ReferenceBlockNumber:    confirmationInfo.ReferenceBlockNumber,
+						},
+						SignatoryRecordHash:     confirmationInfo.SignatoryRecordHash[:],
+						Fee:                     confirmationInfo.Fee,
+						ConfirmationBlockNumber: confirmationInfo.ConfirmationBlockNumber,
+						BatchHeaderHash:         confirmationInfo.BatchHeaderHash[:],
+					},
+					InclusionProof: confirmationInfo.BlobInclusionProof,
+					// ref: api/proto/disperser/disperser.proto:BlobVerificationProof.quorum_indexes
+					QuorumIndexes: quorumIndexes,
+				},
+			},
+			Details: getBlobStatusDetails(metadata),
+		}, nil
+	}
+
+	return &pb.BlobStatusReply{
+		Status:  getResponseStatus(metadata.BlobStatus),
+		Info:    &pb.BlobInfo{},
+		Details: getBlobStatusDetails(metadata),
+	}, nil
+}
+
+// isTerminalStatus returns true if the blob won't transition to any other status
+func isTerminalStatus(status disperser.BlobStatus) bool {
+	switch status {
+	case disperser.Failed, disperser.Finalized, disperser.InsufficientSignatures, disperser.Cancelled:
+		return true
+	default:
+		return false
+	}
+}
+
 func getBlobFromRequest(req *pb.DisperseBlobRequest) *core.Blob {
 	params := make([]*core.SecurityParam, len(req.SecurityParams))
 
@@ -431,9 +1228,43 @@ func getBlobFromRequest(req *pb.DisperseBlobRequest) *core.Blob {
 	blob := &core.Blob{
 		RequestHeader: core.BlobRequestHeader{
 			SecurityParams: params,
+			CallbackURL:    req.GetCallbackUrl(),
 		},
 		Data: data,
 	}
 
 	return blob
 }
+
+// getChallengeNonce returns a random nonce for the client to sign in DisperseBlobAuthenticated.
+func getChallengeNonce() (uint32, error) {
+	var buf [4]byte
+	if _, err := rand.Read(buf[:]); err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint32(buf[:]), nil
+}
+
+// receiveWithTimeout waits for the next message on the stream, giving up after the timeout.
+// Returning from the handler cancels the stream, which unblocks the pending Recv.
+func receiveWithTimeout[T any](ctx context.Context, recv func() (T, error), timeout time.Duration) (T, error) {
+	type result struct {
+		req T
+		err error
+	}
+	ch := make(chan result, 1)
+	go func() {
+		req, err := recv()
+		ch <- result{req: req, err: err}
+	}()
+
+	var zero T
+	select {
+	case r := <-ch:
+		return r.req, r.err
+	case <-time.After(timeout):
+		return zero, fmt.Errorf("timed out after %v", timeout)
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+}
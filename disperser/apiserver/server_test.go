@@ -4,14 +4,17 @@ import (
 	"context"
 	"crypto/rand"
 	"fmt"
+	"io"
 	"net"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/Layr-Labs/eigenda/disperser/apiserver"
 	"github.com/Layr-Labs/eigenda/disperser/common/blobstore"
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/google/uuid"
 
 	pb "github.com/Layr-Labs/eigenda/api/grpc/disperser"
@@ -21,6 +24,7 @@ import (
 	"github.com/Layr-Labs/eigenda/common/aws/s3"
 	"github.com/Layr-Labs/eigenda/common/logging"
 	"github.com/Layr-Labs/eigenda/core"
+	"github.com/Layr-Labs/eigenda/core/auth"
 	"github.com/Layr-Labs/eigenda/core/mock"
 	"github.com/Layr-Labs/eigenda/disperser"
 	"github.com/Layr-Labs/eigenda/disperser/batcher"
@@ -29,6 +33,7 @@ import (
 	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
 	"github.com/ory/dockertest/v3"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/peer"
 )
 
@@ -62,6 +67,73 @@ func TestDisperseBlob(t *testing.T) {
 	assert.NotNil(t, key)
 }
 
+func TestDisperseBlobWithIntakePaused(t *testing.T) {
+	data := make([]byte, 1024)
+	_, err := rand.Read(data)
+	assert.NoError(t, err)
+
+	dispersalServer.PauseIntake()
+	assert.True(t, dispersalServer.IsIntakePaused())
+	_, err = dispersalServer.DisperseBlob(context.Background(), &pb.DisperseBlobRequest{
+		Data:           data,
+		SecurityParams: []*pb.SecurityParams{{QuorumId: 0, AdversaryThreshold: 50, QuorumThreshold: 100}},
+	})
+	assert.ErrorContains(t, err, "blob intake is paused")
+	_, err = dispersalServer.DisperseBlobs(context.Background(), &pb.DisperseBlobsRequest{
+		Blobs: []*pb.DisperseBlobRequest{{Data: data}},
+	})
+	assert.ErrorContains(t, err, "blob intake is paused")
+
+	dispersalServer.ResumeIntake()
+	assert.False(t, dispersalServer.IsIntakePaused())
+	status, _, _ := disperseBlob(t, dispersalServer, data)
+	assert.Equal(t, pb.BlobStatus_PROCESSING, status)
+}
+
+func TestDisperseBlobDuplicate(t *testing.T) {
+	data := make([]byte, 1024)
+	_, err := rand.Read(data)
+	assert.NoError(t, err)
+
+	status, _, key := disperseBlob(t, dispersalServer, data)
+	assert.Equal(t, pb.BlobStatus_PROCESSING, status)
+
+	// Retrying the same request returns the existing request ID
+	status, _, retryKey := disperseBlob(t, dispersalServer, data)
+	assert.Equal(t, pb.BlobStatus_PROCESSING, status)
+	assert.Equal(t, key, retryKey)
+
+	// Different security params are a different request
+	p := &peer.Peer{
+		Addr: &net.TCPAddr{
+			IP:   net.ParseIP("0.0.0.0"),
+			Port: 51001,
+		},
+	}
+	ctx := peer.NewContext(context.Background(), p)
+	reply, err := dispersalServer.DisperseBlob(ctx, &pb.DisperseBlobRequest{
+		Data: data,
+		SecurityParams: []*pb.SecurityParams{
+			{
+				QuorumId:           0,
+				AdversaryThreshold: 50,
+				QuorumThreshold:    100,
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.NotEqual(t, key, reply.RequestId)
+
+	// A failed blob is dispersed again
+	metadataKey, err := disperser.ParseBlobKey(string(key))
+	assert.NoError(t, err)
+	err = queue.MarkBlobFailed(context.Background(), metadataKey, &disperser.BlobFailure{Stage: disperser.ConfirmationStage, Error: "test failure"})
+	assert.NoError(t, err)
+	status, _, retryKey = disperseBlob(t, dispersalServer, data)
+	assert.Equal(t, pb.BlobStatus_PROCESSING, status)
+	assert.NotEqual(t, key, retryKey)
+}
+
 func TestDisperseBlobWithInvalidQuorum(t *testing.T) {
 	data := make([]byte, 1024)
 	_, err := rand.Read(data)
@@ -105,6 +177,57 @@ func TestDisperseBlobWithInvalidQuorum(t *testing.T) {
 	assert.ErrorContains(t, err, "invalid request: security_params must not contain duplicate quorum_id")
 }
 
+func TestDisperseBlobWithCallbackURL(t *testing.T) {
+	data := make([]byte, 1024)
+	_, err := rand.Read(data)
+	assert.NoError(t, err)
+
+	p := &peer.Peer{
+		Addr: &net.TCPAddr{
+			IP:   net.ParseIP("0.0.0.0"),
+			Port: 51001,
+		},
+	}
+	ctx := peer.NewContext(context.Background(), p)
+	securityParams := []*pb.SecurityParams{{QuorumId: 0, AdversaryThreshold: 80, QuorumThreshold: 100}}
+
+	invalidCallbackURLs := []string{
+		"not a url",
+		"/relative",
+		"ftp://example.com/callback",
+		"https://" + strings.Repeat("a", 2048),
+		// Hosts internal to the disperser's network
+		"http://localhost:8080/callback",
+		"http://127.0.0.1/callback",
+		"http://169.254.169.254/latest/meta-data",
+		"http://10.0.0.1/callback",
+		"http://[::1]/callback",
+		"http://[::ffff:192.168.0.1]/callback",
+		// Blocked port
+		"https://example.com:51002/callback",
+	}
+	for _, callbackURL := range invalidCallbackURLs {
+		_, err = dispersalServer.DisperseBlob(ctx, &pb.DisperseBlobRequest{
+			Data:           data,
+			SecurityParams: securityParams,
+			CallbackUrl:    callbackURL,
+		})
+		assert.ErrorContains(t, err, "invalid request: callback_url")
+	}
+
+	reply, err := dispersalServer.DisperseBlob(ctx, &pb.DisperseBlobRequest{
+		Data:           data,
+		SecurityParams: securityParams,
+		CallbackUrl:    "https://example.com/callback",
+	})
+	assert.NoError(t, err)
+	blobKey, err := disperser.ParseBlobKey(string(reply.GetRequestId()))
+	assert.NoError(t, err)
+	metadata, err := queue.GetBlobMetadata(ctx, blobKey)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.com/callback", metadata.RequestMetadata.CallbackURL)
+}
+
 func TestGetBlobStatus(t *testing.T) {
 	data := make([]byte, 1024)
 	_, err := rand.Read(data)
@@ -179,6 +302,416 @@ func TestGetBlobStatus(t *testing.T) {
 	})
 	assert.Equal(t, reply.GetInfo().GetBlobVerificationProof().GetInclusionProof(), confirmedMetadata.ConfirmationInfo.BlobInclusionProof)
 	assert.Equal(t, reply.GetInfo().GetBlobVerificationProof().GetQuorumIndexes(), quorumIndexes)
+
+	details := reply.GetDetails()
+	assert.Equal(t, confirmedMetadata.RequestMetadata.RequestedAt, details.GetRequestedAt())
+	assert.Equal(t, confirmedMetadata.ConfirmationInfo.ConfirmationTxnHash.Bytes(), details.GetConfirmationTxnHash())
+	assert.Equal(t, confirmedMetadata.ConfirmationInfo.ConfirmationBlockNumber, details.GetConfirmationBlockNumber())
+	assert.Equal(t, []*pb.QuorumResult{
+		{QuorumId: 0, PercentSigned: 100},
+		{QuorumId: 1, PercentSigned: 100},
+	}, details.GetQuorumResults())
+}
+
+func TestGetBlobStatuses(t *testing.T) {
+	data := make([]byte, 1024)
+	_, err := rand.Read(data)
+	assert.NoError(t, err)
+	_, _, requestID1 := disperseBlob(t, dispersalServer, data)
+	data = make([]byte, 1024)
+	_, err = rand.Read(data)
+	assert.NoError(t, err)
+	_, _, requestID2 := disperseBlob(t, dispersalServer, data)
+
+	// The reason of the failure is reported
+	metadataKey, err := disperser.ParseBlobKey(string(requestID2))
+	assert.NoError(t, err)
+	metadata, err := queue.GetBlobMetadata(context.Background(), metadataKey)
+	assert.NoError(t, err)
+	err = queue.IncrementBlobRetryCount(context.Background(), metadata, &disperser.BlobFailure{Stage: disperser.AggregationStage, Error: "error aggregating signatures"}, 0)
+	assert.NoError(t, err)
+	err = queue.MarkBlobFailed(context.Background(), metadataKey, &disperser.BlobFailure{Stage: disperser.ConfirmationStage, Error: "error confirming batch", Attempt: 1})
+	assert.NoError(t, err)
+
+	missingRequestID := []byte(disperser.BlobKey{BlobHash: "missing", MetadataHash: "missing"}.String())
+	reply, err := dispersalServer.GetBlobStatuses(context.Background(), &pb.BlobStatusesRequest{
+		RequestIds: [][]byte{requestID1, []byte("invalid"), requestID2, missingRequestID, requestID1},
+	})
+	assert.NoError(t, err)
+	results := reply.GetResults()
+	assert.Len(t, results, 5)
+
+	assert.Empty(t, results[0].GetError())
+	assert.Equal(t, pb.BlobStatus_PROCESSING, results[0].GetReply().GetStatus())
+	assert.Equal(t, uint32(0), results[0].GetReply().GetDetails().GetNumRetries())
+	assert.Empty(t, results[0].GetReply().GetDetails().GetFailureReason())
+	assert.NotZero(t, results[0].GetReply().GetDetails().GetRequestedAt())
+
+	assert.Nil(t, results[1].GetReply())
+	assert.Contains(t, results[1].GetError(), "invalid request")
+
+	assert.Empty(t, results[2].GetError())
+	assert.Equal(t, pb.BlobStatus_FAILED, results[2].GetReply().GetStatus())
+	assert.Equal(t, uint32(1), results[2].GetReply().GetDetails().GetNumRetries())
+	assert.Equal(t, "error confirming batch", results[2].GetReply().GetDetails().GetFailureReason())
+
+	assert.Nil(t, results[3].GetReply())
+	assert.Equal(t, disperser.ErrBlobNotFound.Error(), results[3].GetError())
+
+	// Repeated request IDs get the same result
+	assert.Empty(t, results[4].GetError())
+	assert.Equal(t, pb.BlobStatus_PROCESSING, results[4].GetReply().GetStatus())
+
+	_, err = dispersalServer.GetBlobStatuses(context.Background(), &pb.BlobStatusesRequest{})
+	assert.ErrorContains(t, err, "invalid request")
+}
+
+func TestSubscribeBlobStatus(t *testing.T) {
+	data := make([]byte, 1024)
+	_, err := rand.Read(data)
+	assert.NoError(t, err)
+
+	status, blobSize, requestID := disperseBlob(t, dispersalServer, data)
+	assert.Equal(t, status, pb.BlobStatus_PROCESSING)
+	assert.NotNil(t, requestID)
+
+	ctx, cancel := context.WithTimeout(newTestPeerContext(), 10*time.Second)
+	defer cancel()
+	stream := &mockBlobStatusStream{
+		ctx:     ctx,
+		replies: make(chan *pb.BlobStatusReply, 10),
+	}
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- dispersalServer.SubscribeBlobStatus(&pb.BlobStatusRequest{
+			RequestId: requestID,
+		}, stream)
+	}()
+
+	reply := <-stream.replies
+	assert.Equal(t, reply.GetStatus(), pb.BlobStatus_PROCESSING)
+	assert.Nil(t, reply.GetInfo().GetBlobHeader())
+
+	securityParams := []*core.SecurityParam{
+		{
+			QuorumID:           0,
+			AdversaryThreshold: 80,
+			QuorumThreshold:    100,
+		},
+		{
+			QuorumID:           1,
+			AdversaryThreshold: 80,
+			QuorumThreshold:    100,
+		},
+	}
+	confirmedMetadata := simulateBlobConfirmation(t, requestID, blobSize, securityParams, 3)
+
+	reply = <-stream.replies
+	assert.Equal(t, reply.GetStatus(), pb.BlobStatus_CONFIRMED)
+	assert.Equal(t, reply.GetInfo().GetBlobVerificationProof().GetBatchId(), confirmedMetadata.ConfirmationInfo.BatchID)
+	assert.Equal(t, reply.GetInfo().GetBlobVerificationProof().GetBlobIndex(), confirmedMetadata.ConfirmationInfo.BlobIndex)
+
+	err = queue.MarkBlobFinalized(context.Background(), confirmedMetadata.GetBlobKey())
+	assert.NoError(t, err)
+
+	reply = <-stream.replies
+	assert.Equal(t, reply.GetStatus(), pb.BlobStatus_FINALIZED)
+	assert.Equal(t, reply.GetInfo().GetBlobVerificationProof().GetBatchId(), confirmedMetadata.ConfirmationInfo.BatchID)
+
+	// The stream is closed once the blob reaches a terminal status
+	assert.NoError(t, <-errChan)
+	assert.Len(t, stream.replies, 0)
+}
+
+func TestSubscribeBlobStatusLimits(t *testing.T) {
+	server := newTestDispersalServer(disperser.ServerConfig{
+		GrpcPort:                "51001",
+		StatusPollInterval:      100 * time.Millisecond,
+		DuplicateWindow:         time.Minute,
+		MaxStatusStreamsPerIP:   1,
+		MaxStatusStreamDuration: 500 * time.Millisecond,
+	})
+
+	data := make([]byte, 1024)
+	_, err := rand.Read(data)
+	assert.NoError(t, err)
+	status, _, requestID := disperseBlob(t, server, data)
+	assert.Equal(t, status, pb.BlobStatus_PROCESSING)
+
+	ctx, cancel := context.WithTimeout(newTestPeerContext(), 10*time.Second)
+	defer cancel()
+	stream := &mockBlobStatusStream{
+		ctx:     ctx,
+		replies: make(chan *pb.BlobStatusReply, 10),
+	}
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- server.SubscribeBlobStatus(&pb.BlobStatusRequest{
+			RequestId: requestID,
+		}, stream)
+	}()
+	reply := <-stream.replies
+	assert.Equal(t, reply.GetStatus(), pb.BlobStatus_PROCESSING)
+
+	// A second stream from the same address is rejected while the first one is open
+	err = server.SubscribeBlobStatus(&pb.BlobStatusRequest{
+		RequestId: requestID,
+	}, &mockBlobStatusStream{
+		ctx:     ctx,
+		replies: make(chan *pb.BlobStatusReply, 10),
+	})
+	assert.ErrorContains(t, err, "request ratelimited")
+
+	// The first stream is closed once it reaches its max duration, which frees its slot
+	assert.ErrorContains(t, <-errChan, "max duration")
+	go func() {
+		errChan <- server.SubscribeBlobStatus(&pb.BlobStatusRequest{
+			RequestId: requestID,
+		}, stream)
+	}()
+	reply = <-stream.replies
+	assert.Equal(t, reply.GetStatus(), pb.BlobStatus_PROCESSING)
+	assert.ErrorContains(t, <-errChan, "max duration")
+}
+
+func TestDisperseBlobAuthenticated(t *testing.T) {
+	data := make([]byte, 1024)
+	_, err := rand.Read(data)
+	assert.NoError(t, err)
+
+	signer, err := auth.NewSigner("fcdfdf11f9d6f1d41deb7fdc4fd4c3b1c4c8b2e0b5cc0a1bb8fed5a8a91a6fd6")
+	assert.NoError(t, err)
+
+	stream := newMockAuthenticatedStream(signer, data)
+	err = dispersalServer.DisperseBlobAuthenticated(stream)
+	assert.NoError(t, err)
+
+	reply := stream.disperseReply
+	assert.NotNil(t, reply)
+	assert.Equal(t, pb.BlobStatus_PROCESSING, reply.Result)
+
+	metadataKey, err := disperser.ParseBlobKey(string(reply.RequestId))
+	assert.NoError(t, err)
+	metadata, err := queue.GetBlobMetadata(context.Background(), metadataKey)
+	assert.NoError(t, err)
+	assert.Equal(t, signer.GetAccountID(), metadata.RequestMetadata.AccountID)
+}
+
+func TestDisperseBlobAuthenticatedWithWrongAccount(t *testing.T) {
+	data := make([]byte, 1024)
+	_, err := rand.Read(data)
+	assert.NoError(t, err)
+
+	signer, err := auth.NewSigner("fcdfdf11f9d6f1d41deb7fdc4fd4c3b1c4c8b2e0b5cc0a1bb8fed5a8a91a6fd6")
+	assert.NoError(t, err)
+
+	stream := newMockAuthenticatedStream(signer, data)
+	// Claim to be a different account than the one signing the challenge
+	stream.accountID = "0x1aa8226f6d354380dDE75eE6B634875c4203e522"
+	err = dispersalServer.DisperseBlobAuthenticated(stream)
+	assert.ErrorContains(t, err, "failed to authenticate blob request")
+	assert.Nil(t, stream.disperseReply)
+}
+
+func TestCancelBlob(t *testing.T) {
+	data := make([]byte, 1024)
+	_, err := rand.Read(data)
+	assert.NoError(t, err)
+
+	_, _, requestID := disperseBlob(t, dispersalServer, data)
+
+	// Only the address that dispersed the blob can cancel it
+	otherPeer := &peer.Peer{
+		Addr: &net.TCPAddr{
+			IP:   net.ParseIP("1.2.3.4"),
+			Port: 51001,
+		},
+	}
+	_, err = dispersalServer.CancelBlob(peer.NewContext(context.Background(), otherPeer), &pb.CancelBlobRequest{
+		RequestId: requestID,
+	})
+	assert.ErrorContains(t, err, "permission denied")
+
+	p := &peer.Peer{
+		Addr: &net.TCPAddr{
+			IP:   net.ParseIP("0.0.0.0"),
+			Port: 51001,
+		},
+	}
+	ctx := peer.NewContext(context.Background(), p)
+	reply, err := dispersalServer.CancelBlob(ctx, &pb.CancelBlobRequest{
+		RequestId: requestID,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.BlobStatus_CANCELLED, reply.GetStatus())
+
+	statusReply, err := dispersalServer.GetBlobStatus(ctx, &pb.BlobStatusRequest{
+		RequestId: requestID,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.BlobStatus_CANCELLED, statusReply.GetStatus())
+
+	// A cancelled blob can't be cancelled again
+	_, err = dispersalServer.CancelBlob(ctx, &pb.CancelBlobRequest{
+		RequestId: requestID,
+	})
+	assert.ErrorContains(t, err, "invalid request: blob is not processing")
+
+	// Dispersing the same data again creates a new request
+	_, _, retryID := disperseBlob(t, dispersalServer, data)
+	assert.NotEqual(t, requestID, retryID)
+}
+
+func TestCancelBlobAuthenticated(t *testing.T) {
+	data := make([]byte, 1024)
+	_, err := rand.Read(data)
+	assert.NoError(t, err)
+
+	signer, err := auth.NewSigner("fcdfdf11f9d6f1d41deb7fdc4fd4c3b1c4c8b2e0b5cc0a1bb8fed5a8a91a6fd6")
+	assert.NoError(t, err)
+	stream := newMockAuthenticatedStream(signer, data)
+	err = dispersalServer.DisperseBlobAuthenticated(stream)
+	assert.NoError(t, err)
+	requestID := stream.disperseReply.GetRequestId()
+
+	// Blobs of authenticated accounts can't be cancelled by address
+	_, err = dispersalServer.CancelBlob(stream.ctx, &pb.CancelBlobRequest{
+		RequestId: requestID,
+	})
+	assert.ErrorContains(t, err, "must be cancelled with CancelBlobAuthenticated")
+
+	// The signature must be from the account that dispersed the blob
+	otherSigner, err := auth.NewSigner("0b2d9f1a9f7a5c4a6d1e4b7c9a8e3f2d1c0b9a8f7e6d5c4b3a291807f6e5d4c3")
+	assert.NoError(t, err)
+	cancelStream := newMockAuthenticatedCancelStream(otherSigner, requestID)
+	err = dispersalServer.CancelBlobAuthenticated(cancelStream)
+	assert.ErrorContains(t, err, "permission denied")
+	assert.Nil(t, cancelStream.cancelReply)
+
+	// A signature over another challenge can't be replayed
+	signature, err := signer.SignBlobRequest(core.NewCancelBlobAuthHeader(signer.GetAccountID(), requestID, 0, nil))
+	assert.NoError(t, err)
+	cancelStream = newMockAuthenticatedCancelStream(signer, requestID)
+	cancelStream.signature = signature
+	err = dispersalServer.CancelBlobAuthenticated(cancelStream)
+	assert.ErrorContains(t, err, "permission denied")
+	assert.Nil(t, cancelStream.cancelReply)
+
+	cancelStream = newMockAuthenticatedCancelStream(signer, requestID)
+	err = dispersalServer.CancelBlobAuthenticated(cancelStream)
+	assert.NoError(t, err)
+	assert.Equal(t, pb.BlobStatus_CANCELLED, cancelStream.cancelReply.GetStatus())
+
+	// Blobs that were dispersed by address are cancelled with CancelBlob
+	_, _, requestID = disperseBlob(t, dispersalServer, data)
+	cancelStream = newMockAuthenticatedCancelStream(signer, requestID)
+	err = dispersalServer.CancelBlobAuthenticated(cancelStream)
+	assert.ErrorContains(t, err, "must be cancelled with CancelBlob")
+}
+
+func TestDisperseBlobStream(t *testing.T) {
+	data := make([]byte, 1024*300)
+	_, err := rand.Read(data)
+	assert.NoError(t, err)
+
+	stream := newMockBlobUploadStream(uint32(len(data)), data, 1024*64)
+	err = dispersalServer.DisperseBlobStream(stream)
+	assert.NoError(t, err)
+	assert.Equal(t, pb.BlobStatus_PROCESSING, stream.reply.Result)
+
+	metadataKey, err := disperser.ParseBlobKey(string(stream.reply.RequestId))
+	assert.NoError(t, err)
+	content, err := queue.GetBlobContent(context.Background(), metadataKey.BlobHash)
+	assert.NoError(t, err)
+	assert.Equal(t, data, content)
+}
+
+func TestDisperseBlobStreamWithInvalidDataSize(t *testing.T) {
+	data := make([]byte, 1024)
+	_, err := rand.Read(data)
+	assert.NoError(t, err)
+
+	// More data than announced in the header
+	stream := newMockBlobUploadStream(512, data, 256)
+	err = dispersalServer.DisperseBlobStream(stream)
+	assert.ErrorContains(t, err, "received more than data_size")
+
+	// Less data than announced in the header
+	stream = newMockBlobUploadStream(2048, data, 256)
+	err = dispersalServer.DisperseBlobStream(stream)
+	assert.ErrorContains(t, err, "received 1024 bytes, but data_size is 2048")
+
+	// Larger than the max blob size
+	stream = newMockBlobUploadStream(1024*512+1, data, 256)
+	err = dispersalServer.DisperseBlobStream(stream)
+	assert.EqualError(t, err, "blob size cannot exceed 512 KiB")
+}
+
+func TestDisperseBlobs(t *testing.T) {
+	p := &peer.Peer{
+		Addr: &net.TCPAddr{
+			IP:   net.ParseIP("0.0.0.0"),
+			Port: 51001,
+		},
+	}
+	ctx := peer.NewContext(context.Background(), p)
+
+	securityParams := []*pb.SecurityParams{
+		{
+			QuorumId:           0,
+			AdversaryThreshold: 50,
+			QuorumThreshold:    100,
+		},
+	}
+	data := make([][]byte, 3)
+	for i := range data {
+		data[i] = make([]byte, 1024)
+		_, err := rand.Read(data[i])
+		assert.NoError(t, err)
+	}
+
+	reply, err := dispersalServer.DisperseBlobs(ctx, &pb.DisperseBlobsRequest{
+		Blobs: []*pb.DisperseBlobRequest{
+			{Data: data[0], SecurityParams: securityParams},
+			// Empty blob
+			{Data: []byte{}, SecurityParams: securityParams},
+			{Data: data[1], SecurityParams: securityParams},
+			// Invalid quorum
+			{Data: data[2], SecurityParams: []*pb.SecurityParams{
+				{
+					QuorumId:           2,
+					AdversaryThreshold: 50,
+					QuorumThreshold:    100,
+				},
+			}},
+			// Same data as the first blob
+			{Data: data[0], SecurityParams: securityParams},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, reply.Results, 5)
+
+	requestIDs := make(map[string]struct{})
+	for _, i := range []int{0, 2, 4} {
+		assert.Empty(t, reply.Results[i].Error)
+		assert.Equal(t, pb.BlobStatus_PROCESSING, reply.Results[i].Reply.Result)
+		requestIDs[string(reply.Results[i].Reply.RequestId)] = struct{}{}
+	}
+	assert.Len(t, requestIDs, 3)
+
+	assert.Nil(t, reply.Results[1].Reply)
+	assert.Equal(t, "blob size must be greater than 0", reply.Results[1].Error)
+	assert.Nil(t, reply.Results[3].Reply)
+	assert.Equal(t, "invalid request: the quorum_id must be in range [0, 1], but found 2", reply.Results[3].Error)
+
+	_, err = dispersalServer.DisperseBlobs(ctx, &pb.DisperseBlobsRequest{})
+	assert.ErrorContains(t, err, "blobs must not be empty")
+}
+
+func TestValidateMaxBlobSize(t *testing.T) {
+	assert.NoError(t, disperser.ValidateMaxBlobSize(1024*512, 300000))
+	assert.Error(t, disperser.ValidateMaxBlobSize(1024*1024, 300000))
+	assert.Error(t, disperser.ValidateMaxBlobSize(0, 300000))
 }
 
 func TestRetrieveBlob(t *testing.T) {
@@ -334,6 +867,22 @@ func newTestServer(m *testing.M) *apiserver.DispersalServer {
 		panic("failed to create dynamoDB client")
 	}
 	blobMetadataStore := blobstore.NewBlobMetadataStore(dynamoClient, logger, metadataTableName, time.Hour)
+	queue = blobstore.NewSharedStorage(bucketName, s3Client, blobMetadataStore, logger)
+
+	return newTestDispersalServer(disperser.ServerConfig{
+		GrpcPort:             "51001",
+		StatusPollInterval:   100 * time.Millisecond,
+		DuplicateWindow:      time.Minute,
+		BlockedCallbackPorts: []string{"51002"},
+	})
+}
+
+// newTestDispersalServer creates a server with the given config on top of the shared blob store
+func newTestDispersalServer(config disperser.ServerConfig) *apiserver.DispersalServer {
+	logger, err := logging.GetLogger(logging.DefaultCLIConfig())
+	if err != nil {
+		panic("failed to create a new logger")
+	}
 
 	var ratelimiter common.RateLimiter
 	rateConfig := apiserver.RateConfig{
@@ -346,14 +895,11 @@ func newTestServer(m *testing.M) *apiserver.DispersalServer {
 		ClientIPHeader: "",
 	}
 
-	queue = blobstore.NewSharedStorage(bucketName, s3Client, blobMetadataStore, logger)
 	tx := &mock.MockTransactor{}
 	tx.On("GetCurrentBlockNumber").Return(uint32(100), nil)
 	tx.On("GetQuorumCount").Return(uint16(2), nil)
 
-	return apiserver.NewDispersalServer(disperser.ServerConfig{
-		GrpcPort: "51001",
-	}, queue, tx, logger, disperser.NewMetrics("9001", logger), ratelimiter, rateConfig)
+	return apiserver.NewDispersalServer(config, queue, tx, logger, disperser.NewMetrics("9001", logger), ratelimiter, rateConfig, auth.NewAuthenticator())
 }
 
 func disperseBlob(t *testing.T, server *apiserver.DispersalServer, data []byte) (pb.BlobStatus, uint, []byte) {
@@ -490,3 +1036,236 @@ func simulateBlobConfirmation(t *testing.T, requestID []byte, blobSize uint, sec
 
 	return updated
 }
+
+type mockBlobStatusStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	replies chan *pb.BlobStatusReply
+}
+
+func (s *mockBlobStatusStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockBlobStatusStream) Send(reply *pb.BlobStatusReply) error {
+	s.replies <- reply
+	return nil
+}
+
+// mockAuthenticatedStream plays the client side of DisperseBlobAuthenticated: it sends the
+// dispersal request, signs the challenge it gets back, and records the final reply.
+type mockAuthenticatedStream struct {
+	grpc.ServerStream
+	ctx           context.Context
+	signer        *auth.LocalBlobRequestSigner
+	accountID     string
+	data          []byte
+	requests      chan *pb.AuthenticatedRequest
+	disperseReply *pb.DisperseBlobReply
+}
+
+func newMockAuthenticatedStream(signer *auth.LocalBlobRequestSigner, data []byte) *mockAuthenticatedStream {
+	p := &peer.Peer{
+		Addr: &net.TCPAddr{
+			IP:   net.ParseIP("0.0.0.0"),
+			Port: 51001,
+		},
+	}
+	return &mockAuthenticatedStream{
+		ctx:       peer.NewContext(context.Background(), p),
+		signer:    signer,
+		accountID: signer.GetAccountID(),
+		data:      data,
+		requests:  make(chan *pb.AuthenticatedRequest, 2),
+	}
+}
+
+func (s *mockAuthenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockAuthenticatedStream) Recv() (*pb.AuthenticatedRequest, error) {
+	// The first message is always the dispersal request
+	if s.accountID != "" {
+		accountID := s.accountID
+		s.accountID = ""
+		return &pb.AuthenticatedRequest{Payload: &pb.AuthenticatedRequest_DisperseRequest{
+			DisperseRequest: &pb.DisperseBlobRequest{
+				Data: s.data,
+				SecurityParams: []*pb.SecurityParams{
+					{
+						QuorumId:           0,
+						AdversaryThreshold: 50,
+						QuorumThreshold:    100,
+					},
+				},
+				AccountId: accountID,
+			},
+		}}, nil
+	}
+	return <-s.requests, nil
+}
+
+func (s *mockAuthenticatedStream) Send(reply *pb.AuthenticatedReply) error {
+	switch payload := reply.GetPayload().(type) {
+	case *pb.AuthenticatedReply_BlobAuthHeader:
+		signature, err := s.signer.SignBlobRequest(core.BlobAuthHeader{
+			AccountID: s.signer.GetAccountID(),
+			BlobHash:  crypto.Keccak256Hash(s.data),
+			Nonce:     payload.BlobAuthHeader.ChallengeParameter,
+		})
+		if err != nil {
+			return err
+		}
+		s.requests <- &pb.AuthenticatedRequest{Payload: &pb.AuthenticatedRequest_AuthenticationData{
+			AuthenticationData: &pb.AuthenticationData{
+				AuthenticationData: signature,
+			},
+		}}
+	case *pb.AuthenticatedReply_DisperseReply:
+		s.disperseReply = payload.DisperseReply
+	}
+	return nil
+}
+
+// mockAuthenticatedCancelStream plays the client side of CancelBlobAuthenticated: it sends the
+// cancel request and then signs the challenge, or sends signature instead if it's set.
+type mockAuthenticatedCancelStream struct {
+	grpc.ServerStream
+	ctx         context.Context
+	signer      *auth.LocalBlobRequestSigner
+	requestID   []byte
+	signature   []byte
+	sentRequest bool
+	requests    chan *pb.AuthenticatedCancelRequest
+	cancelReply *pb.CancelBlobReply
+}
+
+func newMockAuthenticatedCancelStream(signer *auth.LocalBlobRequestSigner, requestID []byte) *mockAuthenticatedCancelStream {
+	p := &peer.Peer{
+		Addr: &net.TCPAddr{
+			IP:   net.ParseIP("0.0.0.0"),
+			Port: 51001,
+		},
+	}
+	return &mockAuthenticatedCancelStream{
+		ctx:       peer.NewContext(context.Background(), p),
+		signer:    signer,
+		requestID: requestID,
+		requests:  make(chan *pb.AuthenticatedCancelRequest, 1),
+	}
+}
+
+func (s *mockAuthenticatedCancelStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockAuthenticatedCancelStream) Recv() (*pb.AuthenticatedCancelRequest, error) {
+	// The first message is always the cancel request
+	if !s.sentRequest {
+		s.sentRequest = true
+		return &pb.AuthenticatedCancelRequest{Payload: &pb.AuthenticatedCancelRequest_CancelRequest{
+			CancelRequest: &pb.CancelBlobRequest{
+				RequestId: s.requestID,
+			},
+		}}, nil
+	}
+	return <-s.requests, nil
+}
+
+func (s *mockAuthenticatedCancelStream) Send(reply *pb.AuthenticatedCancelReply) error {
+	switch payload := reply.GetPayload().(type) {
+	case *pb.AuthenticatedCancelReply_BlobAuthHeader:
+		signature := s.signature
+		if signature == nil {
+			var err error
+			signature, err = s.signer.SignBlobRequest(core.NewCancelBlobAuthHeader(s.signer.GetAccountID(), s.requestID, payload.BlobAuthHeader.ChallengeParameter, nil))
+			if err != nil {
+				return err
+			}
+		}
+		s.requests <- &pb.AuthenticatedCancelRequest{Payload: &pb.AuthenticatedCancelRequest_AuthenticationData{
+			AuthenticationData: &pb.AuthenticationData{
+				AuthenticationData: signature,
+			},
+		}}
+	case *pb.AuthenticatedCancelReply_CancelReply:
+		s.cancelReply = payload.CancelReply
+	}
+	return nil
+}
+
+type mockBlobUploadStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	requests []*pb.DisperseBlobStreamRequest
+	reply    *pb.DisperseBlobReply
+}
+
+// newMockBlobUploadStream returns a stream that sends a header announcing dataSize bytes,
+// followed by data split into chunks of chunkSize bytes.
+func newMockBlobUploadStream(dataSize uint32, data []byte, chunkSize int) *mockBlobUploadStream {
+	p := &peer.Peer{
+		Addr: &net.TCPAddr{
+			IP:   net.ParseIP("0.0.0.0"),
+			Port: 51001,
+		},
+	}
+
+	requests := []*pb.DisperseBlobStreamRequest{
+		{Payload: &pb.DisperseBlobStreamRequest_Header{
+			Header: &pb.DisperseBlobStreamHeader{
+				SecurityParams: []*pb.SecurityParams{
+					{
+						QuorumId:           0,
+						AdversaryThreshold: 50,
+						QuorumThreshold:    100,
+					},
+				},
+				DataSize: dataSize,
+			},
+		}},
+	}
+	for start := 0; start < len(data); start += chunkSize {
+		end := start + chunkSize
+		if end > len(data) {
+			end = len(data)
+		}
+		requests = append(requests, &pb.DisperseBlobStreamRequest{
+			Payload: &pb.DisperseBlobStreamRequest_Chunk{Chunk: data[start:end]},
+		})
+	}
+
+	return &mockBlobUploadStream{
+		ctx:      peer.NewContext(context.Background(), p),
+		requests: requests,
+	}
+}
+
+func (s *mockBlobUploadStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockBlobUploadStream) Recv() (*pb.DisperseBlobStreamRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func (s *mockBlobUploadStream) SendAndClose(reply *pb.DisperseBlobReply) error {
+	s.reply = reply
+	return nil
+}
+
+func newTestPeerContext() context.Context {
+	p := &peer.Peer{
+		Addr: &net.TCPAddr{
+			IP:   net.ParseIP("0.0.0.0"),
+			Port: 51001,
+		},
+	}
+	return peer.NewContext(context.Background(), p)
+}
@@ -0,0 +1,172 @@
+package apiserver
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Layr-Labs/eigenda/common"
+	"github.com/Layr-Labs/eigenda/disperser"
+)
+
+var errTooManyStatusStreams = fmt.Errorf("request ratelimited: too many blob status streams")
+var errTooManyStatusStreamsPerIP = fmt.Errorf("request ratelimited: too many blob status streams from the same address")
+
+// statusWatcher polls the status of the blobs with an open SubscribeBlobStatus stream and fans it out to the streams.
+// All the watched blobs are read with a single bulk read per poll, however many streams are open, and the poller only
+// runs while there are streams.
+type statusWatcher struct {
+	blobStore       disperser.BlobStore
+	pollInterval    time.Duration
+	maxStreams      int
+	maxStreamsPerIP int
+	logger          common.Logger
+
+	mu             sync.Mutex
+	subscriptions  map[disperser.BlobKey]map[*statusSubscription]struct{}
+	numStreams     int
+	numStreamsByIP map[string]int
+	polling        bool
+}
+
+// statusSubscription receives the status updates of a blob for a single stream
+type statusSubscription struct {
+	blobKey disperser.BlobKey
+	origin  string
+	// updates holds the latest metadata of the blob that the stream hasn't received yet
+	updates chan *disperser.BlobMetadata
+}
+
+func newStatusWatcher(blobStore disperser.BlobStore, config disperser.ServerConfig, logger common.Logger) *statusWatcher {
+	pollInterval := config.StatusPollInterval
+	if pollInterval <= 0 {
+		pollInterval = defaultStatusPollInterval
+	}
+	maxStreams := config.MaxStatusStreams
+	if maxStreams <= 0 {
+		maxStreams = disperser.DefaultMaxStatusStreams
+	}
+	maxStreamsPerIP := config.MaxStatusStreamsPerIP
+	if maxStreamsPerIP <= 0 {
+		maxStreamsPerIP = disperser.DefaultMaxStatusStreamsPerIP
+	}
+	return &statusWatcher{
+		blobStore:       blobStore,
+		pollInterval:    pollInterval,
+		maxStreams:      maxStreams,
+		maxStreamsPerIP: maxStreamsPerIP,
+		logger:          logger,
+		subscriptions:   make(map[disperser.BlobKey]map[*statusSubscription]struct{}),
+		numStreamsByIP:  make(map[string]int),
+	}
+}
+
+// subscribe starts watching the status of the blob for a stream from origin. It fails if there are already too many
+// streams in total or from origin. The subscription must be released with unsubscribe.
+func (w *statusWatcher) subscribe(blobKey disperser.BlobKey, origin string) (*statusSubscription, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.numStreams >= w.maxStreams {
+		return nil, errTooManyStatusStreams
+	}
+	if w.numStreamsByIP[origin] >= w.maxStreamsPerIP {
+		return nil, errTooManyStatusStreamsPerIP
+	}
+
+	sub := &statusSubscription{
+		blobKey: blobKey,
+		origin:  origin,
+		updates: make(chan *disperser.BlobMetadata, 1),
+	}
+	if _, ok := w.subscriptions[blobKey]; !ok {
+		w.subscriptions[blobKey] = make(map[*statusSubscription]struct{})
+	}
+	w.subscriptions[blobKey][sub] = struct{}{}
+	w.numStreams++
+	w.numStreamsByIP[origin]++
+
+	if !w.polling {
+		w.polling = true
+		go w.poll()
+	}
+	return sub, nil
+}
+
+func (w *statusWatcher) unsubscribe(sub *statusSubscription) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	subs, ok := w.subscriptions[sub.blobKey]
+	if !ok {
+		return
+	}
+	if _, ok := subs[sub]; !ok {
+		return
+	}
+	delete(subs, sub)
+	if len(subs) == 0 {
+		delete(w.subscriptions, sub.blobKey)
+	}
+	w.numStreams--
+	w.numStreamsByIP[sub.origin]--
+	if w.numStreamsByIP[sub.origin] == 0 {
+		delete(w.numStreamsByIP, sub.origin)
+	}
+}
+
+// poll reads the status of the watched blobs every poll interval until there are no more subscriptions
+func (w *statusWatcher) poll() {
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		blobKeys := w.watchedBlobKeys()
+		if len(blobKeys) == 0 {
+			return
+		}
+
+		metadatas, err := w.blobStore.GetBulkBlobMetadata(context.Background(), blobKeys)
+		if err != nil {
+			// The streams keep waiting, and the status is read again at the next poll
+			w.logger.Error("failed to read the status of watched blobs", "numBlobs", len(blobKeys), "err", err)
+			continue
+		}
+		w.publish(metadatas)
+	}
+}
+
+// watchedBlobKeys returns the keys of the blobs that have subscriptions. If there are none, the poller is marked as
+// stopped, so that the next subscription starts it again.
+func (w *statusWatcher) watchedBlobKeys() []disperser.BlobKey {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if len(w.subscriptions) == 0 {
+		w.polling = false
+		return nil
+	}
+	blobKeys := make([]disperser.BlobKey, 0, len(w.subscriptions))
+	for blobKey := range w.subscriptions {
+		blobKeys = append(blobKeys, blobKey)
+	}
+	return blobKeys
+}
+
+// publish sends the metadata to the subscriptions of their blobs, replacing the updates they haven't received yet
+func (w *statusWatcher) publish(metadatas []*disperser.BlobMetadata) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, metadata := range metadatas {
+		for sub := range w.subscriptions[metadata.GetBlobKey()] {
+			// Only the poller sends updates, so there's room for the latest one once the stale one is dropped
+			select {
+			case <-sub.updates:
+			default:
+			}
+			sub.updates <- metadata
+		}
+	}
+}
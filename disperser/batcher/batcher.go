@@ -6,21 +6,26 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"math/rand"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/Layr-Labs/eigenda/common"
 	"github.com/Layr-Labs/eigenda/core"
 	"github.com/Layr-Labs/eigenda/disperser"
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	gcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/hashicorp/go-multierror"
-	"github.com/prometheus/client_golang/prometheus"
 	"github.com/wealdtech/go-merkletree"
 )
 
 const (
 	QuantizationFactor = uint(1)
 	indexerWarmupDelay = 2 * time.Second
+	// maxRetryDelay caps the delay given by a RetryPolicy, as it grows exponentially with the number of retries
+	maxRetryDelay = 24 * time.Hour
 )
 
 type BatchPlan struct {
@@ -35,6 +40,18 @@ type QuorumInfo struct {
 	QuantizationFactor uint
 }
 
+// dispersedBatch is a batch that has been dispersed and attested, and is waiting to be confirmed on chain
+type dispersedBatch struct {
+	*batch
+	aggSig *core.SignatureAggregation
+	// pendingBatch has the confirmation of each blob in the batch, which is completed once the batch is confirmed
+	pendingBatch *disperser.PendingBatch
+	// startTime is when the batch started being created
+	startTime time.Time
+	// done receives the result of the confirmation if it's set
+	done chan error
+}
+
 type TimeoutConfig struct {
 	EncodingTimeout    time.Duration
 	AttestationTimeout time.Duration
@@ -42,16 +59,61 @@ type TimeoutConfig struct {
 	ChainWriteTimeout  time.Duration
 }
 
+// RetryPolicy determines how long a blob waits after a failed attempt before it's dispersed again.
+// The n-th retry is delayed by BaseDelay * Multiplier^(n-1), reduced by a random fraction of up to Jitter.
+type RetryPolicy struct {
+	// BaseDelay is the delay before the first retry. Retries aren't delayed if it's zero.
+	BaseDelay time.Duration
+	// Multiplier is the factor by which the delay grows with each retry. Values below 1 are treated as 1.
+	Multiplier float64
+	// Jitter is the maximum fraction of the delay that's randomly subtracted from it, between 0 and 1
+	// This spreads out the retries of the blobs that failed in the same batch.
+	Jitter float64
+}
+
+// RetryDelay returns the delay before the retry that follows the given number of retries
+func (p RetryPolicy) RetryDelay(numRetries uint) time.Duration {
+	if p.BaseDelay <= 0 {
+		return 0
+	}
+	multiplier := math.Max(p.Multiplier, 1)
+	delay := math.Min(float64(p.BaseDelay)*math.Pow(multiplier, float64(numRetries)), float64(maxRetryDelay))
+	jitter := math.Min(math.Max(p.Jitter, 0), 1)
+	delay -= delay * jitter * rand.Float64()
+	return time.Duration(delay)
+}
+
 type Config struct {
-	PullInterval             time.Duration
-	FinalizerInterval        time.Duration
-	EncoderSocket            string
-	SRSOrder                 int
-	NumConnections           int
-	EncodingRequestQueueSize int
+	PullInterval      time.Duration
+	FinalizerInterval time.Duration
+	// EncoderSockets are the addresses of the encoder servers, over which the encoding requests are spread
+	EncoderSockets []string
+	// EncoderHealthCheckInterval is the interval at which the health of the encoder servers is checked
+	EncoderHealthCheckInterval time.Duration
+	SRSOrder                   int
+	NumConnections             int
+	EncodingRequestQueueSize   int
 	// BatchSizeMBLimit is the maximum size of a batch in MB
 	BatchSizeMBLimit     uint
 	MaxNumRetriesPerBlob uint
+	// MaxBlobsToFetchFromStore is the maximum number of blobs fetched from the blob store in a single query
+	MaxBlobsToFetchFromStore int
+	// MaxBlobsPerEncodingRequest is the maximum number of blobs with the same encoding params that are encoded with a
+	// single request
+	MaxBlobsPerEncodingRequest int
+	// MaxBytesPerEncodingRequest is the maximum total size in bytes of the blobs that are encoded with a single request
+	MaxBytesPerEncodingRequest int
+	// FinalizerReorgDepth is the number of blocks by which the confirmation block of a blob must be behind the latest
+	// finalized block before the finalizer considers a missing or reverted confirmation transaction dropped
+	FinalizerReorgDepth uint64
+	// RetryPolicy determines the delay before a blob that failed in a batch is retried
+	RetryPolicy RetryPolicy
+	// MaxInFlightBatches is the maximum number of batches that are being dispersed or confirmed at a time.
+	// The next batch is dispersed while the previous ones are being confirmed, which happens one batch at a time in the
+	// order they were dispersed. Values below 1 are treated as 1, i.e. each batch is confirmed before the next one.
+	MaxInFlightBatches int
+	// EigenDAServiceManagerAddr is the address of the contract that emits the BatchConfirmed events
+	EigenDAServiceManagerAddr gcommon.Address
 }
 
 type Batcher struct {
@@ -71,7 +133,16 @@ type Batcher struct {
 
 	ethClient common.EthClient
 	finalizer Finalizer
+	notifier  Notifier
 	logger    common.Logger
+
+	// batchMu makes sure that the batching loop and TriggerBatch don't create batches concurrently
+	batchMu        sync.Mutex
+	batchingPaused atomic.Bool
+	// batchSlots has an entry for each batch in flight, so that there are at most MaxInFlightBatches of them
+	batchSlots chan struct{}
+	// confirmationQueue holds the dispersed batches until they're confirmed
+	confirmationQueue chan *dispersedBatch
 }
 
 func NewBatcher(
@@ -86,6 +157,7 @@ func NewBatcher(
 	aggregator core.SignatureAggregator,
 	ethClient common.EthClient,
 	finalizer Finalizer,
+	notifier Notifier,
 	logger common.Logger,
 	metrics *Metrics,
 ) (*Batcher, error) {
@@ -94,15 +166,22 @@ func NewBatcher(
 		config.BatchSizeMBLimit*1024*1024, // convert to bytes
 	)
 	streamerConfig := StreamerConfig{
-		SRSOrder:               config.SRSOrder,
-		EncodingRequestTimeout: config.PullInterval,
-		EncodingQueueLimit:     config.EncodingRequestQueueSize,
-		PoolSize:               config.NumConnections,
+		SRSOrder:                   config.SRSOrder,
+		EncodingRequestTimeout:     config.PullInterval,
+		EncodingQueueLimit:         config.EncodingRequestQueueSize,
+		PoolSize:                   config.NumConnections,
+		MaxBlobsToFetchFromStore:   config.MaxBlobsToFetchFromStore,
+		MaxBlobsPerEncodingRequest: config.MaxBlobsPerEncodingRequest,
+		MaxBytesPerEncodingRequest: config.MaxBytesPerEncodingRequest,
 	}
-	encodingStreamer, err := NewEncodingStreamer(streamerConfig, queue, chainState, encoderClient, assignmentCoordinator, batchTrigger, logger)
+	encodingStreamer, err := NewEncodingStreamer(streamerConfig, queue, chainState, encoderClient, assignmentCoordinator, batchTrigger, notifier, logger)
 	if err != nil {
 		return nil, err
 	}
+	maxInFlightBatches := config.MaxInFlightBatches
+	if maxInFlightBatches < 1 {
+		maxInFlightBatches = 1
+	}
 
 	return &Batcher{
 		Config:        config,
@@ -121,7 +200,11 @@ func NewBatcher(
 
 		ethClient: ethClient,
 		finalizer: finalizer,
+		notifier:  notifier,
 		logger:    logger,
+
+		batchSlots:        make(chan struct{}, maxInFlightBatches),
+		confirmationQueue: make(chan *dispersedBatch, maxInFlightBatches),
 	}, nil
 }
 
@@ -133,12 +216,39 @@ func (b *Batcher) Start(ctx context.Context) error {
 	// Wait for few seconds for indexer to index blockchain
 	// This won't be needed when we switch to using Graph node
 	time.Sleep(indexerWarmupDelay)
+	// Recover the batches confirmed before the batcher last stopped, so that their blobs aren't dispersed again
+	err = b.RecoverConfirmedBatches(ctx)
+	if err != nil {
+		return err
+	}
 	err = b.EncodingStreamer.Start(ctx)
 	if err != nil {
 		return err
 	}
 	batchTrigger := b.EncodingStreamer.EncodedSizeNotifier
 	b.finalizer.Start(ctx)
+	err = b.notifier.Start(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Confirm the dispersed batches one at a time, so that their transactions are sent in order
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case dispersed := <-b.confirmationQueue:
+				err := b.confirmBatch(ctx, dispersed)
+				<-b.batchSlots
+				if dispersed.done != nil {
+					dispersed.done <- err
+				} else if err != nil {
+					b.logger.Error("failed to confirm a batch", "err", err)
+				}
+			}
+		}
+	}()
 
 	go func() {
 		ticker := time.NewTicker(b.PullInterval)
@@ -149,22 +259,10 @@ func (b *Batcher) Start(ctx context.Context) error {
 			case <-ctx.Done():
 				return
 			case <-ticker.C:
-				if err := b.HandleSingleBatch(ctx); err != nil {
-					if errors.Is(err, errNoEncodedResults) {
-						b.logger.Warn("no encoded results to make a batch with")
-					} else {
-						b.logger.Error("failed to process a batch", "err", err)
-					}
-				}
+				b.processBatch(ctx)
 			case <-batchTrigger.Notify:
 				ticker.Stop()
-				if err := b.HandleSingleBatch(ctx); err != nil {
-					if errors.Is(err, errNoEncodedResults) {
-						b.logger.Warn("no encoded results to make a batch with")
-					} else {
-						b.logger.Error("failed to process a batch", "err", err)
-					}
-				}
+				b.processBatch(ctx)
 				ticker.Reset(b.PullInterval)
 			}
 		}
@@ -173,14 +271,121 @@ func (b *Batcher) Start(ctx context.Context) error {
 	return nil
 }
 
-func (b *Batcher) handleFailure(ctx context.Context, blobMetadatas []*disperser.BlobMetadata) error {
+// processBatch disperses a single batch and queues it for confirmation unless batching is paused
+func (b *Batcher) processBatch(ctx context.Context) {
+	if b.batchingPaused.Load() {
+		b.logger.Debug("batching is paused, skipping batch")
+		return
+	}
+	if err := b.dispatchBatch(ctx, nil); err != nil {
+		if errors.Is(err, errNoEncodedResults) {
+			b.logger.Warn("no encoded results to make a batch with")
+		} else {
+			b.logger.Error("failed to process a batch", "err", err)
+		}
+	}
+}
+
+// TriggerBatch handles a single batch right away, even if batching is paused, and waits for it to be confirmed.
+// It waits for the batch that is being created by the batching loop, if any, and for a batch in flight to be done if
+// there are already MaxInFlightBatches of them.
+func (b *Batcher) TriggerBatch(ctx context.Context) error {
+	done := make(chan error, 1)
+	if err := b.dispatchBatch(ctx, done); err != nil {
+		return err
+	}
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// dispatchBatch disperses a batch once there are fewer than MaxInFlightBatches batches in flight, and queues it for
+// confirmation. If done is set, it receives the result of the confirmation.
+func (b *Batcher) dispatchBatch(ctx context.Context, done chan error) error {
+	select {
+	case b.batchSlots <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	b.batchMu.Lock()
+	dispersed, err := b.disperseBatch(ctx)
+	b.batchMu.Unlock()
+	if err != nil {
+		<-b.batchSlots
+		return err
+	}
+
+	dispersed.done = done
+	b.confirmationQueue <- dispersed
+	return nil
+}
+
+// PauseBatching stops the batching loop from creating batches. Blobs keep being encoded in the meantime.
+func (b *Batcher) PauseBatching() {
+	b.batchingPaused.Store(true)
+	b.logger.Info("paused batching")
+}
+
+// ResumeBatching makes the batching loop create batches again
+func (b *Batcher) ResumeBatching() {
+	b.batchingPaused.Store(false)
+	b.logger.Info("resumed batching")
+}
+
+// IsBatchingPaused returns whether the batching loop is paused
+func (b *Batcher) IsBatchingPaused() bool {
+	return b.batchingPaused.Load()
+}
+
+// RemoveBlob drops the pending encoding requests and the encoded results of a blob so that it isn't
+// included in the next batch, e.g. after it's been failed through the admin API
+func (b *Batcher) RemoveBlob(metadata *disperser.BlobMetadata) {
+	b.EncodingStreamer.RemoveBlob(metadata)
+}
+
+// GetEncodedBlobBacklog returns the state of the encoded blob store
+func (b *Batcher) GetEncodedBlobBacklog() EncodedBlobBacklog {
+	return b.EncodingStreamer.EncodedBlobstore.GetBacklog()
+}
+
+// handleFailure schedules the blobs for another attempt according to the retry policy, or marks them as failed once
+// they run out of retries.
+// The failure is appended to the failure history of the blobs so that it can be reported to the clients and operators.
+func (b *Batcher) handleFailure(ctx context.Context, blobMetadatas []*disperser.BlobMetadata, stage disperser.FailureStage, reason error) error {
 	var result *multierror.Error
+	now := time.Now()
 	for _, metadata := range blobMetadatas {
+		failure := &disperser.BlobFailure{
+			Stage:     stage,
+			Error:     reason.Error(),
+			Attempt:   metadata.NumRetries,
+			Timestamp: uint64(now.UnixNano()),
+		}
 		var err error
 		if metadata.NumRetries < b.MaxNumRetriesPerBlob {
-			err = b.Queue.IncrementBlobRetryCount(ctx, metadata)
+			var notBefore uint64
+			if delay := b.RetryPolicy.RetryDelay(metadata.NumRetries); delay > 0 {
+				notBefore = uint64(now.Add(delay).UnixNano())
+				// Drop the encoded blob so that it isn't included in a batch before it's requested again
+				b.EncodingStreamer.RemoveEncodedBlob(metadata)
+			}
+			err = b.Queue.IncrementBlobRetryCount(ctx, metadata, failure, notBefore)
 		} else {
-			err = b.Queue.MarkBlobFailed(ctx, metadata.GetBlobKey())
+			err = b.Queue.MarkBlobFailed(ctx, metadata.GetBlobKey(), failure)
+			if err == nil {
+				b.notifier.Notify(metadata, disperser.Failed)
+			}
+		}
+		if errors.Is(err, disperser.ErrBlobNotProcessing) {
+			// The blob was cancelled or failed by an operator while it was in the batch, so it's left in that status
+			b.logger.Info("HandleSingleBatch: skipping failure of blob that is no longer processing", "blobKey", metadata.GetBlobKey().String())
+			b.EncodingStreamer.RemoveEncodedBlob(metadata)
+			continue
 		}
 		if err != nil {
 			b.logger.Error("HandleSingleBatch: error handling blob failure", "err", err)
@@ -193,20 +398,34 @@ func (b *Batcher) handleFailure(ctx context.Context, blobMetadatas []*disperser.
 	// Return the error(s)
 	return result.ErrorOrNil()
 }
+
+// HandleSingleBatch creates, disperses and confirms a single batch, without overlapping with other batches
 func (b *Batcher) HandleSingleBatch(ctx context.Context) error {
+	dispersed, err := b.disperseBatch(ctx)
+	if err != nil {
+		return err
+	}
+	return b.confirmBatch(ctx, dispersed)
+}
+
+// disperseBatch creates a batch, disperses it and aggregates the signatures, which is the part of the batch that can
+// overlap with the confirmation of the previous batches.
+// The blobs of the batch stay in flight if it succeeds, until the batch is confirmed.
+func (b *Batcher) disperseBatch(ctx context.Context) (dispersed *dispersedBatch, err error) {
 	log := b.logger
-	// start a timer
-	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(f float64) {
-		b.Metrics.ObserveLatency("total", f*1000) // make milliseconds
-	}))
-	defer timer.ObserveDuration()
+	startTime := time.Now()
 
 	stageTimer := time.Now()
-	batch, err := b.EncodingStreamer.CreateBatch()
+	batch, err := b.EncodingStreamer.CreateBatch(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	log.Trace("[batcher] CreateBatch took", "duration", time.Since(stageTimer))
+	defer func() {
+		if err != nil {
+			b.EncodingStreamer.ReleaseBlobs(batch.BlobMetadata)
+		}
+	}()
 
 	// Dispatch encoded batch
 	log.Trace("[batcher] Dispatching encoded batch...")
@@ -218,8 +437,9 @@ func (b *Batcher) HandleSingleBatch(ctx context.Context) error {
 	log.Trace("[batcher] Getting batch header hash...")
 	headerHash, err := batch.BatchHeader.GetBatchHeaderHash()
 	if err != nil {
-		_ = b.handleFailure(ctx, batch.BlobMetadata)
-		return fmt.Errorf("HandleSingleBatch: error getting batch header hash: %w", err)
+		err = fmt.Errorf("HandleSingleBatch: error getting batch header hash: %w", err)
+		_ = b.handleFailure(ctx, batch.BlobMetadata, disperser.DispersalStage, err)
+		return nil, err
 	}
 
 	// Aggregate the signatures
@@ -234,8 +454,9 @@ func (b *Batcher) HandleSingleBatch(ctx context.Context) error {
 	stageTimer = time.Now()
 	aggSig, err := b.Aggregator.AggregateSignatures(batch.BatchMetadata.State, quorumIDs, headerHash, update)
 	if err != nil {
-		_ = b.handleFailure(ctx, batch.BlobMetadata)
-		return fmt.Errorf("HandleSingleBatch: error aggregating signatures: %w", err)
+		err = fmt.Errorf("HandleSingleBatch: error aggregating signatures: %w", err)
+		_ = b.handleFailure(ctx, batch.BlobMetadata, disperser.AggregationStage, err)
+		return nil, err
 	}
 	log.Trace("[batcher] AggregateSignatures took", "duration", time.Since(stageTimer))
 	b.Metrics.ObserveLatency("AggregateSignatures", float64(time.Since(stageTimer).Milliseconds()))
@@ -244,35 +465,15 @@ func (b *Batcher) HandleSingleBatch(ctx context.Context) error {
 	passed, numPassed := getBlobQuorumPassStatus(aggSig.QuorumResults, batch.BlobHeaders)
 	// TODO(mooselumph): Determine whether to confirm the batch based on the number of successes
 	if numPassed == 0 {
-		_ = b.handleFailure(ctx, batch.BlobMetadata)
-		return fmt.Errorf("HandleSingleBatch: no blobs received sufficient signatures")
-	}
-
-	// Confirm the batch
-	log.Trace("[batcher] Confirming batch...")
-	stageTimer = time.Now()
-	txnReceipt, err := b.Confirmer.ConfirmBatch(ctx, batch.BatchHeader, aggSig.QuorumResults, aggSig)
-	if err != nil {
-		_ = b.handleFailure(ctx, batch.BlobMetadata)
-		return fmt.Errorf("HandleSingleBatch: error confirming batch: %w", err)
-	}
-	log.Trace("[batcher] ConfirmBatch took", "duration", time.Since(stageTimer))
-	log.Info("[batcher] Batch confirmed at block", "blockNumber", txnReceipt.BlockNumber, "txnHash", txnReceipt.TxHash.Hex())
-	b.Metrics.ObserveLatency("ConfirmBatch", float64(time.Since(stageTimer).Milliseconds()))
-	b.Metrics.GasUsed.Set(float64(txnReceipt.GasUsed))
-
-	batchID, err := b.getBatchID(ctx, txnReceipt)
-	if err != nil {
-		_ = b.handleFailure(ctx, batch.BlobMetadata)
-		return fmt.Errorf("HandleSingleBatch: error fetching batch ID: %w", err)
+		err = fmt.Errorf("HandleSingleBatch: no blobs received sufficient signatures")
+		_ = b.handleFailure(ctx, batch.BlobMetadata, disperser.AggregationStage, err)
+		return nil, err
 	}
 
-	// Mark the blobs as complete
-	log.Trace("[batcher] Marking blobs as complete...")
-	stageTimer = time.Now()
-	blobsToRetry := make([]*disperser.BlobMetadata, 0)
-	var updateConfirmationInfoErr error
-	for blobIndex, metadata := range batch.BlobMetadata {
+	// Build the confirmation info of the blobs and record it before confirming the batch, so that the blobs can be
+	// marked as confirmed if the batcher stops before updating them
+	pendingConfirmations := make([]*disperser.PendingConfirmation, len(batch.BlobMetadata))
+	for blobIndex := range batch.BlobMetadata {
 		// Mark the blob failed if it didn't get enough signatures.
 		status := disperser.Confirmed
 		if !passed[blobIndex] {
@@ -284,45 +485,111 @@ func (b *Batcher) HandleSingleBatch(ctx context.Context) error {
 		if status == disperser.Confirmed {
 			// generate inclusion proof
 			if blobIndex >= len(batch.BlobHeaders) {
-				return fmt.Errorf("HandleSingleBatch: error confirming blobs: blob header at index %d not found in batch", blobIndex)
+				return nil, fmt.Errorf("HandleSingleBatch: error confirming blobs: blob header at index %d not found in batch", blobIndex)
 			}
 			blobHeader = batch.BlobHeaders[blobIndex]
 
 			blobHeaderHash, err := blobHeader.GetBlobHeaderHash()
 			if err != nil {
-				return fmt.Errorf("HandleSingleBatch: failed to get blob header hash: %w", err)
+				return nil, fmt.Errorf("HandleSingleBatch: failed to get blob header hash: %w", err)
 			}
 			merkleProof, err := batch.MerkleTree.GenerateProof(blobHeaderHash[:], 0)
 			if err != nil {
-				return fmt.Errorf("HandleSingleBatch: failed to generate blob header inclusion proof: %w", err)
+				return nil, fmt.Errorf("HandleSingleBatch: failed to generate blob header inclusion proof: %w", err)
 			}
 			proof = serializeProof(merkleProof)
 		}
 
-		confirmationInfo := &disperser.ConfirmationInfo{
-			BatchHeaderHash:         headerHash,
-			BlobIndex:               uint32(blobIndex),
-			SignatoryRecordHash:     core.ComputeSignatoryRecordHash(uint32(batch.BatchHeader.ReferenceBlockNumber), aggSig.NonSigners),
-			ReferenceBlockNumber:    uint32(batch.BatchHeader.ReferenceBlockNumber),
-			BatchRoot:               batch.BatchHeader.BatchRoot[:],
-			BlobInclusionProof:      proof,
-			BlobCommitment:          &batch.BlobHeaders[blobIndex].BlobCommitments,
-			BatchID:                 uint32(batchID),
-			ConfirmationTxnHash:     txnReceipt.TxHash,
-			ConfirmationBlockNumber: uint32(txnReceipt.BlockNumber.Uint64()),
-			Fee:                     []byte{0}, // No fee
-			QuorumResults:           aggSig.QuorumResults,
-			BlobQuorumInfos:         batch.BlobHeaders[blobIndex].QuorumInfos,
+		pendingConfirmations[blobIndex] = &disperser.PendingConfirmation{
+			BlobKey: batch.BlobMetadata[blobIndex].GetBlobKey(),
+			Status:  status,
+			ConfirmationInfo: &disperser.ConfirmationInfo{
+				BatchHeaderHash:      headerHash,
+				BlobIndex:            uint32(blobIndex),
+				SignatoryRecordHash:  core.ComputeSignatoryRecordHash(uint32(batch.BatchHeader.ReferenceBlockNumber), aggSig.NonSigners),
+				ReferenceBlockNumber: uint32(batch.BatchHeader.ReferenceBlockNumber),
+				BatchRoot:            batch.BatchHeader.BatchRoot[:],
+				BlobInclusionProof:   proof,
+				BlobCommitment:       &batch.BlobHeaders[blobIndex].BlobCommitments,
+				Fee:                  []byte{0}, // No fee
+				QuorumResults:        aggSig.QuorumResults,
+				BlobQuorumInfos:      batch.BlobHeaders[blobIndex].QuorumInfos,
+			},
 		}
+	}
+	pendingBatch := &disperser.PendingBatch{
+		BatchHeaderHash:      headerHash,
+		ReferenceBlockNumber: uint32(batch.BatchHeader.ReferenceBlockNumber),
+		Blobs:                pendingConfirmations,
+	}
+	// Failures are only logged, as the blobs can still be confirmed without it unless the batcher stops in between
+	if err := b.Queue.SetPendingBatch(ctx, pendingBatch); err != nil {
+		log.Error("HandleSingleBatch: error recording pending batch", "batchHeaderHash", gcommon.Hash(headerHash).Hex(), "err", err)
+	}
+
+	return &dispersedBatch{
+		batch:        batch,
+		aggSig:       aggSig,
+		pendingBatch: pendingBatch,
+		startTime:    startTime,
+	}, nil
+}
+
+// confirmBatch confirms a dispersed batch on chain and updates its blobs, which releases them
+func (b *Batcher) confirmBatch(ctx context.Context, dispersed *dispersedBatch) error {
+	log := b.logger
+	batch := dispersed.batch
+	aggSig := dispersed.aggSig
+	pendingConfirmations := dispersed.pendingBatch.Blobs
+	defer b.EncodingStreamer.ReleaseBlobs(batch.BlobMetadata)
+	defer func() {
+		b.Metrics.ObserveLatency("total", float64(time.Since(dispersed.startTime).Milliseconds()))
+	}()
+
+	// Confirm the batch
+	log.Trace("[batcher] Confirming batch...")
+	stageTimer := time.Now()
+	txnReceipt, err := b.Confirmer.ConfirmBatch(ctx, batch.BatchHeader, aggSig.QuorumResults, aggSig)
+	if err != nil {
+		err = fmt.Errorf("HandleSingleBatch: error confirming batch: %w", err)
+		_ = b.handleFailure(ctx, batch.BlobMetadata, disperser.ConfirmationStage, err)
+		return err
+	}
+	log.Trace("[batcher] ConfirmBatch took", "duration", time.Since(stageTimer))
+	log.Info("[batcher] Batch confirmed at block", "blockNumber", txnReceipt.BlockNumber, "txnHash", txnReceipt.TxHash.Hex())
+	b.Metrics.ObserveLatency("ConfirmBatch", float64(time.Since(stageTimer).Milliseconds()))
+	b.Metrics.GasUsed.Set(float64(txnReceipt.GasUsed))
+
+	batchID, err := b.getBatchID(ctx, txnReceipt)
+	if err != nil {
+		err = fmt.Errorf("HandleSingleBatch: error fetching batch ID: %w", err)
+		_ = b.handleFailure(ctx, batch.BlobMetadata, disperser.ConfirmationStage, err)
+		return err
+	}
 
+	// Mark the blobs as complete
+	log.Trace("[batcher] Marking blobs as complete...")
+	stageTimer = time.Now()
+	blobsToRetry := make([]*disperser.BlobMetadata, 0)
+	var updateConfirmationInfoErr error
+	for blobIndex, metadata := range batch.BlobMetadata {
+		status := pendingConfirmations[blobIndex].Status
+		confirmationInfo := pendingConfirmations[blobIndex].ConfirmationInfo
+		confirmationInfo.BatchID = uint32(batchID)
+		confirmationInfo.ConfirmationTxnHash = txnReceipt.TxHash
+		confirmationInfo.ConfirmationBlockNumber = uint32(txnReceipt.BlockNumber.Uint64())
+
+		var updatedMetadata *disperser.BlobMetadata
 		if status == disperser.Confirmed {
-			if _, updateConfirmationInfoErr = b.Queue.MarkBlobConfirmed(ctx, metadata, confirmationInfo); updateConfirmationInfoErr == nil {
+			if updatedMetadata, updateConfirmationInfoErr = b.Queue.MarkBlobConfirmed(ctx, metadata, confirmationInfo); updateConfirmationInfoErr == nil {
+				b.notifier.Notify(updatedMetadata, disperser.Confirmed)
 				b.Metrics.UpdateCompletedBlob(int(metadata.RequestMetadata.BlobSize), disperser.Confirmed)
 				// remove encoded blob from storage so we don't disperse it again
 				b.EncodingStreamer.RemoveEncodedBlob(metadata)
 			}
 		} else if status == disperser.InsufficientSignatures {
-			if _, updateConfirmationInfoErr = b.Queue.MarkBlobInsufficientSignatures(ctx, metadata, confirmationInfo); updateConfirmationInfoErr == nil {
+			if updatedMetadata, updateConfirmationInfoErr = b.Queue.MarkBlobInsufficientSignatures(ctx, metadata, confirmationInfo); updateConfirmationInfoErr == nil {
+				b.notifier.Notify(updatedMetadata, disperser.InsufficientSignatures)
 				b.Metrics.UpdateCompletedBlob(int(metadata.RequestMetadata.BlobSize), disperser.InsufficientSignatures)
 				// remove encoded blob from storage so we don't disperse it again
 				b.EncodingStreamer.RemoveEncodedBlob(metadata)
@@ -330,6 +597,12 @@ func (b *Batcher) HandleSingleBatch(ctx context.Context) error {
 		} else {
 			updateConfirmationInfoErr = fmt.Errorf("HandleSingleBatch: trying to update confirmation info for blob in status other than confirmed or insufficient signatures: %s", status.String())
 		}
+		if errors.Is(updateConfirmationInfoErr, disperser.ErrBlobNotProcessing) {
+			// The blob was cancelled or failed by an operator after it was batched, so its status is left as is
+			log.Info("HandleSingleBatch: skipping confirmation of blob that is no longer processing", "blobKey", metadata.GetBlobKey().String())
+			b.EncodingStreamer.RemoveEncodedBlob(metadata)
+			updateConfirmationInfoErr = nil
+		}
 		if updateConfirmationInfoErr != nil {
 			log.Error("HandleSingleBatch: error updating blob confirmed metadata", "err", updateConfirmationInfoErr)
 			blobsToRetry = append(blobsToRetry, batch.BlobMetadata[blobIndex])
@@ -339,10 +612,13 @@ func (b *Batcher) HandleSingleBatch(ctx context.Context) error {
 	}
 
 	if len(blobsToRetry) > 0 {
-		_ = b.handleFailure(ctx, blobsToRetry)
+		_ = b.handleFailure(ctx, blobsToRetry, disperser.MetadataUpdateStage, fmt.Errorf("failed to update blob confirmed metadata: %w", updateConfirmationInfoErr))
 		if len(blobsToRetry) == len(batch.BlobMetadata) {
 			return fmt.Errorf("HandleSingleBatch: failed to update blob confirmed metadata for all blobs in batch: %w", updateConfirmationInfoErr)
 		}
+	} else if err := b.Queue.RemovePendingBatch(ctx, dispersed.pendingBatch.BatchHeaderHash); err != nil {
+		// The record is only read on startup, where the blobs that are no longer processing are skipped
+		log.Error("HandleSingleBatch: error removing pending batch", "err", err)
 	}
 
 	log.Trace("[batcher] Update confirmation info took", "duration", time.Since(stageTimer))
@@ -371,30 +647,35 @@ func (b *Batcher) parseBatchIDFromReceipt(ctx context.Context, txReceipt *types.
 		b.logger.Debug("[getBatchIDFromReceipt] ", "sigHash", log.Topics[0].Hex())
 
 		if log.Topics[0] == common.BatchConfirmedEventSigHash {
-			smAbi, err := abi.JSON(bytes.NewReader(common.ServiceManagerAbi))
-			if err != nil {
-				return 0, err
-			}
-			eventAbi, err := smAbi.EventByID(common.BatchConfirmedEventSigHash)
-			if err != nil {
-				return 0, err
-			}
-			unpackedData, err := eventAbi.Inputs.Unpack(log.Data)
-			if err != nil {
-				return 0, err
-			}
-
-			// There should be exactly two inputs in the data field, batchId and fee.
-			// ref: https://github.com/Layr-Labs/eigenda/blob/master/contracts/src/interfaces/IEigenDAServiceManager.sol#L20
-			if len(unpackedData) != 2 {
-				return 0, fmt.Errorf("BatchConfirmed log should contain exactly 2 inputs. Found %d", len(unpackedData))
-			}
-			return unpackedData[0].(uint32), nil
+			return parseBatchIDFromLog(log)
 		}
 	}
 	return 0, fmt.Errorf("failed to find BatchConfirmed log from the transaction")
 }
 
+// parseBatchIDFromLog returns the batch ID in the data of a BatchConfirmed log
+func parseBatchIDFromLog(log *types.Log) (uint32, error) {
+	smAbi, err := abi.JSON(bytes.NewReader(common.ServiceManagerAbi))
+	if err != nil {
+		return 0, err
+	}
+	eventAbi, err := smAbi.EventByID(common.BatchConfirmedEventSigHash)
+	if err != nil {
+		return 0, err
+	}
+	unpackedData, err := eventAbi.Inputs.Unpack(log.Data)
+	if err != nil {
+		return 0, err
+	}
+
+	// There should be exactly two inputs in the data field, batchId and fee.
+	// ref: https://github.com/Layr-Labs/eigenda/blob/master/contracts/src/interfaces/IEigenDAServiceManager.sol#L20
+	if len(unpackedData) != 2 {
+		return 0, fmt.Errorf("BatchConfirmed log should contain exactly 2 inputs. Found %d", len(unpackedData))
+	}
+	return unpackedData[0].(uint32), nil
+}
+
 func (b *Batcher) getBatchID(ctx context.Context, txReceipt *types.Receipt) (uint32, error) {
 	const (
 		maxRetries = 4
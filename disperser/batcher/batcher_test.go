@@ -21,6 +21,7 @@ import (
 	"github.com/Layr-Labs/eigenda/disperser/common/inmem"
 	dmock "github.com/Layr-Labs/eigenda/disperser/mock"
 	"github.com/Layr-Labs/eigenda/pkg/encoding/kzgEncoder"
+	"github.com/ethereum/go-ethereum"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/assert"
@@ -37,6 +38,7 @@ type batcherComponents struct {
 	encoderClient    *disperser.LocalEncoderClient
 	encodingStreamer *bat.EncodingStreamer
 	ethClient        *cmock.MockEthClient
+	notifier         *batchermock.MockNotifier
 }
 
 // makeTestEncoder makes an encoder currently using the only supported backend.
@@ -91,6 +93,7 @@ func makeBatcher(t *testing.T) (*batcherComponents, *bat.Batcher) {
 		BatchSizeMBLimit:         100,
 		SRSOrder:                 3000,
 		MaxNumRetriesPerBlob:     2,
+		MaxInFlightBatches:       2,
 	}
 	timeoutConfig := bat.TimeoutConfig{
 		EncodingTimeout:    10 * time.Second,
@@ -104,8 +107,9 @@ func makeBatcher(t *testing.T) (*batcherComponents, *bat.Batcher) {
 	encoderClient := disperser.NewLocalEncoderClient(enc)
 	finalizer := batchermock.NewFinalizer()
 	ethClient := &cmock.MockEthClient{}
+	notifier := batchermock.NewNotifier()
 
-	b, err := bat.NewBatcher(config, timeoutConfig, blobStore, dispatcher, confirmer, cst, asgn, encoderClient, agg, ethClient, finalizer, logger, metrics)
+	b, err := bat.NewBatcher(config, timeoutConfig, blobStore, dispatcher, confirmer, cst, asgn, encoderClient, agg, ethClient, finalizer, notifier, logger, metrics)
 	assert.NoError(t, err)
 
 	// Make the batcher
@@ -115,6 +119,7 @@ func makeBatcher(t *testing.T) (*batcherComponents, *bat.Batcher) {
 		encoderClient:    encoderClient,
 		encodingStreamer: b.EncodingStreamer,
 		ethClient:        ethClient,
+		notifier:         notifier,
 	}, b
 }
 
@@ -180,6 +185,13 @@ func TestBatcherIterations(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, blobKey2, meta2.GetBlobKey())
 	assert.Equal(t, disperser.Confirmed, meta2.BlobStatus)
+	components.notifier.AssertCalled(t, "Notify", mock.Anything, disperser.Confirmed)
+	components.notifier.AssertNumberOfCalls(t, "Notify", 2)
+
+	// The batch is no longer pending once its blobs are confirmed
+	pendingBatches, err := blobStore.GetPendingBatches(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, pendingBatches, 0)
 
 	res, err := components.encodingStreamer.EncodedBlobstore.GetEncodingResult(meta1.GetBlobKey(), 0)
 	assert.ErrorContains(t, err, "no such key")
@@ -219,6 +231,10 @@ func TestBlobFailures(t *testing.T) {
 	// should be retried
 	assert.Equal(t, disperser.Processing, meta.BlobStatus)
 	assert.Equal(t, uint(1), meta.NumRetries)
+	assert.Len(t, meta.FailureHistory, 1)
+	assert.Equal(t, disperser.ConfirmationStage, meta.LastFailure().Stage)
+	assert.Equal(t, "HandleSingleBatch: error confirming batch: error", meta.LastFailure().Error)
+	assert.Equal(t, uint(0), meta.LastFailure().Attempt)
 	metadatas, err := blobStore.GetBlobMetadataByStatus(ctx, disperser.Processing)
 	assert.NoError(t, err)
 	assert.Len(t, metadatas, 1)
@@ -242,6 +258,166 @@ func TestBlobFailures(t *testing.T) {
 	// should not be retried again
 	assert.Equal(t, disperser.Failed, meta.BlobStatus)
 	assert.Equal(t, uint(2), meta.NumRetries)
+	assert.Len(t, meta.FailureHistory, 3)
+	assert.Equal(t, disperser.ConfirmationStage, meta.LastFailure().Stage)
+	assert.Equal(t, "HandleSingleBatch: error confirming batch: error", meta.LastFailure().Error)
+	assert.Equal(t, uint(2), meta.LastFailure().Attempt)
+	components.notifier.AssertCalled(t, "Notify", mock.Anything, disperser.Failed)
+	components.notifier.AssertNumberOfCalls(t, "Notify", 1)
+}
+
+func TestCancelledBlobIsNotRetried(t *testing.T) {
+	blob := makeTestBlob([]*core.SecurityParam{{
+		QuorumID:           0,
+		AdversaryThreshold: 80,
+		QuorumThreshold:    100,
+	}})
+
+	components, batcher := makeBatcher(t)
+	blobStore := components.blobStore
+	ctx := context.Background()
+	_, blobKey := queueBlob(t, ctx, &blob, blobStore)
+
+	// The blob is cancelled while its batch is being confirmed
+	confirmationErr := fmt.Errorf("error")
+	components.confirmer.On("ConfirmBatch").Run(func(args mock.Arguments) {
+		err := blobStore.MarkBlobCancelled(ctx, blobKey)
+		assert.NoError(t, err)
+	}).Return(nil, confirmationErr)
+
+	out := make(chan bat.EncodingResultOrStatus)
+	err := components.encodingStreamer.RequestEncoding(ctx, out)
+	assert.NoError(t, err)
+	err = components.encodingStreamer.ProcessEncodedBlobs(ctx, <-out)
+	assert.NoError(t, err)
+
+	err = batcher.HandleSingleBatch(ctx)
+	assert.ErrorIs(t, err, confirmationErr)
+	meta, err := blobStore.GetBlobMetadata(ctx, blobKey)
+	assert.NoError(t, err)
+	assert.Equal(t, disperser.Cancelled, meta.BlobStatus)
+	assert.Equal(t, uint(0), meta.NumRetries)
+	assert.Empty(t, meta.FailureHistory)
+	assert.Equal(t, 0, batcher.GetEncodedBlobBacklog().NumEncodedResults)
+	components.notifier.AssertNotCalled(t, "Notify", mock.Anything, mock.Anything)
+}
+
+func TestBlobRetryBackoff(t *testing.T) {
+	blob := makeTestBlob([]*core.SecurityParam{{
+		QuorumID:           0,
+		AdversaryThreshold: 80,
+		QuorumThreshold:    100,
+	}})
+
+	components, batcher := makeBatcher(t)
+	batcher.RetryPolicy = bat.RetryPolicy{
+		BaseDelay:  200 * time.Millisecond,
+		Multiplier: 2,
+	}
+	confirmationErr := fmt.Errorf("error")
+	components.confirmer.On("ConfirmBatch").Return(nil, confirmationErr)
+	blobStore := components.blobStore
+	ctx := context.Background()
+	_, blobKey := queueBlob(t, ctx, &blob, blobStore)
+
+	out := make(chan bat.EncodingResultOrStatus)
+	err := components.encodingStreamer.RequestEncoding(ctx, out)
+	assert.NoError(t, err)
+	err = components.encodingStreamer.ProcessEncodedBlobs(ctx, <-out)
+	assert.NoError(t, err)
+
+	failedAt := time.Now()
+	err = batcher.HandleSingleBatch(ctx)
+	assert.ErrorIs(t, err, confirmationErr)
+	meta, err := blobStore.GetBlobMetadata(ctx, blobKey)
+	assert.NoError(t, err)
+	assert.Equal(t, disperser.Processing, meta.BlobStatus)
+	assert.Equal(t, uint(1), meta.NumRetries)
+	assert.GreaterOrEqual(t, meta.NotBefore, uint64(failedAt.Add(200*time.Millisecond).UnixNano()))
+	assert.False(t, meta.IsEligibleForDispersal(time.Now()))
+	// The encoded blob is dropped so that it can't be batched before the delay passes
+	assert.Equal(t, uint(0), components.encodingStreamer.EncodedBlobstore.GetEncodedResultSize())
+
+	// The blob isn't requested again until the delay passes
+	err = components.encodingStreamer.RequestEncoding(ctx, out)
+	assert.NoError(t, err)
+	referenceBlockNumber := components.encodingStreamer.ReferenceBlockNumber
+	assert.False(t, components.encodingStreamer.EncodedBlobstore.HasEncodingRequested(blobKey, 0, referenceBlockNumber))
+
+	time.Sleep(time.Until(time.Unix(0, int64(meta.NotBefore))))
+	err = components.encodingStreamer.RequestEncoding(ctx, out)
+	assert.NoError(t, err)
+	assert.True(t, components.encodingStreamer.EncodedBlobstore.HasEncodingRequested(blobKey, 0, referenceBlockNumber))
+	err = components.encodingStreamer.ProcessEncodedBlobs(ctx, <-out)
+	assert.NoError(t, err)
+
+	// The delay grows with the number of retries
+	failedAt = time.Now()
+	err = batcher.HandleSingleBatch(ctx)
+	assert.ErrorIs(t, err, confirmationErr)
+	meta, err = blobStore.GetBlobMetadata(ctx, blobKey)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(2), meta.NumRetries)
+	assert.GreaterOrEqual(t, meta.NotBefore, uint64(failedAt.Add(400*time.Millisecond).UnixNano()))
+}
+
+func TestEncodedBlobBacklog(t *testing.T) {
+	blob := makeTestBlob([]*core.SecurityParam{{
+		QuorumID:           0,
+		AdversaryThreshold: 80,
+		QuorumThreshold:    100,
+	}})
+
+	components, batcher := makeBatcher(t)
+	ctx := context.Background()
+	_, blobKey := queueBlob(t, ctx, &blob, components.blobStore)
+
+	out := make(chan bat.EncodingResultOrStatus)
+	err := components.encodingStreamer.RequestEncoding(ctx, out)
+	assert.NoError(t, err)
+	assert.Equal(t, bat.EncodedBlobBacklog{NumPendingRequests: 1}, batcher.GetEncodedBlobBacklog())
+
+	err = components.encodingStreamer.ProcessEncodedBlobs(ctx, <-out)
+	assert.NoError(t, err)
+	backlog := batcher.GetEncodedBlobBacklog()
+	assert.Equal(t, 0, backlog.NumPendingRequests)
+	assert.Equal(t, 1, backlog.NumEncodedResults)
+	assert.Equal(t, components.encodingStreamer.EncodedBlobstore.GetEncodedResultSize(), backlog.EncodedResultSize)
+
+	meta, err := components.blobStore.GetBlobMetadata(ctx, blobKey)
+	assert.NoError(t, err)
+	batcher.RemoveBlob(meta)
+	assert.Equal(t, bat.EncodedBlobBacklog{}, batcher.GetEncodedBlobBacklog())
+
+	assert.False(t, batcher.IsBatchingPaused())
+	batcher.PauseBatching()
+	assert.True(t, batcher.IsBatchingPaused())
+	batcher.ResumeBatching()
+	assert.False(t, batcher.IsBatchingPaused())
+}
+
+func TestRetryPolicy(t *testing.T) {
+	policy := bat.RetryPolicy{}
+	assert.Equal(t, time.Duration(0), policy.RetryDelay(0))
+	assert.Equal(t, time.Duration(0), policy.RetryDelay(5))
+
+	policy = bat.RetryPolicy{BaseDelay: time.Second, Multiplier: 2}
+	assert.Equal(t, time.Second, policy.RetryDelay(0))
+	assert.Equal(t, 2*time.Second, policy.RetryDelay(1))
+	assert.Equal(t, 8*time.Second, policy.RetryDelay(3))
+	// The delay is capped
+	assert.Equal(t, 24*time.Hour, policy.RetryDelay(1000))
+
+	// Multipliers below 1 don't shrink the delay
+	policy = bat.RetryPolicy{BaseDelay: time.Second, Multiplier: 0.5}
+	assert.Equal(t, time.Second, policy.RetryDelay(3))
+
+	policy = bat.RetryPolicy{BaseDelay: time.Second, Multiplier: 2, Jitter: 0.5}
+	for i := 0; i < 100; i++ {
+		delay := policy.RetryDelay(1)
+		assert.GreaterOrEqual(t, delay, time.Second)
+		assert.LessOrEqual(t, delay, 2*time.Second)
+	}
 }
 
 func TestRetryTxnReceipt(t *testing.T) {
@@ -300,3 +476,263 @@ func TestRetryTxnReceipt(t *testing.T) {
 	assert.Equal(t, meta.ConfirmationInfo.BatchID, uint32(3))
 	components.ethClient.AssertNumberOfCalls(t, "TransactionReceipt", 3)
 }
+
+func TestRecoverConfirmedBatches(t *testing.T) {
+	blob1 := makeTestBlob([]*core.SecurityParam{{
+		QuorumID:           0,
+		AdversaryThreshold: 80,
+		QuorumThreshold:    100,
+	}})
+	blob2 := makeTestBlob([]*core.SecurityParam{{
+		QuorumID:           1,
+		AdversaryThreshold: 70,
+		QuorumThreshold:    100,
+	}})
+	components, batcher := makeBatcher(t)
+	// The batch is confirmed on chain but the batcher fails to get the receipt
+	components.confirmer.On("ConfirmBatch").Return(nil, fmt.Errorf("error"))
+	blobStore := components.blobStore
+	ctx := context.Background()
+	_, blobKey1 := queueBlob(t, ctx, &blob1, blobStore)
+	_, blobKey2 := queueBlob(t, ctx, &blob2, blobStore)
+
+	out := make(chan bat.EncodingResultOrStatus)
+	err := components.encodingStreamer.RequestEncoding(ctx, out)
+	assert.NoError(t, err)
+	err = components.encodingStreamer.ProcessEncodedBlobs(ctx, <-out)
+	assert.NoError(t, err)
+	err = components.encodingStreamer.ProcessEncodedBlobs(ctx, <-out)
+	assert.NoError(t, err)
+
+	err = batcher.HandleSingleBatch(ctx)
+	assert.Error(t, err)
+	meta1, err := blobStore.GetBlobMetadata(ctx, blobKey1)
+	assert.NoError(t, err)
+	assert.Equal(t, disperser.Processing, meta1.BlobStatus)
+	pendingBatches, err := blobStore.GetPendingBatches(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, pendingBatches, 1)
+	pendingBatch := pendingBatches[0]
+	assert.Len(t, pendingBatch.Blobs, 2)
+	var pending *disperser.PendingConfirmation
+	for _, p := range pendingBatch.Blobs {
+		if p.BlobKey == blobKey1 {
+			pending = p
+		}
+	}
+	assert.NotNil(t, pending)
+	assert.Equal(t, disperser.Confirmed, pending.Status)
+	assert.NotEmpty(t, pending.ConfirmationInfo.BlobInclusionProof)
+	batchHeaderHash := pendingBatch.BatchHeaderHash
+	referenceBlockNumber := pendingBatch.ReferenceBlockNumber
+	assert.Equal(t, batchHeaderHash, pending.ConfirmationInfo.BatchHeaderHash)
+
+	// Another batch that could have been confirmed in the same blocks
+	blob3 := makeTestBlob([]*core.SecurityParam{{
+		QuorumID:           0,
+		AdversaryThreshold: 80,
+		QuorumThreshold:    100,
+	}})
+	_, blobKey3 := queueBlob(t, ctx, &blob3, blobStore)
+	otherBatchHeaderHash := [32]byte{1, 2, 3}
+	otherConfirmationInfo := *pending.ConfirmationInfo
+	otherConfirmationInfo.BatchHeaderHash = otherBatchHeaderHash
+	otherConfirmationInfo.ReferenceBlockNumber = referenceBlockNumber + 20
+	err = blobStore.SetPendingBatch(ctx, &disperser.PendingBatch{
+		BatchHeaderHash:      otherBatchHeaderHash,
+		ReferenceBlockNumber: referenceBlockNumber + 20,
+		Blobs: []*disperser.PendingConfirmation{{
+			BlobKey:          blobKey3,
+			Status:           disperser.Confirmed,
+			ConfirmationInfo: &otherConfirmationInfo,
+		}},
+	})
+	assert.NoError(t, err)
+
+	// should be encoding 3 and 0
+	logData, err := hex.DecodeString("00000000000000000000000000000000000000000000000000000000000000030000000000000000000000000000000000000000000000000000000000000000")
+	assert.NoError(t, err)
+	otherLogData, err := hex.DecodeString("00000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000000000")
+	assert.NoError(t, err)
+	txHash := gethcommon.HexToHash("0x1234")
+	confirmedLogs := []types.Log{
+		{
+			Topics:      []gethcommon.Hash{common.BatchConfirmedEventSigHash, batchHeaderHash},
+			Data:        logData,
+			BlockNumber: uint64(referenceBlockNumber) + 5,
+			TxHash:      txHash,
+		},
+		{
+			Topics:      []gethcommon.Hash{common.BatchConfirmedEventSigHash, otherBatchHeaderHash},
+			Data:        otherLogData,
+			BlockNumber: uint64(referenceBlockNumber) + 25,
+			TxHash:      txHash,
+		},
+	}
+	// The batches are looked up with a single query, as their blocks overlap
+	isBatchQuery := mock.MatchedBy(func(q ethereum.FilterQuery) bool {
+		return q.FromBlock.Uint64() == uint64(referenceBlockNumber) &&
+			q.ToBlock.Uint64() == uint64(referenceBlockNumber)+100 &&
+			len(q.Topics[1]) == 2
+	})
+	components.ethClient.On("GetCurrentBlockNumber").Return(referenceBlockNumber + 100)
+	components.ethClient.On("FilterLogs", isBatchQuery).Return([]types.Log{}, nil).Once()
+	components.ethClient.On("FilterLogs", isBatchQuery).Return(confirmedLogs, nil).Once()
+
+	// The blobs are left to be dispersed again if the batch wasn't confirmed, and the batches are kept while they can
+	// still be confirmed
+	err = batcher.RecoverConfirmedBatches(ctx)
+	assert.NoError(t, err)
+	meta1, err = blobStore.GetBlobMetadata(ctx, blobKey1)
+	assert.NoError(t, err)
+	assert.Equal(t, disperser.Processing, meta1.BlobStatus)
+	pendingBatches, err = blobStore.GetPendingBatches(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, pendingBatches, 2)
+
+	err = batcher.RecoverConfirmedBatches(ctx)
+	assert.NoError(t, err)
+	for _, blobKey := range []disperser.BlobKey{blobKey1, blobKey2} {
+		meta, err := blobStore.GetBlobMetadata(ctx, blobKey)
+		assert.NoError(t, err)
+		assert.Equal(t, disperser.Confirmed, meta.BlobStatus)
+		assert.Equal(t, batchHeaderHash, meta.ConfirmationInfo.BatchHeaderHash)
+		assert.Equal(t, uint32(3), meta.ConfirmationInfo.BatchID)
+		assert.Equal(t, txHash, meta.ConfirmationInfo.ConfirmationTxnHash)
+		assert.Equal(t, referenceBlockNumber+5, meta.ConfirmationInfo.ConfirmationBlockNumber)
+	}
+	meta1, err = blobStore.GetBlobMetadata(ctx, blobKey1)
+	assert.NoError(t, err)
+	assert.Equal(t, pending.ConfirmationInfo.BlobIndex, meta1.ConfirmationInfo.BlobIndex)
+	assert.Equal(t, pending.ConfirmationInfo.BlobInclusionProof, meta1.ConfirmationInfo.BlobInclusionProof)
+	meta3, err := blobStore.GetBlobMetadata(ctx, blobKey3)
+	assert.NoError(t, err)
+	assert.Equal(t, disperser.Confirmed, meta3.BlobStatus)
+	assert.Equal(t, uint32(4), meta3.ConfirmationInfo.BatchID)
+	assert.Equal(t, referenceBlockNumber+25, meta3.ConfirmationInfo.ConfirmationBlockNumber)
+
+	// The recovered batches are no longer pending
+	pendingBatches, err = blobStore.GetPendingBatches(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, pendingBatches, 0)
+	components.notifier.AssertNumberOfCalls(t, "Notify", 3)
+	components.ethClient.AssertNumberOfCalls(t, "FilterLogs", 2)
+}
+
+func TestRecoverConfirmedBatchesRemovesStaleBatches(t *testing.T) {
+	components, batcher := makeBatcher(t)
+	blobStore := components.blobStore
+	ctx := context.Background()
+
+	blob := makeTestBlob([]*core.SecurityParam{{
+		QuorumID:           0,
+		AdversaryThreshold: 80,
+		QuorumThreshold:    100,
+	}})
+	_, blobKey := queueBlob(t, ctx, &blob, blobStore)
+	batchHeaderHash := [32]byte{1}
+	err := blobStore.SetPendingBatch(ctx, &disperser.PendingBatch{
+		BatchHeaderHash:      batchHeaderHash,
+		ReferenceBlockNumber: 10,
+		Blobs: []*disperser.PendingConfirmation{{
+			BlobKey:          blobKey,
+			Status:           disperser.Confirmed,
+			ConfirmationInfo: &disperser.ConfirmationInfo{BatchHeaderHash: batchHeaderHash},
+		}},
+	})
+	assert.NoError(t, err)
+
+	// The batch can no longer be confirmed, so it's dropped and its blobs are left to be dispersed again
+	components.ethClient.On("GetCurrentBlockNumber").Return(uint32(1000))
+	components.ethClient.On("FilterLogs", mock.Anything).Return([]types.Log{}, nil).Once()
+	err = batcher.RecoverConfirmedBatches(ctx)
+	assert.NoError(t, err)
+
+	meta, err := blobStore.GetBlobMetadata(ctx, blobKey)
+	assert.NoError(t, err)
+	assert.Equal(t, disperser.Processing, meta.BlobStatus)
+	pendingBatches, err := blobStore.GetPendingBatches(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, pendingBatches, 0)
+}
+
+// notifyingDispatcher signals each batch it disperses
+type notifyingDispatcher struct {
+	disperser.Dispatcher
+	dispersed chan struct{}
+}
+
+func (d *notifyingDispatcher) DisperseBatch(ctx context.Context, state *core.IndexedOperatorState, blobs []core.EncodedBlob, header *core.BatchHeader) chan core.SignerMessage {
+	d.dispersed <- struct{}{}
+	return d.Dispatcher.DisperseBatch(ctx, state, blobs, header)
+}
+
+func TestPipelinedBatches(t *testing.T) {
+	components, batcher := makeBatcher(t)
+	dispersed := make(chan struct{}, 3)
+	batcher.Dispatcher = &notifyingDispatcher{Dispatcher: batcher.Dispatcher, dispersed: dispersed}
+
+	// should be encoding 3 and 0
+	logData, err := hex.DecodeString("00000000000000000000000000000000000000000000000000000000000000030000000000000000000000000000000000000000000000000000000000000000")
+	assert.NoError(t, err)
+	receipt := &types.Receipt{
+		Logs: []*types.Log{
+			{
+				Topics: []gethcommon.Hash{common.BatchConfirmedEventSigHash, gethcommon.HexToHash("1234")},
+				Data:   logData,
+			},
+		},
+		BlockNumber: big.NewInt(123),
+	}
+	confirming := make(chan struct{}, 3)
+	releaseConfirmation := make(chan struct{})
+	components.confirmer.On("ConfirmBatch").Run(func(args mock.Arguments) {
+		confirming <- struct{}{}
+		<-releaseConfirmation
+	}).Return(receipt, nil)
+
+	waitFor := func(ch chan struct{}, msg string) {
+		select {
+		case <-ch:
+		case <-time.After(10 * time.Second):
+			t.Fatal(msg)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	blobStore := components.blobStore
+	blob := makeTestBlob([]*core.SecurityParam{{
+		QuorumID:           0,
+		AdversaryThreshold: 80,
+		QuorumThreshold:    100,
+	}})
+	_, blobKey1 := queueBlob(t, ctx, &blob, blobStore)
+	err = batcher.Start(ctx)
+	assert.NoError(t, err)
+	waitFor(dispersed, "first batch wasn't dispersed")
+	waitFor(confirming, "first batch wasn't confirmed")
+
+	// The next batch is dispersed while the first one is being confirmed
+	_, blobKey2 := queueBlob(t, ctx, &blob, blobStore)
+	waitFor(dispersed, "second batch wasn't dispersed while the first one was being confirmed")
+
+	// There are already two batches in flight, so the next one waits for the first one to be confirmed
+	_, blobKey3 := queueBlob(t, ctx, &blob, blobStore)
+	select {
+	case <-dispersed:
+		t.Fatal("expected the third batch to wait for the first one to be confirmed")
+	case <-confirming:
+		t.Fatal("expected the batches to be confirmed one at a time")
+	case <-time.After(3 * time.Second):
+	}
+
+	close(releaseConfirmation)
+	waitFor(dispersed, "third batch wasn't dispersed")
+	for _, blobKey := range []disperser.BlobKey{blobKey1, blobKey2, blobKey3} {
+		assert.Eventually(t, func() bool {
+			meta, err := blobStore.GetBlobMetadata(ctx, blobKey)
+			return err == nil && meta.BlobStatus == disperser.Confirmed
+		}, 10*time.Second, 100*time.Millisecond)
+	}
+}
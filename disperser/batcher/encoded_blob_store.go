@@ -16,6 +16,9 @@ type encodedBlobStore struct {
 
 	requested map[requestID]struct{}
 	encoded   map[requestID]*EncodingResult
+	// inFlight holds the blobs of the batches that are being dispersed or confirmed
+	// They aren't encoded again or included in another batch until they're released.
+	inFlight map[disperser.BlobKey]struct{}
 	// encodedResultSize is the total size of all the chunks in the encoded results in bytes
 	encodedResultSize uint
 
@@ -32,17 +35,31 @@ type EncodingResult struct {
 	Assignments          map[core.OperatorID]core.Assignment
 }
 
+// EncodedBlobBacklog describes the encoding requests and results held by the encoded blob store
+type EncodedBlobBacklog struct {
+	// NumPendingRequests is the number of (blob, quorum) pairs that are being encoded
+	NumPendingRequests int
+	// NumEncodedResults is the number of (blob, quorum) pairs that are encoded and waiting to be batched
+	NumEncodedResults int
+	// EncodedResultSize is the total size of all the chunks in the encoded results in bytes
+	EncodedResultSize uint
+}
+
 // EncodingResultOrStatus is a wrapper for EncodingResult that also contains an error
 type EncodingResultOrStatus struct {
 	EncodingResult
 	// Err is set if there was an error during encoding
 	Err error
+	// FailedQuorums are the quorums of the blob whose shared encoding failed with Err. BlobQuorumInfo is the first of
+	// them.
+	FailedQuorums []core.QuorumID
 }
 
 func newEncodedBlobStore(logger common.Logger) *encodedBlobStore {
 	return &encodedBlobStore{
 		requested:         make(map[requestID]struct{}),
 		encoded:           make(map[requestID]*EncodingResult),
+		inFlight:          make(map[disperser.BlobKey]struct{}),
 		encodedResultSize: 0,
 		logger:            logger,
 	}
@@ -60,6 +77,10 @@ func (e *encodedBlobStore) HasEncodingRequested(blobKey disperser.BlobKey, quoru
 	e.mu.RLock()
 	defer e.mu.RUnlock()
 
+	if _, ok := e.inFlight[blobKey]; ok {
+		return true
+	}
+
 	requestID := getRequestID(blobKey, quorumID)
 	if _, ok := e.requested[requestID]; ok {
 		return true
@@ -73,8 +94,8 @@ func (e *encodedBlobStore) HasEncodingRequested(blobKey disperser.BlobKey, quoru
 }
 
 func (e *encodedBlobStore) DeleteEncodingRequest(blobKey disperser.BlobKey, quorumID core.QuorumID) {
-	e.mu.RLock()
-	defer e.mu.RUnlock()
+	e.mu.Lock()
+	defer e.mu.Unlock()
 
 	requestID := getRequestID(blobKey, quorumID)
 	if _, ok := e.requested[requestID]; !ok {
@@ -96,6 +117,10 @@ func (e *encodedBlobStore) PutEncodingResult(result *EncodingResult) error {
 	if _, ok := e.requested[requestID]; !ok {
 		return fmt.Errorf("PutEncodedBlob: no such key (%s) in requested set", requestID)
 	}
+	if _, ok := e.inFlight[blobKey]; ok {
+		delete(e.requested, requestID)
+		return fmt.Errorf("PutEncodedBlob: blob (%s) is in a batch in flight", blobKey.String())
+	}
 
 	if _, ok := e.encoded[requestID]; !ok {
 		e.encodedResultSize += getChunksSize(result)
@@ -123,14 +148,17 @@ func (e *encodedBlobStore) DeleteEncodingResult(blobKey disperser.BlobKey, quoru
 	defer e.mu.Unlock()
 
 	requestID := getRequestID(blobKey, quorumID)
-	if _, ok := e.encoded[requestID]; !ok {
+	encodedResult, ok := e.encoded[requestID]
+	if !ok {
 		return
 	}
 
 	delete(e.encoded, requestID)
+	e.encodedResultSize -= getChunksSize(encodedResult)
 }
 
-// GetNewAndDeleteStaleEncodingResults returns all the fresh encoded results and deletes all the stale results
+// GetNewAndDeleteStaleEncodingResults returns all the fresh encoded results and deletes all the stale results.
+// The fresh results of the blobs in flight are kept but not returned.
 func (e *encodedBlobStore) GetNewAndDeleteStaleEncodingResults(blockNumber uint) []*EncodingResult {
 	e.mu.Lock()
 	defer e.mu.Unlock()
@@ -142,7 +170,7 @@ func (e *encodedBlobStore) GetNewAndDeleteStaleEncodingResults(blockNumber uint)
 			delete(e.encoded, k)
 			staleCount++
 			e.encodedResultSize -= getChunksSize(encodedResult)
-		} else {
+		} else if _, ok := e.inFlight[encodedResult.BlobMetadata.GetBlobKey()]; !ok {
 			fetched = append(fetched, encodedResult)
 		}
 	}
@@ -151,6 +179,44 @@ func (e *encodedBlobStore) GetNewAndDeleteStaleEncodingResults(blockNumber uint)
 	return fetched
 }
 
+// GetEncodedBlobKeys returns the keys of the blobs that have encoded results
+func (e *encodedBlobStore) GetEncodedBlobKeys() []disperser.BlobKey {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	seen := make(map[disperser.BlobKey]struct{})
+	blobKeys := make([]disperser.BlobKey, 0)
+	for _, encodedResult := range e.encoded {
+		blobKey := encodedResult.BlobMetadata.GetBlobKey()
+		if _, ok := seen[blobKey]; ok {
+			continue
+		}
+		seen[blobKey] = struct{}{}
+		blobKeys = append(blobKeys, blobKey)
+	}
+	return blobKeys
+}
+
+// MarkInFlight marks the blobs as part of a batch that's being dispersed or confirmed
+func (e *encodedBlobStore) MarkInFlight(metadatas []*disperser.BlobMetadata) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for _, metadata := range metadatas {
+		e.inFlight[metadata.GetBlobKey()] = struct{}{}
+	}
+}
+
+// ReleaseInFlight makes the blobs available for encoding and batching again once their batch is done
+func (e *encodedBlobStore) ReleaseInFlight(metadatas []*disperser.BlobMetadata) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for _, metadata := range metadatas {
+		delete(e.inFlight, metadata.GetBlobKey())
+	}
+}
+
 // GetEncodedResultSize returns the total size of all the chunks in the encoded results in bytes
 func (e *encodedBlobStore) GetEncodedResultSize() uint {
 	e.mu.RLock()
@@ -159,6 +225,18 @@ func (e *encodedBlobStore) GetEncodedResultSize() uint {
 	return e.encodedResultSize
 }
 
+// GetBacklog returns the number of pending encoding requests and encoded results, and the size of the encoded results
+func (e *encodedBlobStore) GetBacklog() EncodedBlobBacklog {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	return EncodedBlobBacklog{
+		NumPendingRequests: len(e.requested),
+		NumEncodedResults:  len(e.encoded),
+		EncodedResultSize:  e.encodedResultSize,
+	}
+}
+
 func getRequestID(key disperser.BlobKey, quorumID core.QuorumID) requestID {
 	return requestID(fmt.Sprintf("%s-%d", key.String(), quorumID))
 }
@@ -41,6 +41,21 @@ type StreamerConfig struct {
 
 	// PoolSize is the number of workers in the worker pool
 	PoolSize int
+
+	// MaxBlobsToFetchFromStore is the maximum number of blobs to fetch from the blob store in each encoding round.
+	// The streamer goes through the processing blobs one page at a time. If it's 0, the page size is only bounded by
+	// the free space in the encoding queue.
+	MaxBlobsToFetchFromStore int
+
+	// MaxBlobsPerEncodingRequest is the maximum number of blobs with the same encoding params that are encoded with a
+	// single request, which sets up the encoding once for all of them. If it's 1 or less, each blob is encoded with a
+	// request of its own.
+	MaxBlobsPerEncodingRequest int
+
+	// MaxBytesPerEncodingRequest is the maximum total size in bytes of the blobs that are encoded with a single request.
+	// A blob that is larger than it is encoded with a request of its own. If it's 0, the requests are only bounded by
+	// MaxBlobsPerEncodingRequest.
+	MaxBytesPerEncodingRequest int
 }
 
 type EncodingStreamer struct {
@@ -57,9 +72,19 @@ type EncodingStreamer struct {
 	chainState            core.IndexedChainState
 	encoderClient         disperser.EncoderClient
 	assignmentCoordinator core.AssignmentCoordinator
+	notifier              Notifier
 
 	encodingCtxCancelFuncs []context.CancelFunc
 
+	// encodingsInProgress holds the blob encodings that are being made by (blob, encoding params, reference block)
+	// A quorum whose encoding params match one of them is added to it instead of encoding the blob again.
+	encodingsInProgress map[encodingKey]*blobEncodingRequest
+	inProgressMu        sync.Mutex
+
+	// exclusiveStartKey is the cursor from which the next page of processing blobs is fetched
+	// It's nil when the next page is the first one. It's only accessed by RequestEncoding.
+	exclusiveStartKey *disperser.BlobStoreExclusiveStartKey
+
 	logger common.Logger
 }
 
@@ -92,6 +117,7 @@ func NewEncodingStreamer(
 	encoderClient disperser.EncoderClient,
 	assignmentCoordinator core.AssignmentCoordinator,
 	encodedSizeNotifier *EncodedSizeNotifier,
+	notifier Notifier,
 	logger common.Logger) (*EncodingStreamer, error) {
 	if config.EncodingQueueLimit <= 0 {
 		return nil, fmt.Errorf("EncodingQueueLimit should be greater than 0")
@@ -106,7 +132,9 @@ func NewEncodingStreamer(
 		chainState:             chainState,
 		encoderClient:          encoderClient,
 		assignmentCoordinator:  assignmentCoordinator,
+		notifier:               notifier,
 		encodingCtxCancelFuncs: make([]context.CancelFunc, 0),
+		encodingsInProgress:    make(map[encodingKey]*blobEncodingRequest),
 		logger:                 logger,
 	}, nil
 }
@@ -171,13 +199,40 @@ func (e *EncodingStreamer) dedupRequests(metadatas []*disperser.BlobMetadata, re
 	return res
 }
 
+// filterEligibleBlobs drops the blobs that are waiting for their retry delay to pass
+func filterEligibleBlobs(metadatas []*disperser.BlobMetadata, now time.Time) []*disperser.BlobMetadata {
+	res := make([]*disperser.BlobMetadata, 0, len(metadatas))
+	for _, meta := range metadatas {
+		if meta.IsEligibleForDispersal(now) {
+			res = append(res, meta)
+		}
+	}
+	return res
+}
+
 func (e *EncodingStreamer) RequestEncoding(ctx context.Context, encoderChan chan EncodingResultOrStatus) error {
 	stageTimer := time.Now()
+
+	waitingQueueSize := e.Pool.WaitingQueueSize()
+	numMetadatastoProcess := e.EncodingQueueLimit - waitingQueueSize
+	if numMetadatastoProcess <= 0 {
+		// encoding queue is full
+		e.logger.Warn("[RequestEncoding] worker pool queue is full. skipping this round of encoding requests", "waitingQueueSize", waitingQueueSize, "encodingQueueLimit", e.EncodingQueueLimit)
+		return nil
+	}
+	// only fetch a page of blobs so that it doesn't exceed the EncodingQueueLimit
+	limit := numMetadatastoProcess
+	if e.MaxBlobsToFetchFromStore > 0 && e.MaxBlobsToFetchFromStore < limit {
+		limit = e.MaxBlobsToFetchFromStore
+	}
+
 	// pull new blobs and send to encoder
-	metadatas, err := e.blobStore.GetBlobMetadataByStatus(ctx, disperser.Processing)
+	metadatas, exclusiveStartKey, err := e.blobStore.GetBlobMetadataByStatusWithPagination(ctx, disperser.Processing, int32(limit), e.exclusiveStartKey)
 	if err != nil {
 		return fmt.Errorf("error getting blob metadatas: %w", err)
 	}
+	// start over from the first page once all the processing blobs have been fetched
+	e.exclusiveStartKey = exclusiveStartKey
 	if len(metadatas) == 0 {
 		e.logger.Info("no new metadatas to encode")
 		return nil
@@ -202,26 +257,13 @@ func (e *EncodingStreamer) RequestEncoding(ctx context.Context, encoderChan chan
 	}
 
 	e.logger.Trace("[encodingstreamer] metadata in processing status", "numMetadata", len(metadatas))
+	metadatas = filterEligibleBlobs(metadatas, time.Now())
 	metadatas = e.dedupRequests(metadatas, referenceBlockNumber)
 	if len(metadatas) == 0 {
 		e.logger.Info("no new metadatas to encode")
 		return nil
 	}
 
-	waitingQueueSize := e.Pool.WaitingQueueSize()
-	numMetadatastoProcess := e.EncodingQueueLimit - waitingQueueSize
-	if numMetadatastoProcess > len(metadatas) {
-		numMetadatastoProcess = len(metadatas)
-	}
-	if numMetadatastoProcess <= 0 {
-		// encoding queue is full
-		e.logger.Warn("[RequestEncoding] worker pool queue is full. skipping this round of encoding requests", "waitingQueueSize", waitingQueueSize, "encodingQueueLimit", e.EncodingQueueLimit)
-		return nil
-	}
-	// only process subset of blobs so it doesn't exceed the EncodingQueueLimit
-	// TODO: this should be done at the request time and keep the cursor so that we don't fetch the same metadata every time
-	metadatas = metadatas[:numMetadatastoProcess]
-
 	e.logger.Trace("[encodingstreamer] new metadatas to encode", "numMetadata", len(metadatas), "duration", time.Since(stageTimer))
 
 	batchMetadata, err := e.getBatchMetadata(ctx, metadatas, referenceBlockNumber)
@@ -243,10 +285,43 @@ func (e *EncodingStreamer) RequestEncoding(ctx context.Context, encoderChan chan
 
 	e.logger.Trace("[RequestEncoding] encoding blobs...", "numBlobs", len(blobs), "blockNumber", referenceBlockNumber)
 
-	for i := range metadatas {
-		metadata := metadatas[i]
+	// Each blob is encoded once per distinct set of encoding params among its quorums, and the blobs encoded with the
+	// same params are sent to the encoder together, so that the encoding is set up once for all of them
+	requestsByParams := make(map[core.EncodingParams][]*blobEncodingRequest)
+	paramsOrder := make([]core.EncodingParams, 0)
+	for _, metadata := range metadatas {
+		for _, request := range e.getEncodingRequests(ctx, metadata, blobs[metadata.GetBlobKey()], batchMetadata, referenceBlockNumber) {
+			if e.joinEncodingInProgress(request, referenceBlockNumber) {
+				continue
+			}
+			if _, ok := requestsByParams[request.params]; !ok {
+				paramsOrder = append(paramsOrder, request.params)
+			}
+			requestsByParams[request.params] = append(requestsByParams[request.params], request)
+		}
+	}
 
-		e.RequestEncodingForBlob(ctx, metadata, blobs[metadata.GetBlobKey()], batchMetadata, referenceBlockNumber, encoderChan)
+	maxBlobsPerRequest := e.MaxBlobsPerEncodingRequest
+	if maxBlobsPerRequest < 1 {
+		maxBlobsPerRequest = 1
+	}
+	for _, params := range paramsOrder {
+		requests := requestsByParams[params]
+		// start is the first request of the next encoding request, and size is the total size of its blobs so far
+		start, size := 0, 0
+		for end, request := range requests {
+			blobSize := len(request.blob.Data)
+			full := end-start == maxBlobsPerRequest ||
+				(e.MaxBytesPerEncodingRequest > 0 && size+blobSize > e.MaxBytesPerEncodingRequest)
+			if end > start && full {
+				e.requestEncoding(ctx, params, requests[start:end], batchMetadata, referenceBlockNumber, encoderChan)
+				start, size = end, 0
+			}
+			size += blobSize
+		}
+		if start < len(requests) {
+			e.requestEncoding(ctx, params, requests[start:], batchMetadata, referenceBlockNumber, encoderChan)
+		}
 	}
 
 	return nil
@@ -257,7 +332,74 @@ type pendingRequestInfo struct {
 	EncodingParams core.EncodingParams
 }
 
-func (e *EncodingStreamer) RequestEncodingForBlob(ctx context.Context, metadata *disperser.BlobMetadata, blob *core.Blob, batchMetadata *batchMetadata, referenceBlockNumber uint, encoderChan chan EncodingResultOrStatus) {
+// blobEncodingRequest is the encoding of a blob with a set of encoding params, which is shared by the quorums of the
+// blob that resolve to these params
+type blobEncodingRequest struct {
+	metadata *disperser.BlobMetadata
+	blob     *core.Blob
+	params   core.EncodingParams
+	quorums  []pendingRequestInfo
+}
+
+type encodingKey struct {
+	blobKey              disperser.BlobKey
+	params               core.EncodingParams
+	referenceBlockNumber uint
+}
+
+// joinEncodingInProgress adds the quorums of the request to the encoding of the blob with the same params that's in
+// progress, if any, and returns whether it did. Otherwise, the request is tracked as in progress until it's done.
+func (e *EncodingStreamer) joinEncodingInProgress(request *blobEncodingRequest, referenceBlockNumber uint) bool {
+	e.inProgressMu.Lock()
+	defer e.inProgressMu.Unlock()
+
+	key := encodingKey{
+		blobKey:              request.metadata.GetBlobKey(),
+		params:               request.params,
+		referenceBlockNumber: referenceBlockNumber,
+	}
+	inProgress, ok := e.encodingsInProgress[key]
+	if !ok {
+		e.encodingsInProgress[key] = request
+		return false
+	}
+	for _, res := range request.quorums {
+		joined := false
+		for _, q := range inProgress.quorums {
+			if q.BlobQuorumInfo.QuorumID == res.BlobQuorumInfo.QuorumID {
+				joined = true
+				break
+			}
+		}
+		if !joined {
+			inProgress.quorums = append(inProgress.quorums, res)
+		}
+		e.EncodedBlobstore.PutEncodingRequest(key.blobKey, res.BlobQuorumInfo.QuorumID)
+	}
+	return true
+}
+
+// finishEncodingsInProgress stops tracking the requests as in progress and returns the quorums of each of them,
+// including the ones that joined them
+func (e *EncodingStreamer) finishEncodingsInProgress(requests []*blobEncodingRequest, referenceBlockNumber uint) [][]pendingRequestInfo {
+	e.inProgressMu.Lock()
+	defer e.inProgressMu.Unlock()
+
+	quorums := make([][]pendingRequestInfo, len(requests))
+	for i, request := range requests {
+		delete(e.encodingsInProgress, encodingKey{
+			blobKey:              request.metadata.GetBlobKey(),
+			params:               request.params,
+			referenceBlockNumber: referenceBlockNumber,
+		})
+		quorums[i] = append([]pendingRequestInfo(nil), request.quorums...)
+	}
+	return quorums
+}
+
+// getEncodingRequests returns the encodings that the quorums of the blob that haven't been requested yet need, one per
+// distinct set of encoding params. The blob is marked as failed if its encoding params are invalid.
+func (e *EncodingStreamer) getEncodingRequests(ctx context.Context, metadata *disperser.BlobMetadata, blob *core.Blob, batchMetadata *batchMetadata, referenceBlockNumber uint) []*blobEncodingRequest {
 
 	// Validate the encoding parameters for each quorum
 
@@ -279,24 +421,31 @@ func (e *EncodingStreamer) RequestEncodingForBlob(ctx context.Context, metadata
 		chunkLength, err := e.assignmentCoordinator.GetMinimumChunkLength(numOperators, blobLength, quorumInfo.QuantizationFactor, quorum.QuorumThreshold, quorum.AdversaryThreshold)
 		if err != nil {
 			// This error shouldn't happen because we check blob headers before adding them blob store
-			e.logger.Error("[RequestEncodingForBlob] invalid request parameters", "err", err)
+			e.logger.Error("[RequestEncoding] invalid request parameters", "err", err)
 			continue
 		}
 		params, err := core.GetEncodingParams(chunkLength, quorumInfo.Info.TotalChunks)
 		if err != nil {
-			e.logger.Error("[RequestEncodingForBlob] error getting encoding params", "err", err)
+			e.logger.Error("[RequestEncoding] error getting encoding params", "err", err)
 			continue
 		}
 
 		err = core.ValidateEncodingParams(params, int(blobLength), e.SRSOrder)
 		if err != nil {
-			e.logger.Error("[RequestEncodingForBlob] invalid encoding params", "err", err)
+			e.logger.Error("[RequestEncoding] invalid encoding params", "err", err)
 			// Cancel the blob
-			err := e.blobStore.MarkBlobFailed(ctx, blobKey)
+			err := e.blobStore.MarkBlobFailed(ctx, blobKey, &disperser.BlobFailure{
+				Stage:     disperser.EncodingStage,
+				Error:     fmt.Sprintf("invalid encoding params: %v", err),
+				Attempt:   metadata.NumRetries,
+				Timestamp: uint64(time.Now().UnixNano()),
+			})
 			if err != nil {
-				e.logger.Error("[RequestEncodingForBlob] error marking blob failed", "err", err)
+				e.logger.Error("[RequestEncoding] error marking blob failed", "err", err)
+			} else {
+				e.notifier.Notify(metadata, disperser.Failed)
 			}
-			return
+			return nil
 		}
 
 		blobQuorumInfo := &core.BlobQuorumInfo{
@@ -316,52 +465,138 @@ func (e *EncodingStreamer) RequestEncodingForBlob(ctx context.Context, metadata
 		})
 	}
 
-	// Execute the encoding requests
-	for ind := range pending {
-
-		res := pending[ind]
+	// Quorums whose encoding params are the same share the encoding of the blob, so the blob is encoded once per
+	// distinct set of params and the result is fanned out to each of these quorums
+	requests := make([]*blobEncodingRequest, 0, len(pending))
+	requestByParams := make(map[core.EncodingParams]*blobEncodingRequest)
+	for _, res := range pending {
+		request, ok := requestByParams[res.EncodingParams]
+		if !ok {
+			request = &blobEncodingRequest{
+				metadata: metadata,
+				blob:     blob,
+				params:   res.EncodingParams,
+			}
+			requestByParams[res.EncodingParams] = request
+			requests = append(requests, request)
+		}
+		request.quorums = append(request.quorums, res)
+	}
+	return requests
+}
 
-		// Create a new context for each encoding request
-		// This allows us to cancel all outstanding encoding requests when we create a new batch
-		// This is necessary because an encoding request is dependent on the reference block number
-		// If the reference block number changes, we need to cancel all outstanding encoding requests
-		// and re-request them with the new reference block number
-		encodingCtx, cancel := context.WithTimeout(ctx, e.EncodingRequestTimeout)
-		e.mu.Lock()
-		e.encodingCtxCancelFuncs = append(e.encodingCtxCancelFuncs, cancel)
-		e.mu.Unlock()
-		e.Pool.Submit(func() {
-			defer cancel()
-			commits, chunks, err := e.encoderClient.EncodeBlob(encodingCtx, blob.Data, res.EncodingParams)
-			if err != nil {
-				encoderChan <- EncodingResultOrStatus{Err: err, EncodingResult: EncodingResult{
-					BlobMetadata:   metadata,
-					BlobQuorumInfo: res.BlobQuorumInfo,
-				}}
-				return
+// requestEncoding encodes the blobs of the requests, which have the same encoding params, and sends the result of each
+// of their quorums to encoderChan. A single blob is encoded with EncodeBlob, and several blobs with EncodeBlobs.
+func (e *EncodingStreamer) requestEncoding(ctx context.Context, params core.EncodingParams, requests []*blobEncodingRequest, batchMetadata *batchMetadata, referenceBlockNumber uint, encoderChan chan EncodingResultOrStatus) {
+	// Create a new context for each encoding request
+	// This allows us to cancel all outstanding encoding requests when we create a new batch
+	// This is necessary because an encoding request is dependent on the reference block number
+	// If the reference block number changes, we need to cancel all outstanding encoding requests
+	// and re-request them with the new reference block number
+	encodingCtx, cancel := context.WithTimeout(ctx, e.EncodingRequestTimeout)
+	e.mu.Lock()
+	e.encodingCtxCancelFuncs = append(e.encodingCtxCancelFuncs, cancel)
+	e.mu.Unlock()
+	e.Pool.Submit(func() {
+		defer cancel()
+		results := e.encode(encodingCtx, params, requests)
+		quorums := e.finishEncodingsInProgress(requests, referenceBlockNumber)
+		for i, request := range requests {
+			result := results[i]
+			if result.Err != nil {
+				// The quorums share the failed encoding, so the blob gets a single failure for all of them
+				failedQuorums := make([]core.QuorumID, len(quorums[i]))
+				for j, res := range quorums[i] {
+					failedQuorums[j] = res.BlobQuorumInfo.QuorumID
+				}
+				encoderChan <- EncodingResultOrStatus{
+					EncodingResult: EncodingResult{
+						BlobMetadata:   request.metadata,
+						BlobQuorumInfo: quorums[i][0].BlobQuorumInfo,
+					},
+					Err:           result.Err,
+					FailedQuorums: failedQuorums,
+				}
+				continue
 			}
 
-			encoderChan <- EncodingResultOrStatus{
-				EncodingResult: EncodingResult{
-					BlobMetadata:         metadata,
-					ReferenceBlockNumber: referenceBlockNumber,
-					BlobQuorumInfo:       res.BlobQuorumInfo,
-					Commitment:           commits,
-					Chunks:               chunks,
-					Assignments:          batchMetadata.QuorumInfos[res.BlobQuorumInfo.QuorumID].Assignments,
-				},
-				Err: nil,
+			for _, res := range quorums[i] {
+				encoderChan <- EncodingResultOrStatus{
+					EncodingResult: EncodingResult{
+						BlobMetadata:         request.metadata,
+						ReferenceBlockNumber: referenceBlockNumber,
+						BlobQuorumInfo:       res.BlobQuorumInfo,
+						Commitment:           &result.Commitments,
+						Chunks:               result.Chunks,
+						Assignments:          batchMetadata.QuorumInfos[res.BlobQuorumInfo.QuorumID].Assignments,
+					},
+					Err: nil,
+				}
 			}
-		})
-		e.EncodedBlobstore.PutEncodingRequest(blobKey, res.BlobQuorumInfo.QuorumID)
+		}
+	})
+	for _, request := range requests {
+		for _, res := range request.quorums {
+			e.EncodedBlobstore.PutEncodingRequest(request.metadata.GetBlobKey(), res.BlobQuorumInfo.QuorumID)
+		}
+	}
+}
 
+// encode encodes the blobs of the requests with the given params. The i-th result is the encoding of the blob of the
+// i-th request.
+func (e *EncodingStreamer) encode(ctx context.Context, params core.EncodingParams, requests []*blobEncodingRequest) []core.BlobEncodingResult {
+	results := make([]core.BlobEncodingResult, len(requests))
+	if len(requests) == 1 {
+		commits, chunks, err := e.encoderClient.EncodeBlob(ctx, requests[0].blob.Data, params)
+		if err != nil {
+			results[0].Err = err
+			return results
+		}
+		results[0].Chunks = chunks
+		if commits != nil {
+			results[0].Commitments = *commits
+		}
+		return results
 	}
 
+	blobs := make([][]byte, len(requests))
+	for i, request := range requests {
+		blobs[i] = request.blob.Data
+	}
+	encoded, err := e.encoderClient.EncodeBlobs(ctx, blobs, params)
+	if err == nil && len(encoded) != len(requests) {
+		err = fmt.Errorf("encoder returned %d results for %d blobs", len(encoded), len(requests))
+	}
+	if err != nil {
+		for i := range results {
+			results[i].Err = err
+		}
+		return results
+	}
+	return encoded
 }
 
 func (e *EncodingStreamer) ProcessEncodedBlobs(ctx context.Context, result EncodingResultOrStatus) error {
 	if result.Err != nil {
-		e.EncodedBlobstore.DeleteEncodingRequest(result.BlobMetadata.GetBlobKey(), result.BlobQuorumInfo.QuorumID)
+		failedQuorums := result.FailedQuorums
+		if len(failedQuorums) == 0 {
+			failedQuorums = []core.QuorumID{result.BlobQuorumInfo.QuorumID}
+		}
+		for _, quorumID := range failedQuorums {
+			e.EncodedBlobstore.DeleteEncodingRequest(result.BlobMetadata.GetBlobKey(), quorumID)
+		}
+		// Requests cancelled by CreateBatch are expected and will be made again, so they aren't recorded as failures
+		if !errors.Is(result.Err, context.Canceled) {
+			err := e.blobStore.RecordBlobFailure(ctx, result.BlobMetadata.GetBlobKey(), &disperser.BlobFailure{
+				Stage:     disperser.EncodingStage,
+				Error:     fmt.Sprintf("error encoding blob for quorums %v: %v", failedQuorums, result.Err),
+				Attempt:   result.BlobMetadata.NumRetries,
+				Timestamp: uint64(time.Now().UnixNano()),
+			})
+			if err != nil {
+				e.logger.Error("[ProcessEncodedBlobs] error recording blob failure", "err", err)
+			}
+		}
 		return fmt.Errorf("error encoding blob: %w", result.Err)
 	}
 
@@ -386,11 +621,20 @@ func (e *EncodingStreamer) ProcessEncodedBlobs(ctx context.Context, result Encod
 	return nil
 }
 
-// CreateBatch makes a batch from all blobs in the encoded blob store.
+// CreateBatch makes a batch from all blobs in the encoded blob store, except the ones in batches that are in flight.
 // If successful, it returns a batch, and updates the reference block number for next batch to use.
 // Otherwise, it returns an error and keeps the blobs in the encoded blob store.
+// The blobs of a batch are in flight until they're released with ReleaseBlobs.
 // This function is meant to be called periodically in a single goroutine as it resets the state of the encoded blob store.
-func (e *EncodingStreamer) CreateBatch() (*batch, error) {
+func (e *EncodingStreamer) CreateBatch(ctx context.Context) (*batch, error) {
+	// Read the status of the encoded blobs with a single read before taking the lock, so that encoding results can still
+	// be processed meanwhile
+	// Their encoded results are kept if this fails, so the batch isn't created until their status can be checked
+	encodedMetadata, err := e.getEncodedBlobMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// lock to update e.ReferenceBlockNumber
 	e.mu.Lock()
 	defer e.mu.Unlock()
@@ -419,6 +663,9 @@ func (e *EncodingStreamer) CreateBatch() (*batch, error) {
 	// If any pending encoded results are discarded here, it will be re-requested in the next iteration
 	encodedResults := e.EncodedBlobstore.GetNewAndDeleteStaleEncodingResults(e.ReferenceBlockNumber)
 
+	// Skip the blobs that have been cancelled since they were encoded
+	encodedResults = e.removeCancelledResults(encodedResults, encodedMetadata)
+
 	// Reset the notifier
 	e.EncodedSizeNotifier.mu.Lock()
 	e.EncodedSizeNotifier.active = true
@@ -517,6 +764,8 @@ func (e *EncodingStreamer) CreateBatch() (*batch, error) {
 	}
 
 	e.ReferenceBlockNumber = 0
+	// The blobs aren't encoded again or included in another batch until the batcher is done with this one
+	e.EncodedBlobstore.MarkInFlight(metadatas)
 
 	return &batch{
 		EncodedBlobs:  encodedBlobs,
@@ -528,12 +777,68 @@ func (e *EncodingStreamer) CreateBatch() (*batch, error) {
 	}, nil
 }
 
+// ReleaseBlobs makes the blobs of a batch available for encoding and batching again once the batch is confirmed or
+// has failed. The encoded results of the blobs that are still processing are kept, so they can be reused if the
+// reference block number of the next batch doesn't change.
+func (e *EncodingStreamer) ReleaseBlobs(metadatas []*disperser.BlobMetadata) {
+	e.EncodedBlobstore.ReleaseInFlight(metadatas)
+}
+
 func (e *EncodingStreamer) RemoveEncodedBlob(metadata *disperser.BlobMetadata) {
 	for _, sp := range metadata.RequestMetadata.SecurityParams {
 		e.EncodedBlobstore.DeleteEncodingResult(metadata.GetBlobKey(), sp.QuorumID)
 	}
 }
 
+// getEncodedBlobMetadata reads the metadata of the blobs that have encoded results in bulk, so the number of round
+// trips to the blob store doesn't grow with the number of blobs.
+func (e *EncodingStreamer) getEncodedBlobMetadata(ctx context.Context) (map[disperser.BlobKey]*disperser.BlobMetadata, error) {
+	blobKeys := e.EncodedBlobstore.GetEncodedBlobKeys()
+	if len(blobKeys) == 0 {
+		return nil, nil
+	}
+	metadatas, err := e.blobStore.GetBulkBlobMetadata(ctx, blobKeys)
+	if err != nil {
+		return nil, fmt.Errorf("error getting status of encoded blobs: %w", err)
+	}
+	metadataByKey := make(map[disperser.BlobKey]*disperser.BlobMetadata, len(metadatas))
+	for _, metadata := range metadatas {
+		metadataByKey[metadata.GetBlobKey()] = metadata
+	}
+	return metadataByKey, nil
+}
+
+// removeCancelledResults drops the encoded results of the blobs that are no longer processing, e.g. because they
+// were cancelled after being encoded, along with their pending encoding requests.
+// The status of the blobs is taken from encodedMetadata. The results of the blobs that aren't in it, which were
+// encoded after it was read, are kept.
+func (e *EncodingStreamer) removeCancelledResults(encodedResults []*EncodingResult, encodedMetadata map[disperser.BlobKey]*disperser.BlobMetadata) []*EncodingResult {
+	filtered := make([]*EncodingResult, 0, len(encodedResults))
+	removed := make(map[disperser.BlobKey]struct{})
+	for _, result := range encodedResults {
+		blobKey := result.BlobMetadata.GetBlobKey()
+		metadata, ok := encodedMetadata[blobKey]
+		if !ok || metadata.BlobStatus == disperser.Processing {
+			filtered = append(filtered, result)
+			continue
+		}
+		if _, ok := removed[blobKey]; !ok {
+			removed[blobKey] = struct{}{}
+			e.logger.Info("[CreateBatch] skipping blob that is no longer processing", "blobKey", blobKey.String(), "status", metadata.BlobStatus.String())
+			e.RemoveBlob(metadata)
+		}
+	}
+	return filtered
+}
+
+// RemoveBlob drops the pending encoding requests and the encoded results of a blob
+func (e *EncodingStreamer) RemoveBlob(metadata *disperser.BlobMetadata) {
+	for _, sp := range metadata.RequestMetadata.SecurityParams {
+		e.EncodedBlobstore.DeleteEncodingRequest(metadata.GetBlobKey(), sp.QuorumID)
+	}
+	e.RemoveEncodedBlob(metadata)
+}
+
 func (e *EncodingStreamer) getBatchMetadata(ctx context.Context, metadatas []*disperser.BlobMetadata, blockNumber uint) (*batchMetadata, error) {
 	quorums := make(map[core.QuorumID]QuorumInfo, 0)
 	for _, metadata := range metadatas {
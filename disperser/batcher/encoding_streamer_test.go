@@ -12,6 +12,7 @@ import (
 	coremock "github.com/Layr-Labs/eigenda/core/mock"
 	"github.com/Layr-Labs/eigenda/disperser"
 	"github.com/Layr-Labs/eigenda/disperser/batcher"
+	batchermock "github.com/Layr-Labs/eigenda/disperser/batcher/mock"
 	"github.com/Layr-Labs/eigenda/disperser/common/inmem"
 	"github.com/Layr-Labs/eigenda/disperser/mock"
 	"github.com/stretchr/testify/assert"
@@ -20,10 +21,11 @@ import (
 
 var (
 	streamerConfig = batcher.StreamerConfig{
-		SRSOrder:               300000,
-		EncodingRequestTimeout: 5 * time.Second,
-		EncodingQueueLimit:     100,
-		PoolSize:               5,
+		SRSOrder:                 300000,
+		EncodingRequestTimeout:   5 * time.Second,
+		EncodingQueueLimit:       100,
+		PoolSize:                 5,
+		MaxBlobsToFetchFromStore: 10,
 	}
 )
 
@@ -46,7 +48,7 @@ func createEncodingStreamer(t *testing.T, initialBlockNumber uint, batchThreshol
 	asgn := &core.StdAssignmentCoordinator{}
 	sizeNotifier := batcher.NewEncodedSizeNotifier(make(chan struct{}, 1), batchThreshold)
 
-	encodingStreamer, err := batcher.NewEncodingStreamer(streamerConfig, blobStore, cst, encoderClient, asgn, sizeNotifier, logger)
+	encodingStreamer, err := batcher.NewEncodingStreamer(streamerConfig, blobStore, cst, encoderClient, asgn, sizeNotifier, batchermock.NewNotifier(), logger)
 	assert.Nil(t, err)
 	encodingStreamer.ReferenceBlockNumber = initialBlockNumber
 
@@ -68,7 +70,7 @@ func TestEncodingQueueLimit(t *testing.T) {
 	asgn := &core.StdAssignmentCoordinator{}
 	sizeNotifier := batcher.NewEncodedSizeNotifier(make(chan struct{}, 1), 100000)
 
-	encodingStreamer, err := batcher.NewEncodingStreamer(streamerConfig, blobStore, cst, encoderClient, asgn, sizeNotifier, logger)
+	encodingStreamer, err := batcher.NewEncodingStreamer(streamerConfig, blobStore, cst, encoderClient, asgn, sizeNotifier, batchermock.NewNotifier(), logger)
 	assert.Nil(t, err)
 	encodingStreamer.ReferenceBlockNumber = 10
 
@@ -243,7 +245,7 @@ func TestStreamingEncoding(t *testing.T) {
 	assert.Equal(t, total, uint(131584))
 
 	// Cancel previous blob so it doesn't get reencoded.
-	err = c.blobStore.MarkBlobFailed(ctx, metadataKey)
+	err = c.blobStore.MarkBlobFailed(ctx, metadataKey, &disperser.BlobFailure{Stage: disperser.ConfirmationStage, Error: "test failure"})
 	assert.Nil(t, err)
 
 	encodingStreamer.ReferenceBlockNumber = 11
@@ -282,6 +284,59 @@ func TestStreamingEncoding(t *testing.T) {
 	assert.Equal(t, total, uint(131584))
 }
 
+func TestRequestEncodingInPages(t *testing.T) {
+	streamerConfig := batcher.StreamerConfig{
+		SRSOrder:                 300000,
+		EncodingRequestTimeout:   5 * time.Second,
+		EncodingQueueLimit:       100,
+		PoolSize:                 5,
+		MaxBlobsToFetchFromStore: 2,
+	}
+	encodingStreamer, c := createEncodingStreamer(t, 10, 1e12, streamerConfig)
+
+	blob := makeTestBlob([]*core.SecurityParam{{
+		QuorumID:           0,
+		AdversaryThreshold: 80,
+		QuorumThreshold:    100,
+	}})
+	ctx := context.Background()
+	requestedAt := uint64(time.Now().UnixNano())
+	metadataKeys := make([]disperser.BlobKey, 3)
+	for i := range metadataKeys {
+		var err error
+		metadataKeys[i], err = c.blobStore.StoreBlob(ctx, &blob, requestedAt+uint64(i))
+		assert.Nil(t, err)
+	}
+
+	out := make(chan batcher.EncodingResultOrStatus, len(metadataKeys))
+	// The first page has the 2 oldest blobs
+	err := encodingStreamer.RequestEncoding(ctx, out)
+	assert.Nil(t, err)
+	assert.True(t, encodingStreamer.EncodedBlobstore.HasEncodingRequested(metadataKeys[0], core.QuorumID(0), 10))
+	assert.True(t, encodingStreamer.EncodedBlobstore.HasEncodingRequested(metadataKeys[1], core.QuorumID(0), 10))
+	assert.False(t, encodingStreamer.EncodedBlobstore.HasEncodingRequested(metadataKeys[2], core.QuorumID(0), 10))
+
+	// The second page has the remaining blob
+	err = encodingStreamer.RequestEncoding(ctx, out)
+	assert.Nil(t, err)
+	assert.True(t, encodingStreamer.EncodedBlobstore.HasEncodingRequested(metadataKeys[2], core.QuorumID(0), 10))
+
+	// The streamer starts over from the first page, whose blobs have already been requested
+	err = encodingStreamer.RequestEncoding(ctx, out)
+	assert.Nil(t, err)
+
+	for range metadataKeys {
+		err = encodingStreamer.ProcessEncodedBlobs(ctx, <-out)
+		assert.Nil(t, err)
+	}
+	assert.Len(t, out, 0)
+	for _, metadataKey := range metadataKeys {
+		encodedResult, err := encodingStreamer.EncodedBlobstore.GetEncodingResult(metadataKey, core.QuorumID(0))
+		assert.Nil(t, err)
+		assert.NotNil(t, encodedResult)
+	}
+}
+
 func TestEncodingFailure(t *testing.T) {
 	logger := &cmock.Logger{}
 	blobStore := inmem.NewBlobStore()
@@ -291,13 +346,14 @@ func TestEncodingFailure(t *testing.T) {
 	asgn := &core.StdAssignmentCoordinator{}
 	sizeNotifier := batcher.NewEncodedSizeNotifier(make(chan struct{}, 1), 1e12)
 	streamerConfig := batcher.StreamerConfig{
-		SRSOrder:               300000,
-		EncodingRequestTimeout: 5 * time.Second,
-		EncodingQueueLimit:     100,
-		PoolSize:               5,
+		SRSOrder:                 300000,
+		EncodingRequestTimeout:   5 * time.Second,
+		EncodingQueueLimit:       100,
+		PoolSize:                 5,
+		MaxBlobsToFetchFromStore: 10,
 	}
 
-	encodingStreamer, err := batcher.NewEncodingStreamer(streamerConfig, blobStore, cst, encoderClient, asgn, sizeNotifier, logger)
+	encodingStreamer, err := batcher.NewEncodingStreamer(streamerConfig, blobStore, cst, encoderClient, asgn, sizeNotifier, batchermock.NewNotifier(), logger)
 	assert.Nil(t, err)
 	encodingStreamer.ReferenceBlockNumber = 10
 
@@ -340,6 +396,17 @@ func TestEncodingFailure(t *testing.T) {
 	assert.False(t, isRequested)
 	isRequested = encodingStreamer.EncodedBlobstore.HasEncodingRequested(metadataKey, core.QuorumID(1), 10)
 	assert.False(t, isRequested)
+
+	// The failures are recorded without affecting the retries of the blob
+	metadata, err := blobStore.GetBlobMetadata(ctx, metadataKey)
+	assert.Nil(t, err)
+	assert.Equal(t, disperser.Processing, metadata.BlobStatus)
+	assert.Equal(t, uint(0), metadata.NumRetries)
+	assert.Len(t, metadata.FailureHistory, 2)
+	for _, failure := range metadata.FailureHistory {
+		assert.Equal(t, disperser.EncodingStage, failure.Stage)
+		assert.Contains(t, failure.Error, "errrrr")
+	}
 }
 
 func TestPartialBlob(t *testing.T) {
@@ -411,7 +478,7 @@ func TestPartialBlob(t *testing.T) {
 
 	// get batch
 	assert.Equal(t, encodingStreamer.ReferenceBlockNumber, uint(10))
-	batch, err := encodingStreamer.CreateBatch()
+	batch, err := encodingStreamer.CreateBatch(context.Background())
 	assert.Nil(t, err)
 	assert.NotNil(t, batch)
 	assert.Equal(t, encodingStreamer.ReferenceBlockNumber, uint(0))
@@ -471,10 +538,11 @@ func TestIncorrectParameters(t *testing.T) {
 	ctx := context.Background()
 
 	streamerConfig := batcher.StreamerConfig{
-		SRSOrder:               3000,
-		EncodingRequestTimeout: 5 * time.Second,
-		EncodingQueueLimit:     100,
-		PoolSize:               5,
+		SRSOrder:                 3000,
+		EncodingRequestTimeout:   5 * time.Second,
+		EncodingQueueLimit:       100,
+		PoolSize:                 5,
+		MaxBlobsToFetchFromStore: 10,
 	}
 
 	encodingStreamer, c := createEncodingStreamer(t, 0, 1e12, streamerConfig)
@@ -515,6 +583,8 @@ func TestIncorrectParameters(t *testing.T) {
 	stats, err := c.blobStore.GetBlobMetadata(ctx, metadataKey)
 	assert.NoError(t, err)
 	assert.Equal(t, disperser.Failed, stats.BlobStatus)
+	assert.Equal(t, disperser.EncodingStage, stats.LastFailure().Stage)
+	assert.Contains(t, stats.LastFailure().Error, "invalid encoding params")
 
 }
 
@@ -578,7 +648,7 @@ func TestGetBatch(t *testing.T) {
 
 	// get batch
 	assert.Equal(t, encodingStreamer.ReferenceBlockNumber, uint(10))
-	batch, err := encodingStreamer.CreateBatch()
+	batch, err := encodingStreamer.CreateBatch(context.Background())
 	assert.Nil(t, err)
 	assert.NotNil(t, batch)
 	assert.Equal(t, encodingStreamer.ReferenceBlockNumber, uint(0))
@@ -681,3 +751,374 @@ func TestGetBatch(t *testing.T) {
 	assert.Contains(t, batch.BlobMetadata, metadata1)
 	assert.Contains(t, batch.BlobMetadata, metadata2)
 }
+
+func TestCancelledBlobIsNotBatched(t *testing.T) {
+	encodingStreamer, c := createEncodingStreamer(t, 10, 1e12, streamerConfig)
+	ctx := context.Background()
+
+	blob1 := makeTestBlob([]*core.SecurityParam{{
+		QuorumID:           0,
+		AdversaryThreshold: 80,
+		QuorumThreshold:    100,
+	}})
+	blob2 := makeTestBlob([]*core.SecurityParam{{
+		QuorumID:           0,
+		AdversaryThreshold: 80,
+		QuorumThreshold:    100,
+	}})
+	metadataKey1, err := c.blobStore.StoreBlob(ctx, &blob1, uint64(time.Now().UnixNano()))
+	assert.Nil(t, err)
+	metadataKey2, err := c.blobStore.StoreBlob(ctx, &blob2, uint64(time.Now().UnixNano()))
+	assert.Nil(t, err)
+
+	c.chainDataMock.On("GetCurrentBlockNumber").Return(uint(10), nil)
+
+	out := make(chan batcher.EncodingResultOrStatus)
+	err = encodingStreamer.RequestEncoding(context.Background(), out)
+	assert.Nil(t, err)
+	err = encodingStreamer.ProcessEncodedBlobs(context.Background(), <-out)
+	assert.Nil(t, err)
+	err = encodingStreamer.ProcessEncodedBlobs(context.Background(), <-out)
+	assert.Nil(t, err)
+	encodingStreamer.Pool.StopWait()
+
+	// Cancel the first blob after it has been encoded
+	err = c.blobStore.MarkBlobCancelled(ctx, metadataKey1)
+	assert.Nil(t, err)
+
+	batch, err := encodingStreamer.CreateBatch(context.Background())
+	assert.Nil(t, err)
+	assert.NotNil(t, batch)
+	assert.Len(t, batch.BlobMetadata, 1)
+	assert.Equal(t, metadataKey2, batch.BlobMetadata[0].GetBlobKey())
+	assert.False(t, encodingStreamer.EncodedBlobstore.HasEncodingRequested(metadataKey1, core.QuorumID(0), 10))
+
+	metadata1, err := c.blobStore.GetBlobMetadata(ctx, metadataKey1)
+	assert.Nil(t, err)
+	assert.Equal(t, disperser.Cancelled, metadata1.BlobStatus)
+}
+
+func TestInFlightBlobIsNotBatchedAgain(t *testing.T) {
+	encodingStreamer, c := createEncodingStreamer(t, 10, 1e12, streamerConfig)
+	ctx := context.Background()
+	c.chainDataMock.On("GetCurrentBlockNumber").Return(uint(10), nil)
+
+	blob := makeTestBlob([]*core.SecurityParam{{
+		QuorumID:           0,
+		AdversaryThreshold: 80,
+		QuorumThreshold:    100,
+	}})
+	metadataKey1, err := c.blobStore.StoreBlob(ctx, &blob, uint64(time.Now().UnixNano()))
+	assert.Nil(t, err)
+
+	out := make(chan batcher.EncodingResultOrStatus)
+	err = encodingStreamer.RequestEncoding(ctx, out)
+	assert.Nil(t, err)
+	err = encodingStreamer.ProcessEncodedBlobs(ctx, <-out)
+	assert.Nil(t, err)
+	batch1, err := encodingStreamer.CreateBatch(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, batch1.BlobMetadata, 1)
+	assert.Equal(t, metadataKey1, batch1.BlobMetadata[0].GetBlobKey())
+
+	// The blob of the first batch isn't encoded again while the batch is in flight
+	metadataKey2, err := c.blobStore.StoreBlob(ctx, &blob, uint64(time.Now().UnixNano()))
+	assert.Nil(t, err)
+	err = encodingStreamer.RequestEncoding(ctx, out)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, encodingStreamer.EncodedBlobstore.GetBacklog().NumPendingRequests)
+	err = encodingStreamer.ProcessEncodedBlobs(ctx, <-out)
+	assert.Nil(t, err)
+	encodingStreamer.Pool.StopWait()
+
+	// nor included in the next batch, even though its encoded result is still fresh
+	batch2, err := encodingStreamer.CreateBatch(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, batch2.BlobMetadata, 1)
+	assert.Equal(t, metadataKey2, batch2.BlobMetadata[0].GetBlobKey())
+
+	// The encoded result of the blob is reused once its batch fails
+	encodingStreamer.ReleaseBlobs(batch1.BlobMetadata)
+	encodingStreamer.ReferenceBlockNumber = 10
+	batch3, err := encodingStreamer.CreateBatch(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, batch3.BlobMetadata, 1)
+	assert.Equal(t, metadataKey1, batch3.BlobMetadata[0].GetBlobKey())
+}
+
+func TestQuorumsWithSameParamsShareEncoding(t *testing.T) {
+	logger := &cmock.Logger{}
+	blobStore := inmem.NewBlobStore()
+	cst, err := coremock.NewChainDataMock(numOperators)
+	assert.Nil(t, err)
+	encoderClient := mock.NewMockEncoderClient()
+	commitments := &core.BlobCommitments{Length: 48}
+	chunks := []*core.Chunk{{}}
+	encoderClient.On("EncodeBlob", tmock.Anything, tmock.Anything, tmock.Anything).Return(commitments, chunks, nil)
+	asgn := &core.StdAssignmentCoordinator{}
+	sizeNotifier := batcher.NewEncodedSizeNotifier(make(chan struct{}, 1), 1e12)
+
+	encodingStreamer, err := batcher.NewEncodingStreamer(streamerConfig, blobStore, cst, encoderClient, asgn, sizeNotifier, batchermock.NewNotifier(), logger)
+	assert.Nil(t, err)
+	encodingStreamer.ReferenceBlockNumber = 10
+
+	ctx := context.Background()
+
+	// Quorums 0 and 1 have the same operators and security params, so they resolve to the same encoding params.
+	// Quorum 2 has different security params.
+	blob := makeTestBlob([]*core.SecurityParam{{
+		QuorumID:           0,
+		AdversaryThreshold: 80,
+		QuorumThreshold:    100,
+	}, {
+		QuorumID:           1,
+		AdversaryThreshold: 80,
+		QuorumThreshold:    100,
+	}, {
+		QuorumID:           2,
+		AdversaryThreshold: 70,
+		QuorumThreshold:    95,
+	}})
+	metadataKey, err := blobStore.StoreBlob(ctx, &blob, uint64(time.Now().UnixNano()))
+	assert.Nil(t, err)
+
+	out := make(chan batcher.EncodingResultOrStatus)
+	err = encodingStreamer.RequestEncoding(ctx, out)
+	assert.Nil(t, err)
+	for i := 0; i < 3; i++ {
+		err = encodingStreamer.ProcessEncodedBlobs(ctx, <-out)
+		assert.Nil(t, err)
+	}
+	encodingStreamer.Pool.StopWait()
+
+	encoderClient.AssertNumberOfCalls(t, "EncodeBlob", 2)
+	results := make([]*batcher.EncodingResult, 3)
+	for quorumID := range results {
+		results[quorumID], err = encodingStreamer.EncodedBlobstore.GetEncodingResult(metadataKey, core.QuorumID(quorumID))
+		assert.Nil(t, err)
+		assert.Equal(t, core.QuorumID(quorumID), results[quorumID].BlobQuorumInfo.QuorumID)
+		assert.Equal(t, commitments, results[quorumID].Commitment)
+		assert.Equal(t, chunks, results[quorumID].Chunks)
+	}
+	assert.Equal(t, results[0].BlobQuorumInfo.EncodedBlobLength, results[1].BlobQuorumInfo.EncodedBlobLength)
+	assert.NotEqual(t, results[0].BlobQuorumInfo.EncodedBlobLength, results[2].BlobQuorumInfo.EncodedBlobLength)
+}
+
+func TestBlobsWithSameParamsAreEncodedTogether(t *testing.T) {
+	logger := &cmock.Logger{}
+	blobStore := inmem.NewBlobStore()
+	cst, err := coremock.NewChainDataMock(numOperators)
+	assert.Nil(t, err)
+	encoderClient := mock.NewMockEncoderClient()
+	commitments := &core.BlobCommitments{Length: 48}
+	chunks := []*core.Chunk{{}}
+	encoderClient.On("EncodeBlob", tmock.Anything, tmock.Anything, tmock.Anything).Return(commitments, chunks, nil)
+	encoderClient.On("EncodeBlobs", tmock.Anything, tmock.Anything, tmock.Anything).Return([]core.BlobEncodingResult{
+		{Commitments: *commitments, Chunks: chunks},
+		{Err: fmt.Errorf("blob is too large")},
+	}, nil)
+	asgn := &core.StdAssignmentCoordinator{}
+	sizeNotifier := batcher.NewEncodedSizeNotifier(make(chan struct{}, 1), 1e12)
+
+	config := streamerConfig
+	config.MaxBlobsPerEncodingRequest = 2
+	encodingStreamer, err := batcher.NewEncodingStreamer(config, blobStore, cst, encoderClient, asgn, sizeNotifier, batchermock.NewNotifier(), logger)
+	assert.Nil(t, err)
+	encodingStreamer.ReferenceBlockNumber = 10
+
+	ctx := context.Background()
+	metadataKeys := make([]disperser.BlobKey, 3)
+	for i := range metadataKeys {
+		blob := makeTestBlob([]*core.SecurityParam{{
+			QuorumID:           0,
+			AdversaryThreshold: 80,
+			QuorumThreshold:    100,
+		}})
+		metadataKeys[i], err = blobStore.StoreBlob(ctx, &blob, uint64(time.Now().UnixNano())+uint64(i))
+		assert.Nil(t, err)
+	}
+
+	out := make(chan batcher.EncodingResultOrStatus)
+	err = encodingStreamer.RequestEncoding(ctx, out)
+	assert.Nil(t, err)
+	numFailed := 0
+	for i := 0; i < 3; i++ {
+		if err := encodingStreamer.ProcessEncodedBlobs(ctx, <-out); err != nil {
+			numFailed++
+		}
+	}
+	encodingStreamer.Pool.StopWait()
+
+	// The 3 blobs have the same params, so the first 2 are encoded with a single request and the last one on its own
+	encoderClient.AssertNumberOfCalls(t, "EncodeBlobs", 1)
+	encoderClient.AssertNumberOfCalls(t, "EncodeBlob", 1)
+	for _, call := range encoderClient.Calls {
+		if call.Method == "EncodeBlobs" {
+			assert.Len(t, call.Arguments.Get(1), 2)
+		}
+	}
+	assert.Equal(t, 1, numFailed)
+	assert.Equal(t, 2, encodingStreamer.EncodedBlobstore.GetBacklog().NumEncodedResults)
+}
+
+func TestEncodingRequestsAreBoundedBySize(t *testing.T) {
+	logger := &cmock.Logger{}
+	blobStore := inmem.NewBlobStore()
+	cst, err := coremock.NewChainDataMock(numOperators)
+	assert.Nil(t, err)
+	encoderClient := mock.NewMockEncoderClient()
+	commitments := &core.BlobCommitments{Length: 48}
+	chunks := []*core.Chunk{{}}
+	encoderClient.On("EncodeBlob", tmock.Anything, tmock.Anything, tmock.Anything).Return(commitments, chunks, nil)
+	encoderClient.On("EncodeBlobs", tmock.Anything, tmock.Anything, tmock.Anything).Return([]core.BlobEncodingResult{
+		{Commitments: *commitments, Chunks: chunks},
+		{Commitments: *commitments, Chunks: chunks},
+	}, nil)
+	asgn := &core.StdAssignmentCoordinator{}
+	sizeNotifier := batcher.NewEncodedSizeNotifier(make(chan struct{}, 1), 1e12)
+
+	ctx := context.Background()
+	metadataKeys := make([]disperser.BlobKey, 3)
+	blobSize := 0
+	for i := range metadataKeys {
+		blob := makeTestBlob([]*core.SecurityParam{{
+			QuorumID:           0,
+			AdversaryThreshold: 80,
+			QuorumThreshold:    100,
+		}})
+		blobSize = len(blob.Data)
+		metadataKeys[i], err = blobStore.StoreBlob(ctx, &blob, uint64(time.Now().UnixNano())+uint64(i))
+		assert.Nil(t, err)
+	}
+
+	config := streamerConfig
+	config.MaxBlobsPerEncodingRequest = 16
+	config.MaxBytesPerEncodingRequest = 2 * blobSize
+	encodingStreamer, err := batcher.NewEncodingStreamer(config, blobStore, cst, encoderClient, asgn, sizeNotifier, batchermock.NewNotifier(), logger)
+	assert.Nil(t, err)
+	encodingStreamer.ReferenceBlockNumber = 10
+
+	out := make(chan batcher.EncodingResultOrStatus)
+	err = encodingStreamer.RequestEncoding(ctx, out)
+	assert.Nil(t, err)
+	for i := 0; i < 3; i++ {
+		err = encodingStreamer.ProcessEncodedBlobs(ctx, <-out)
+		assert.Nil(t, err)
+	}
+	encodingStreamer.Pool.StopWait()
+
+	// Only 2 of the 3 blobs fit in the size of a request, so the last one is encoded on its own
+	encoderClient.AssertNumberOfCalls(t, "EncodeBlobs", 1)
+	encoderClient.AssertNumberOfCalls(t, "EncodeBlob", 1)
+	for _, call := range encoderClient.Calls {
+		if call.Method == "EncodeBlobs" {
+			assert.Len(t, call.Arguments.Get(1), 2)
+		}
+	}
+	assert.Equal(t, 3, encodingStreamer.EncodedBlobstore.GetBacklog().NumEncodedResults)
+}
+
+func TestSharedEncodingFailureIsRecordedOnce(t *testing.T) {
+	logger := &cmock.Logger{}
+	blobStore := inmem.NewBlobStore()
+	cst, err := coremock.NewChainDataMock(numOperators)
+	assert.Nil(t, err)
+	encoderClient := mock.NewMockEncoderClient()
+	encoderClient.On("EncodeBlob", tmock.Anything, tmock.Anything, tmock.Anything).Return(nil, nil, fmt.Errorf("errrrr"))
+	asgn := &core.StdAssignmentCoordinator{}
+	sizeNotifier := batcher.NewEncodedSizeNotifier(make(chan struct{}, 1), 1e12)
+
+	encodingStreamer, err := batcher.NewEncodingStreamer(streamerConfig, blobStore, cst, encoderClient, asgn, sizeNotifier, batchermock.NewNotifier(), logger)
+	assert.Nil(t, err)
+	encodingStreamer.ReferenceBlockNumber = 10
+
+	ctx := context.Background()
+
+	// Quorums 0 and 1 resolve to the same encoding params, so they share a single encoding of the blob
+	blob := makeTestBlob([]*core.SecurityParam{{
+		QuorumID:           0,
+		AdversaryThreshold: 80,
+		QuorumThreshold:    100,
+	}, {
+		QuorumID:           1,
+		AdversaryThreshold: 80,
+		QuorumThreshold:    100,
+	}})
+	metadataKey, err := blobStore.StoreBlob(ctx, &blob, uint64(time.Now().UnixNano()))
+	assert.Nil(t, err)
+
+	out := make(chan batcher.EncodingResultOrStatus, 2)
+	err = encodingStreamer.RequestEncoding(ctx, out)
+	assert.Nil(t, err)
+	encodingStreamer.Pool.StopWait()
+
+	assert.Len(t, out, 1)
+	result := <-out
+	assert.ElementsMatch(t, []core.QuorumID{0, 1}, result.FailedQuorums)
+	err = encodingStreamer.ProcessEncodedBlobs(ctx, result)
+	assert.NotNil(t, err)
+	for _, quorumID := range []core.QuorumID{0, 1} {
+		assert.False(t, encodingStreamer.EncodedBlobstore.HasEncodingRequested(metadataKey, quorumID, 10))
+	}
+
+	metadata, err := blobStore.GetBlobMetadata(ctx, metadataKey)
+	assert.Nil(t, err)
+	assert.Len(t, metadata.FailureHistory, 1)
+	assert.Contains(t, metadata.FailureHistory[0].Error, "errrrr")
+}
+
+func TestQuorumJoinsEncodingInProgress(t *testing.T) {
+	logger := &cmock.Logger{}
+	blobStore := inmem.NewBlobStore()
+	cst, err := coremock.NewChainDataMock(numOperators)
+	assert.Nil(t, err)
+	encoderClient := mock.NewMockEncoderClient()
+	commitments := &core.BlobCommitments{Length: 48}
+	chunks := []*core.Chunk{{}}
+	release := make(chan time.Time)
+	encoderClient.On("EncodeBlob", tmock.Anything, tmock.Anything, tmock.Anything).WaitUntil(release).Return(commitments, chunks, nil)
+	asgn := &core.StdAssignmentCoordinator{}
+	sizeNotifier := batcher.NewEncodedSizeNotifier(make(chan struct{}, 1), 1e12)
+
+	encodingStreamer, err := batcher.NewEncodingStreamer(streamerConfig, blobStore, cst, encoderClient, asgn, sizeNotifier, batchermock.NewNotifier(), logger)
+	assert.Nil(t, err)
+	encodingStreamer.ReferenceBlockNumber = 10
+
+	ctx := context.Background()
+	blob := makeTestBlob([]*core.SecurityParam{{
+		QuorumID:           0,
+		AdversaryThreshold: 80,
+		QuorumThreshold:    100,
+	}, {
+		QuorumID:           1,
+		AdversaryThreshold: 80,
+		QuorumThreshold:    100,
+	}})
+	metadataKey, err := blobStore.StoreBlob(ctx, &blob, uint64(time.Now().UnixNano()))
+	assert.Nil(t, err)
+
+	out := make(chan batcher.EncodingResultOrStatus, 2)
+	err = encodingStreamer.RequestEncoding(ctx, out)
+	assert.Nil(t, err)
+
+	// Quorum 1 is requested again while the encoding it shares with quorum 0 is in progress
+	encodingStreamer.EncodedBlobstore.DeleteEncodingRequest(metadataKey, 1)
+	err = encodingStreamer.RequestEncoding(ctx, out)
+	assert.Nil(t, err)
+	assert.True(t, encodingStreamer.EncodedBlobstore.HasEncodingRequested(metadataKey, 1, 10))
+
+	close(release)
+	encodingStreamer.Pool.StopWait()
+
+	encoderClient.AssertNumberOfCalls(t, "EncodeBlob", 1)
+	assert.Len(t, out, 2)
+	for i := 0; i < 2; i++ {
+		err = encodingStreamer.ProcessEncodedBlobs(ctx, <-out)
+		assert.Nil(t, err)
+	}
+	for _, quorumID := range []core.QuorumID{0, 1} {
+		result, err := encodingStreamer.EncodedBlobstore.GetEncodingResult(metadataKey, quorumID)
+		assert.Nil(t, err)
+		assert.Equal(t, commitments, result.Commitment)
+	}
+}
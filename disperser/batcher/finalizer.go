@@ -2,12 +2,14 @@ package batcher
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"time"
 
 	"github.com/Layr-Labs/eigenda/common"
 	"github.com/Layr-Labs/eigenda/disperser"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/core/types"
 
 	gcommon "github.com/ethereum/go-ethereum/common"
@@ -25,20 +27,31 @@ type Finalizer interface {
 type finalizer struct {
 	timeout      time.Duration
 	loopInterval time.Duration
-	blobStore    disperser.BlobStore
-	ethClient    common.EthClient
-	rpcClient    common.RPCEthClient
-	logger       common.Logger
+	// numBlobsPerFetch is the number of confirmed blobs fetched from the blob store at a time
+	numBlobsPerFetch int32
+	// reorgDepth is the number of blocks by which the confirmation block of a blob must be behind the latest
+	// finalized block before a missing or reverted confirmation transaction is considered dropped
+	reorgDepth uint64
+	blobStore  disperser.BlobStore
+	ethClient  common.EthClient
+	rpcClient  common.RPCEthClient
+	notifier   Notifier
+	logger     common.Logger
+	metrics    *Metrics
 }
 
-func NewFinalizer(timeout time.Duration, loopInterval time.Duration, blobStore disperser.BlobStore, ethClient common.EthClient, rpcClient common.RPCEthClient, logger common.Logger) Finalizer {
+func NewFinalizer(timeout time.Duration, loopInterval time.Duration, numBlobsPerFetch int32, reorgDepth uint64, blobStore disperser.BlobStore, ethClient common.EthClient, rpcClient common.RPCEthClient, notifier Notifier, logger common.Logger, metrics *Metrics) Finalizer {
 	return &finalizer{
-		timeout:      timeout,
-		loopInterval: loopInterval,
-		blobStore:    blobStore,
-		ethClient:    ethClient,
-		rpcClient:    rpcClient,
-		logger:       logger,
+		timeout:          timeout,
+		loopInterval:     loopInterval,
+		numBlobsPerFetch: numBlobsPerFetch,
+		reorgDepth:       reorgDepth,
+		blobStore:        blobStore,
+		ethClient:        ethClient,
+		rpcClient:        rpcClient,
+		notifier:         notifier,
+		logger:           logger,
+		metrics:          metrics,
 	}
 }
 
@@ -62,6 +75,8 @@ func (f *finalizer) Start(ctx context.Context) {
 
 // FinalizeBlobs checks the latest finalized block and marks blobs in `confirmed` state as `finalized` if their confirmation
 // block number is less than or equal to the latest finalized block number.
+// Blobs whose confirmation transaction is missing or reverted at least reorgDepth blocks below the latest finalized block
+// are moved back to `processing` so that they are dispersed again.
 // If it failes to process some blobs, it will log the error, skip the failed blobs, and will not return an error. The function should be invoked again to retry.
 func (f *finalizer) FinalizeBlobs(ctx context.Context) error {
 	finalizedHeader, err := f.getLatestFinalizedBlock(ctx)
@@ -69,13 +84,31 @@ func (f *finalizer) FinalizeBlobs(ctx context.Context) error {
 		return fmt.Errorf("FinalizeBlobs: error getting latest finalized block: %w", err)
 	}
 
-	metadatas, err := f.blobStore.GetBlobMetadataByStatus(ctx, disperser.Confirmed)
-	if err != nil {
-		return fmt.Errorf("FinalizeBlobs: error getting blob headers: %w", err)
-	}
+	// go through the confirmed blobs one page at a time
+	var exclusiveStartKey *disperser.BlobStoreExclusiveStartKey
+	numBlobs := 0
+	for {
+		var metadatas []*disperser.BlobMetadata
+		metadatas, exclusiveStartKey, err = f.blobStore.GetBlobMetadataByStatusWithPagination(ctx, disperser.Confirmed, f.numBlobsPerFetch, exclusiveStartKey)
+		if err != nil {
+			return fmt.Errorf("FinalizeBlobs: error getting blob headers: %w", err)
+		}
 
-	f.logger.Info("FinalizeBlobs: finalizing blobs", "numBlobs", len(metadatas), "finalizedBlockNumber", finalizedHeader.Number)
+		f.logger.Info("FinalizeBlobs: finalizing blobs", "numBlobs", len(metadatas), "finalizedBlockNumber", finalizedHeader.Number)
+		f.updateBlobs(ctx, metadatas, finalizedHeader.Number.Uint64())
+		numBlobs += len(metadatas)
 
+		if exclusiveStartKey == nil {
+			break
+		}
+	}
+	f.logger.Info("FinalizeBlobs: successfully processed all finalized blobs", "numBlobs", numBlobs)
+	return nil
+}
+
+// updateBlobs marks the given confirmed blobs as finalized if their confirmation block is finalized, and requeues
+// those whose confirmation transaction was dropped
+func (f *finalizer) updateBlobs(ctx context.Context, metadatas []*disperser.BlobMetadata, lastFinalBlock uint64) {
 	for _, m := range metadatas {
 		blobKey := m.GetBlobKey()
 		confirmationMetadata, err := f.blobStore.GetBlobMetadata(ctx, blobKey)
@@ -85,19 +118,30 @@ func (f *finalizer) FinalizeBlobs(ctx context.Context) error {
 		}
 
 		// Leave as confirmed if the confirmation block is after the latest finalized block (not yet finalized)
-		if uint64(confirmationMetadata.ConfirmationInfo.ConfirmationBlockNumber) > finalizedHeader.Number.Uint64() {
+		if uint64(confirmationMetadata.ConfirmationInfo.ConfirmationBlockNumber) > lastFinalBlock {
 			continue
 		}
 
 		// confirmation block number may have changed due to reorg
-		confirmationBlockNumber, err := f.getTransactionBlockNumber(ctx, confirmationMetadata.ConfirmationInfo.ConfirmationTxnHash)
+		receipt, err := f.getTransactionReceipt(ctx, confirmationMetadata.ConfirmationInfo.ConfirmationTxnHash)
+		if errors.Is(err, ethereum.NotFound) {
+			// the confirmation transaction may have been dropped by a reorg
+			f.requeueDroppedBlob(ctx, confirmationMetadata, uint64(confirmationMetadata.ConfirmationInfo.ConfirmationBlockNumber), lastFinalBlock, "missing")
+			continue
+		}
 		if err != nil {
-			f.logger.Error("FinalizeBlobs: error getting transaction block number", "err", err)
+			f.logger.Error("FinalizeBlobs: error getting transaction receipt", "err", err)
+			continue
+		}
+		confirmationBlockNumber := receipt.BlockNumber.Uint64()
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			// the confirmation transaction may have been included in another block by a reorg and failed there
+			f.requeueDroppedBlob(ctx, confirmationMetadata, confirmationBlockNumber, lastFinalBlock, "reverted")
 			continue
 		}
 
 		// Leave as confirmed if the reorged confirmation block is after the latest finalized block (not yet finalized)
-		if uint64(confirmationBlockNumber) > finalizedHeader.Number.Uint64() {
+		if confirmationBlockNumber > lastFinalBlock {
 			continue
 		}
 
@@ -107,12 +151,33 @@ func (f *finalizer) FinalizeBlobs(ctx context.Context) error {
 			f.logger.Error("FinalizeBlobs: error marking blob as finalized", "blobKey", blobKey.String(), "err", err)
 			continue
 		}
+		f.notifier.Notify(confirmationMetadata, disperser.Finalized)
 	}
-	f.logger.Info("FinalizeBlobs: successfully processed all finalized blobs")
-	return nil
 }
 
-func (f *finalizer) getTransactionBlockNumber(ctx context.Context, hash gcommon.Hash) (uint64, error) {
+// requeueDroppedBlob moves a confirmed blob back to processing once its confirmation block is at least reorgDepth
+// blocks behind the latest finalized block, so that a receipt that is only temporarily unavailable doesn't cause the
+// blob to be dispersed twice
+func (f *finalizer) requeueDroppedBlob(ctx context.Context, metadata *disperser.BlobMetadata, confirmationBlockNumber uint64, lastFinalBlock uint64, reason string) {
+	blobKey := metadata.GetBlobKey()
+	txHash := metadata.ConfirmationInfo.ConfirmationTxnHash
+	if confirmationBlockNumber+f.reorgDepth > lastFinalBlock {
+		f.logger.Warn("FinalizeBlobs: confirmation transaction is not successful yet, leaving blob as confirmed", "blobKey", blobKey.String(), "reason", reason, "confirmationBlockNumber", confirmationBlockNumber, "finalizedBlockNumber", lastFinalBlock)
+		return
+	}
+
+	err := f.blobStore.RequeueConfirmedBlob(ctx, blobKey)
+	if err != nil {
+		f.logger.Error("FinalizeBlobs: error requeuing blob with dropped confirmation", "blobKey", blobKey.String(), "err", err)
+		return
+	}
+	f.metrics.IncrementDroppedConfirmation(reason)
+	f.logger.Warn("FinalizeBlobs: requeued blob whose confirmation transaction was dropped", "blobKey", blobKey.String(), "reason", reason, "txHash", txHash.Hex(), "confirmationBlockNumber", confirmationBlockNumber)
+}
+
+// getTransactionReceipt returns the receipt of the transaction. It returns ethereum.NotFound without retrying if
+// the transaction isn't included in the chain.
+func (f *finalizer) getTransactionReceipt(ctx context.Context, hash gcommon.Hash) (*types.Receipt, error) {
 	var ctxWithTimeout context.Context
 	var cancel context.CancelFunc
 	var txReceipt *types.Receipt
@@ -121,7 +186,7 @@ func (f *finalizer) getTransactionBlockNumber(ctx context.Context, hash gcommon.
 		ctxWithTimeout, cancel = context.WithTimeout(ctx, f.timeout)
 		defer cancel()
 		txReceipt, err = f.ethClient.TransactionReceipt(ctxWithTimeout, hash)
-		if err == nil {
+		if err == nil || errors.Is(err, ethereum.NotFound) {
 			break
 		}
 
@@ -131,10 +196,10 @@ func (f *finalizer) getTransactionBlockNumber(ctx context.Context, hash gcommon.
 	}
 
 	if err != nil {
-		return 0, fmt.Errorf("Finalizer: error getting transaction receipt after retries: %w", err)
+		return nil, fmt.Errorf("Finalizer: error getting transaction receipt after retries: %w", err)
 	}
 
-	return txReceipt.BlockNumber.Uint64(), nil
+	return txReceipt, nil
 }
 
 func (f *finalizer) getLatestFinalizedBlock(ctx context.Context) (*types.Header, error) {
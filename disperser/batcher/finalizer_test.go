@@ -11,9 +11,12 @@ import (
 	"github.com/Layr-Labs/eigenda/core"
 	"github.com/Layr-Labs/eigenda/disperser"
 	"github.com/Layr-Labs/eigenda/disperser/batcher"
+	batchermock "github.com/Layr-Labs/eigenda/disperser/batcher/mock"
 	"github.com/Layr-Labs/eigenda/disperser/common/inmem"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 
 	m "github.com/stretchr/testify/mock"
@@ -21,6 +24,8 @@ import (
 
 const timeout = 5 * time.Second
 const loopInterval = 6 * time.Minute
+const numBlobsPerFetch = 2
+const reorgDepth = 10
 
 func TestFinalizedBlob(t *testing.T) {
 	queue := inmem.NewBlobStore()
@@ -35,10 +40,11 @@ func TestFinalizedBlob(t *testing.T) {
 			args[1].(*types.Header).Number = big.NewInt(latestFinalBlock)
 		}).Return(nil).Once()
 	ethClient.On("TransactionReceipt", m.Anything, m.Anything).Return(&types.Receipt{
+		Status:      types.ReceiptStatusSuccessful,
 		BlockNumber: new(big.Int).SetUint64(1_000_000),
 	}, nil)
 
-	finalizer := batcher.NewFinalizer(timeout, loopInterval, queue, ethClient, rpcClient, logger)
+	finalizer := batcher.NewFinalizer(timeout, loopInterval, numBlobsPerFetch, reorgDepth, queue, ethClient, rpcClient, batchermock.NewNotifier(), logger, batcher.NewMetrics("9100", logger))
 
 	requestedAt := uint64(time.Now().UnixNano())
 	blob := makeTestBlob([]*core.SecurityParam{{
@@ -113,10 +119,11 @@ func TestUnfinalizedBlob(t *testing.T) {
 			args[1].(*types.Header).Number = big.NewInt(latestFinalBlock)
 		}).Return(nil).Once()
 	ethClient.On("TransactionReceipt", m.Anything, m.Anything).Return(&types.Receipt{
+		Status:      types.ReceiptStatusSuccessful,
 		BlockNumber: new(big.Int).SetUint64(1_000_100),
 	}, nil)
 
-	finalizer := batcher.NewFinalizer(timeout, loopInterval, queue, ethClient, rpcClient, logger)
+	finalizer := batcher.NewFinalizer(timeout, loopInterval, numBlobsPerFetch, reorgDepth, queue, ethClient, rpcClient, batchermock.NewNotifier(), logger, batcher.NewMetrics("9100", logger))
 
 	requestedAt := uint64(time.Now().UnixNano())
 	blob := makeTestBlob([]*core.SecurityParam{{
@@ -170,3 +177,174 @@ func TestUnfinalizedBlob(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Len(t, metadatas, 0)
 }
+
+func TestFinalizeBlobsInPages(t *testing.T) {
+	ctx := context.Background()
+	queue := inmem.NewBlobStore()
+	logger, err := logging.GetLogger(logging.DefaultCLIConfig())
+	assert.NoError(t, err)
+	ethClient := &mock.MockEthClient{}
+	rpcClient := &mock.MockRPCEthClient{}
+
+	latestFinalBlock := int64(1_000_010)
+	rpcClient.On("CallContext", m.Anything, m.Anything, "eth_getBlockByNumber", "finalized", false).
+		Run(func(args m.Arguments) {
+			args[1].(*types.Header).Number = big.NewInt(latestFinalBlock)
+		}).Return(nil).Once()
+	ethClient.On("TransactionReceipt", m.Anything, m.Anything).Return(&types.Receipt{
+		Status:      types.ReceiptStatusSuccessful,
+		BlockNumber: new(big.Int).SetUint64(1_000_000),
+	}, nil)
+
+	finalizer := batcher.NewFinalizer(timeout, loopInterval, numBlobsPerFetch, reorgDepth, queue, ethClient, rpcClient, batchermock.NewNotifier(), logger, batcher.NewMetrics("9100", logger))
+
+	// more confirmed blobs than fit in a single page
+	numBlobs := 2*numBlobsPerFetch + 1
+	requestedAt := uint64(time.Now().UnixNano())
+	for i := 0; i < numBlobs; i++ {
+		blob := makeTestBlob([]*core.SecurityParam{{
+			QuorumID:           0,
+			AdversaryThreshold: 80,
+		}})
+		metadataKey, err := queue.StoreBlob(ctx, &blob, requestedAt+uint64(i))
+		assert.NoError(t, err)
+		metadata := &disperser.BlobMetadata{
+			BlobHash:     metadataKey.BlobHash,
+			MetadataHash: metadataKey.MetadataHash,
+			BlobStatus:   disperser.Processing,
+			RequestMetadata: &disperser.RequestMetadata{
+				BlobRequestHeader: core.BlobRequestHeader{
+					SecurityParams: blob.RequestHeader.SecurityParams,
+				},
+				RequestedAt: requestedAt + uint64(i),
+			},
+		}
+		_, err = queue.MarkBlobConfirmed(ctx, metadata, &disperser.ConfirmationInfo{
+			BatchHeaderHash:         [32]byte{1, 2, 3},
+			BlobIndex:               uint32(i),
+			ConfirmationTxnHash:     common.HexToHash("0x123"),
+			ConfirmationBlockNumber: uint32(150),
+		})
+		assert.NoError(t, err)
+	}
+
+	err = finalizer.FinalizeBlobs(ctx)
+	assert.NoError(t, err)
+
+	metadatas, err := queue.GetBlobMetadataByStatus(ctx, disperser.Confirmed)
+	assert.NoError(t, err)
+	assert.Len(t, metadatas, 0)
+
+	metadatas, err = queue.GetBlobMetadataByStatus(ctx, disperser.Finalized)
+	assert.NoError(t, err)
+	assert.Len(t, metadatas, numBlobs)
+}
+
+// storeConfirmedBlob stores a blob and marks it as confirmed in the given block
+func storeConfirmedBlob(t *testing.T, queue disperser.BlobStore, confirmationBlockNumber uint32) disperser.BlobKey {
+	ctx := context.Background()
+	requestedAt := uint64(time.Now().UnixNano())
+	blob := makeTestBlob([]*core.SecurityParam{{
+		QuorumID:           0,
+		AdversaryThreshold: 80,
+	}})
+	metadataKey, err := queue.StoreBlob(ctx, &blob, requestedAt)
+	assert.NoError(t, err)
+	metadata, err := queue.GetBlobMetadata(ctx, metadataKey)
+	assert.NoError(t, err)
+	_, err = queue.MarkBlobConfirmed(ctx, metadata, &disperser.ConfirmationInfo{
+		BatchHeaderHash:         [32]byte{1, 2, 3},
+		BlobIndex:               0,
+		ConfirmationTxnHash:     common.HexToHash("0x123"),
+		ConfirmationBlockNumber: confirmationBlockNumber,
+	})
+	assert.NoError(t, err)
+	return metadataKey
+}
+
+func TestRequeueBlobWithDroppedConfirmation(t *testing.T) {
+	latestFinalBlock := int64(1_000_010)
+	// confirmations at least reorgDepth blocks behind the latest finalized block are considered dropped
+	deepBlock := uint64(latestFinalBlock - reorgDepth)
+	recentBlock := deepBlock + 1
+
+	testCases := []struct {
+		name                    string
+		confirmationBlockNumber uint64
+		receipt                 *types.Receipt
+		err                     error
+		reason                  string
+		requeued                bool
+	}{
+		{
+			name:                    "missing",
+			confirmationBlockNumber: deepBlock,
+			err:                     ethereum.NotFound,
+			reason:                  "missing",
+			requeued:                true,
+		},
+		{
+			name:                    "missing within reorg depth",
+			confirmationBlockNumber: recentBlock,
+			err:                     ethereum.NotFound,
+			reason:                  "missing",
+			requeued:                false,
+		},
+		{
+			name:                    "reverted",
+			confirmationBlockNumber: deepBlock,
+			receipt: &types.Receipt{
+				Status:      types.ReceiptStatusFailed,
+				BlockNumber: new(big.Int).SetUint64(deepBlock),
+			},
+			reason:   "reverted",
+			requeued: true,
+		},
+		{
+			name:                    "reverted within reorg depth",
+			confirmationBlockNumber: deepBlock,
+			receipt: &types.Receipt{
+				Status:      types.ReceiptStatusFailed,
+				BlockNumber: new(big.Int).SetUint64(recentBlock),
+			},
+			reason:   "reverted",
+			requeued: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			queue := inmem.NewBlobStore()
+			logger, err := logging.GetLogger(logging.DefaultCLIConfig())
+			assert.NoError(t, err)
+			ethClient := &mock.MockEthClient{}
+			rpcClient := &mock.MockRPCEthClient{}
+			metrics := batcher.NewMetrics("9100", logger)
+
+			rpcClient.On("CallContext", m.Anything, m.Anything, "eth_getBlockByNumber", "finalized", false).
+				Run(func(args m.Arguments) {
+					args[1].(*types.Header).Number = big.NewInt(latestFinalBlock)
+				}).Return(nil).Once()
+			ethClient.On("TransactionReceipt", m.Anything, m.Anything).Return(tc.receipt, tc.err)
+
+			finalizer := batcher.NewFinalizer(timeout, loopInterval, numBlobsPerFetch, reorgDepth, queue, ethClient, rpcClient, batchermock.NewNotifier(), logger, metrics)
+			metadataKey := storeConfirmedBlob(t, queue, uint32(tc.confirmationBlockNumber))
+
+			err = finalizer.FinalizeBlobs(ctx)
+			assert.NoError(t, err)
+
+			metadata, err := queue.GetBlobMetadata(ctx, metadataKey)
+			assert.NoError(t, err)
+			if tc.requeued {
+				assert.Equal(t, disperser.Processing, metadata.BlobStatus)
+				assert.Nil(t, metadata.ConfirmationInfo)
+				assert.Equal(t, float64(1), testutil.ToFloat64(metrics.DroppedConfirmation.WithLabelValues(tc.reason)))
+			} else {
+				assert.Equal(t, disperser.Confirmed, metadata.BlobStatus)
+				assert.NotNil(t, metadata.ConfirmationInfo)
+				assert.Equal(t, float64(0), testutil.ToFloat64(metrics.DroppedConfirmation.WithLabelValues(tc.reason)))
+			}
+		})
+	}
+}
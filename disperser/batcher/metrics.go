@@ -21,11 +21,12 @@ type MetricsConfig struct {
 type Metrics struct {
 	registry *prometheus.Registry
 
-	Blob             *prometheus.CounterVec
-	Batch            *prometheus.CounterVec
-	BatchProcLatency *prometheus.SummaryVec
-	GasUsed          prometheus.Gauge
-	Attestation      *prometheus.GaugeVec
+	Blob                *prometheus.CounterVec
+	Batch               *prometheus.CounterVec
+	BatchProcLatency    *prometheus.SummaryVec
+	GasUsed             prometheus.Gauge
+	Attestation         *prometheus.GaugeVec
+	DroppedConfirmation *prometheus.CounterVec
 
 	httpPort string
 	logger   common.Logger
@@ -78,6 +79,14 @@ func NewMetrics(httpPort string, logger common.Logger) *Metrics {
 			},
 			[]string{"type"},
 		),
+		DroppedConfirmation: promauto.With(reg).NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "dropped_confirmations_total",
+				Help:      "number of confirmed blobs requeued because their confirmation transaction is missing or reverted",
+			},
+			[]string{"reason"}, // reason is either missing or reverted
+		),
 		registry: reg,
 		httpPort: httpPort,
 		logger:   logger,
@@ -115,6 +124,11 @@ func (g *Metrics) IncrementBatchCount(size int) {
 	g.Batch.WithLabelValues("size").Add(float64(size))
 }
 
+// IncrementDroppedConfirmation counts a confirmed blob that was requeued for the given reason
+func (g *Metrics) IncrementDroppedConfirmation(reason string) {
+	g.DroppedConfirmation.WithLabelValues(reason).Inc()
+}
+
 func (g *Metrics) ObserveLatency(stage string, latencyMs float64) {
 	g.BatchProcLatency.WithLabelValues(stage).Observe(latencyMs)
 }
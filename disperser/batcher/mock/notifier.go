@@ -0,0 +1,26 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/Layr-Labs/eigenda/disperser"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockNotifier struct {
+	mock.Mock
+}
+
+func NewNotifier() *MockNotifier {
+	notifier := &MockNotifier{}
+	notifier.On("Notify", mock.Anything, mock.Anything).Return()
+	return notifier
+}
+
+func (n *MockNotifier) Start(ctx context.Context) error {
+	return nil
+}
+
+func (n *MockNotifier) Notify(metadata *disperser.BlobMetadata, status disperser.BlobStatus) {
+	n.Called(metadata, status)
+}
@@ -0,0 +1,355 @@
+package batcher
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/Layr-Labs/eigenda/common"
+	"github.com/Layr-Labs/eigenda/disperser"
+	gcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gammazero/workerpool"
+)
+
+// SignatureHeader is the header of a notification request that carries the hex encoded 65-byte [R || S || V] ECDSA
+// signature of the keccak256 hash of the body
+const SignatureHeader = "X-EigenDA-Signature"
+
+type NotifierConfig struct {
+	// SigningKey is the hex encoded ECDSA private key that signs the notifications. Only the disperser holds it, and
+	// the clients check the signatures against the address of the key, so they can't forge notifications for each other.
+	SigningKey string
+	// Timeout is the timeout of a single delivery
+	Timeout time.Duration
+	// MaxAttempts is the number of failed deliveries after which a notification is abandoned.
+	// Abandoned notifications are recorded in the blob store, so they aren't delivered again when the batcher restarts.
+	MaxAttempts uint
+	// RetryPolicy determines the delay before a failed delivery is attempted again
+	RetryPolicy RetryPolicy
+	// NumWorkers is the number of notifications that are delivered concurrently
+	NumWorkers int
+	// MaxBlobsToFetchFromStore is the max number of blobs fetched from the blob store at a time when looking for the
+	// notifications that were pending when the batcher stopped
+	MaxBlobsToFetchFromStore int
+	// AllowPrivateHosts lets notifications be sent to loopback, private and link-local addresses.
+	// It's only meant for tests, where the callback server runs on the same host.
+	AllowPrivateHosts bool
+}
+
+// Notifier delivers the status transitions of blobs to their callback URLs
+type Notifier interface {
+	// Start schedules the notifications that were pending when the batcher stopped
+	Start(ctx context.Context) error
+	// Notify schedules the delivery of a status of the blob if the blob has a callback URL
+	Notify(metadata *disperser.BlobMetadata, status disperser.BlobStatus)
+}
+
+type noopNotifier struct{}
+
+// NewNoopNotifier creates a Notifier that drops all the notifications, for when notifications are disabled
+func NewNoopNotifier() Notifier {
+	return noopNotifier{}
+}
+
+func (noopNotifier) Start(ctx context.Context) error {
+	return nil
+}
+
+func (noopNotifier) Notify(metadata *disperser.BlobMetadata, status disperser.BlobStatus) {}
+
+// Notification is the JSON body of the request sent to the callback URL of a blob
+type Notification struct {
+	// RequestID is the request ID returned by DisperseBlob
+	RequestID string `json:"request_id"`
+	// Status is the status the blob transitioned to
+	Status string `json:"status"`
+	// Timestamp is unix epoch time in nanoseconds at which the notification was sent
+	Timestamp uint64 `json:"timestamp"`
+	// Confirmation is set if the blob was included in a confirmed batch
+	Confirmation *NotificationConfirmation `json:"confirmation,omitempty"`
+	// FailureReason is the error of the last failed attempt if the blob failed
+	FailureReason string `json:"failure_reason,omitempty"`
+}
+
+type NotificationConfirmation struct {
+	BatchHeaderHash         string `json:"batch_header_hash"`
+	BlobIndex               uint32 `json:"blob_index"`
+	BatchID                 uint32 `json:"batch_id"`
+	ConfirmationTxnHash     string `json:"confirmation_txn_hash"`
+	ConfirmationBlockNumber uint32 `json:"confirmation_block_number"`
+}
+
+type pendingNotification struct {
+	blobKey     disperser.BlobKey
+	callbackURL string
+	status      disperser.BlobStatus
+	// attempts is the number of failed deliveries
+	attempts uint
+}
+
+type webhookNotifier struct {
+	NotifierConfig
+
+	ctx        context.Context
+	signingKey *ecdsa.PrivateKey
+	pool       *workerpool.WorkerPool
+	blobStore  disperser.BlobStore
+	httpClient *http.Client
+	logger     common.Logger
+}
+
+// NewNotifier creates a Notifier that POSTs signed notifications to the callback URLs of the blobs
+func NewNotifier(config NotifierConfig, blobStore disperser.BlobStore, logger common.Logger) (Notifier, error) {
+	if config.SigningKey == "" {
+		return nil, errors.New("notification signing key must be set")
+	}
+	signingKey, err := crypto.HexToECDSA(strings.TrimPrefix(config.SigningKey, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse notification signing key: %w", err)
+	}
+	if config.MaxAttempts == 0 {
+		return nil, errors.New("max notification attempts must be greater than 0")
+	}
+	if config.NumWorkers <= 0 {
+		return nil, errors.New("number of notification workers must be greater than 0")
+	}
+	if config.MaxBlobsToFetchFromStore <= 0 {
+		return nil, errors.New("max blobs to fetch from store must be greater than 0")
+	}
+	return &webhookNotifier{
+		NotifierConfig: config,
+		ctx:            context.Background(),
+		signingKey:     signingKey,
+		pool:           workerpool.New(config.NumWorkers),
+		blobStore:      blobStore,
+		httpClient:     newCallbackHTTPClient(config.Timeout, config.AllowPrivateHosts),
+		logger:         logger,
+	}, nil
+}
+
+// newCallbackHTTPClient creates the client that sends the notifications. The client checks the address of the callback
+// host once it's resolved, since the host name was only checked as is when the blob was dispersed, and it doesn't
+// follow redirects, so a callback server can't forward notifications to the disperser's own network.
+func newCallbackHTTPClient(timeout time.Duration, allowPrivateHosts bool) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowPrivateHosts {
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !disperser.IsCallbackIPAllowed(ip) {
+				return fmt.Errorf("%w: %s", disperser.ErrCallbackHostNotAllowed, host)
+			}
+			return nil
+		}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// A proxy would resolve the callback host itself
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+func (n *webhookNotifier) Start(ctx context.Context) error {
+	n.ctx = ctx
+	n.logger.Info("notifications are signed by", "address", crypto.PubkeyToAddress(n.signingKey.PublicKey).Hex())
+
+	numPending := 0
+	for _, status := range []disperser.BlobStatus{disperser.Confirmed, disperser.InsufficientSignatures, disperser.Failed, disperser.Finalized} {
+		var exclusiveStartKey *disperser.BlobStoreExclusiveStartKey
+		for {
+			var metadatas []*disperser.BlobMetadata
+			var err error
+			metadatas, exclusiveStartKey, err = n.blobStore.GetBlobMetadataByStatusWithPagination(ctx, status, int32(n.MaxBlobsToFetchFromStore), exclusiveStartKey)
+			if err != nil {
+				return fmt.Errorf("failed to get blobs with pending notifications: %w", err)
+			}
+			for _, metadata := range metadatas {
+				if metadata.HasPendingNotification() {
+					n.Notify(metadata, metadata.BlobStatus)
+					numPending++
+				}
+			}
+			if exclusiveStartKey == nil {
+				break
+			}
+		}
+	}
+	n.logger.Info("scheduled pending notifications", "numPending", numPending)
+	return nil
+}
+
+func (n *webhookNotifier) Notify(metadata *disperser.BlobMetadata, status disperser.BlobStatus) {
+	if metadata.RequestMetadata == nil || metadata.RequestMetadata.CallbackURL == "" {
+		return
+	}
+	notification := &pendingNotification{
+		blobKey:     metadata.GetBlobKey(),
+		callbackURL: metadata.RequestMetadata.CallbackURL,
+		status:      status,
+	}
+	n.pool.Submit(func() {
+		n.deliver(notification)
+	})
+}
+
+// deliver sends the notification and schedules a retry if it fails
+func (n *webhookNotifier) deliver(notification *pendingNotification) {
+	if n.ctx.Err() != nil {
+		return
+	}
+
+	err := n.send(notification)
+	if err == nil {
+		return
+	}
+	if errors.Is(err, disperser.ErrBlobNotFound) {
+		n.logger.Warn("dropping notification of a blob that no longer exists", "blobKey", notification.blobKey.String())
+		return
+	}
+	if errors.Is(err, disperser.ErrCallbackHostNotAllowed) {
+		n.logger.Warn("abandoning notification to a callback host that isn't allowed", "blobKey", notification.blobKey.String(), "err", err)
+		n.abandon(notification)
+		return
+	}
+
+	notification.attempts++
+	if notification.attempts >= n.MaxAttempts {
+		n.logger.Error("giving up on notification", "blobKey", notification.blobKey.String(), "status", notification.status.String(), "attempts", notification.attempts, "err", err)
+		n.abandon(notification)
+		return
+	}
+	delay := n.RetryPolicy.RetryDelay(notification.attempts - 1)
+	n.logger.Warn("failed to deliver notification, retrying", "blobKey", notification.blobKey.String(), "status", notification.status.String(), "attempts", notification.attempts, "delay", delay, "err", err)
+	time.AfterFunc(delay, func() {
+		if n.ctx.Err() != nil {
+			return
+		}
+		n.pool.Submit(func() {
+			n.deliver(notification)
+		})
+	})
+}
+
+// abandon records that the notification won't be delivered, so that it's not scheduled again when the batcher restarts
+func (n *webhookNotifier) abandon(notification *pendingNotification) {
+	if err := n.blobStore.MarkBlobNotificationAbandoned(n.ctx, notification.blobKey, notification.status); err != nil {
+		n.logger.Error("failed to mark notification abandoned", "blobKey", notification.blobKey.String(), "status", notification.status.String(), "err", err)
+	}
+}
+
+// send POSTs a single notification and records it in the blob store if it was delivered
+func (n *webhookNotifier) send(notification *pendingNotification) error {
+	// Read the latest metadata, e.g. for the failure reason of a failed blob
+	metadata, err := n.blobStore.GetBlobMetadata(n.ctx, notification.blobKey)
+	if err != nil {
+		return fmt.Errorf("failed to get blob metadata: %w", err)
+	}
+	if metadata.BlobHash == "" {
+		return disperser.ErrBlobNotFound
+	}
+
+	body, err := json.Marshal(newNotification(metadata, notification.status, time.Now()))
+	if err != nil {
+		return fmt.Errorf("failed to marshal notification: %w", err)
+	}
+	req, err := http.NewRequestWithContext(n.ctx, http.MethodPost, notification.callbackURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	signature, err := SignNotification(n.signingKey, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, signature)
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send notification: %w", err)
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("callback URL responded with status %d", resp.StatusCode)
+	}
+
+	// If the blob has moved on to another status, that status has its own pending notification
+	if metadata.BlobStatus == notification.status {
+		if err := n.blobStore.MarkBlobNotified(n.ctx, notification.blobKey, notification.status); err != nil {
+			// The notification was delivered, so it's only sent again if the batcher restarts
+			n.logger.Error("failed to mark blob notified", "blobKey", notification.blobKey.String(), "err", err)
+		}
+	}
+	n.logger.Debug("delivered notification", "blobKey", notification.blobKey.String(), "status", notification.status.String())
+	return nil
+}
+
+func newNotification(metadata *disperser.BlobMetadata, status disperser.BlobStatus, now time.Time) *Notification {
+	notification := &Notification{
+		RequestID: metadata.GetBlobKey().String(),
+		Status:    status.String(),
+		Timestamp: uint64(now.UnixNano()),
+	}
+	if info := metadata.ConfirmationInfo; info != nil && status != disperser.Failed {
+		notification.Confirmation = &NotificationConfirmation{
+			BatchHeaderHash:         hex.EncodeToString(info.BatchHeaderHash[:]),
+			BlobIndex:               info.BlobIndex,
+			BatchID:                 info.BatchID,
+			ConfirmationTxnHash:     info.ConfirmationTxnHash.Hex(),
+			ConfirmationBlockNumber: info.ConfirmationBlockNumber,
+		}
+	}
+	if failure := metadata.LastFailure(); failure != nil && status == disperser.Failed {
+		notification.FailureReason = failure.Error
+	}
+	return notification
+}
+
+// SignNotification returns the hex encoded ECDSA signature of the keccak256 hash of the notification body
+func SignNotification(key *ecdsa.PrivateKey, body []byte) (string, error) {
+	signature, err := crypto.Sign(crypto.Keccak256(body), key)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign notification: %w", err)
+	}
+	return hexutil.Encode(signature), nil
+}
+
+// VerifyNotification checks that the signature of the notification body was made by the key of the given address,
+// which is the address the disperser publishes for its notifications
+func VerifyNotification(signer gcommon.Address, body []byte, signature string) error {
+	sig, err := hexutil.Decode(signature)
+	if err != nil {
+		return fmt.Errorf("failed to decode notification signature: %w", err)
+	}
+	if len(sig) != crypto.SignatureLength {
+		return fmt.Errorf("notification signature must be %d bytes, but found %d", crypto.SignatureLength, len(sig))
+	}
+	publicKey, err := crypto.SigToPub(crypto.Keccak256(body), sig)
+	if err != nil {
+		return fmt.Errorf("failed to recover notification signer: %w", err)
+	}
+	if address := crypto.PubkeyToAddress(*publicKey); address != signer {
+		return fmt.Errorf("notification is signed by %s, not %s", address.Hex(), signer.Hex())
+	}
+	return nil
+}
@@ -0,0 +1,335 @@
+package batcher_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Layr-Labs/eigenda/common/logging"
+	"github.com/Layr-Labs/eigenda/core"
+	"github.com/Layr-Labs/eigenda/disperser"
+	"github.com/Layr-Labs/eigenda/disperser/batcher"
+	"github.com/Layr-Labs/eigenda/disperser/common/inmem"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+const testSigningKey = "0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318"
+
+var testSignerAddress = gethcommon.HexToAddress("0x2c7536E3605D9C16a7a3D7b1898e529396a65c23")
+
+// callbackServer records the notifications it receives and fails the first numFailures requests
+type callbackServer struct {
+	*httptest.Server
+
+	mu            sync.Mutex
+	numFailures   int
+	numRequests   int
+	notifications []*batcher.Notification
+}
+
+func newCallbackServer(t *testing.T, numFailures int) *callbackServer {
+	s := &callbackServer{numFailures: numFailures}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.NoError(t, batcher.VerifyNotification(testSignerAddress, body, r.Header.Get(batcher.SignatureHeader)))
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.numRequests++
+		if s.numRequests <= s.numFailures {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		notification := &batcher.Notification{}
+		assert.NoError(t, json.Unmarshal(body, notification))
+		s.notifications = append(s.notifications, notification)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *callbackServer) received() []*batcher.Notification {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*batcher.Notification{}, s.notifications...)
+}
+
+func newTestNotifier(t *testing.T, blobStore disperser.BlobStore) batcher.Notifier {
+	return newTestNotifierWithConfig(t, blobStore, batcher.NotifierConfig{
+		SigningKey:  testSigningKey,
+		Timeout:     time.Second,
+		MaxAttempts: 3,
+		RetryPolicy: batcher.RetryPolicy{BaseDelay: 10 * time.Millisecond, Multiplier: 2},
+		NumWorkers:  2,
+		// Fetch the pending notifications in several pages
+		MaxBlobsToFetchFromStore: 1,
+		// The callback servers run on the loopback address
+		AllowPrivateHosts: true,
+	})
+}
+
+func newTestNotifierWithConfig(t *testing.T, blobStore disperser.BlobStore, config batcher.NotifierConfig) batcher.Notifier {
+	logger, err := logging.GetLogger(logging.DefaultCLIConfig())
+	assert.NoError(t, err)
+	notifier, err := batcher.NewNotifier(config, blobStore, logger)
+	assert.NoError(t, err)
+	return notifier
+}
+
+func storeBlobWithCallback(t *testing.T, blobStore disperser.BlobStore, callbackURL string) *disperser.BlobMetadata {
+	ctx := context.Background()
+	blob := makeTestBlob([]*core.SecurityParam{{QuorumID: 0, AdversaryThreshold: 80, QuorumThreshold: 100}})
+	blob.RequestHeader.CallbackURL = callbackURL
+	key, err := blobStore.StoreBlob(ctx, &blob, uint64(time.Now().UnixNano()))
+	assert.NoError(t, err)
+	metadata, err := blobStore.GetBlobMetadata(ctx, key)
+	assert.NoError(t, err)
+	return metadata
+}
+
+// notifiedBlobStore signals the blobs that are marked notified
+type notifiedBlobStore struct {
+	disperser.BlobStore
+	notified chan disperser.BlobKey
+}
+
+func newNotifiedBlobStore() *notifiedBlobStore {
+	return &notifiedBlobStore{
+		BlobStore: inmem.NewBlobStore(),
+		notified:  make(chan disperser.BlobKey, 10),
+	}
+}
+
+func (s *notifiedBlobStore) MarkBlobNotified(ctx context.Context, blobKey disperser.BlobKey, status disperser.BlobStatus) error {
+	err := s.BlobStore.MarkBlobNotified(ctx, blobKey, status)
+	s.notified <- blobKey
+	return err
+}
+
+func (s *notifiedBlobStore) waitForNotified(t *testing.T, key disperser.BlobKey, status disperser.BlobStatus) {
+	select {
+	case notifiedKey := <-s.notified:
+		assert.Equal(t, key, notifiedKey)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the notification")
+	}
+	metadata, err := s.GetBlobMetadata(context.Background(), key)
+	assert.NoError(t, err)
+	assert.Equal(t, status, metadata.NotifiedStatus)
+}
+
+func (s *notifiedBlobStore) waitForAbandoned(t *testing.T, key disperser.BlobKey, status disperser.BlobStatus) {
+	assert.Eventually(t, func() bool {
+		metadata, err := s.GetBlobMetadata(context.Background(), key)
+		assert.NoError(t, err)
+		return metadata.AbandonedNotificationStatus == status && !metadata.HasPendingNotification()
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestNotifyConfirmedBlob(t *testing.T) {
+	ctx := context.Background()
+	blobStore := newNotifiedBlobStore()
+	server := newCallbackServer(t, 0)
+	notifier := newTestNotifier(t, blobStore)
+	assert.NoError(t, notifier.Start(ctx))
+
+	metadata := storeBlobWithCallback(t, blobStore, server.URL)
+	confirmationInfo := &disperser.ConfirmationInfo{
+		BatchHeaderHash:         [32]byte{1},
+		BlobIndex:               2,
+		BatchID:                 3,
+		ConfirmationTxnHash:     gethcommon.HexToHash("0x1234"),
+		ConfirmationBlockNumber: 150,
+	}
+	confirmed, err := blobStore.MarkBlobConfirmed(ctx, metadata, confirmationInfo)
+	assert.NoError(t, err)
+	assert.True(t, confirmed.HasPendingNotification())
+	notifier.Notify(confirmed, disperser.Confirmed)
+
+	blobStore.waitForNotified(t, metadata.GetBlobKey(), disperser.Confirmed)
+	notifications := server.received()
+	assert.Len(t, notifications, 1)
+	assert.Equal(t, metadata.GetBlobKey().String(), notifications[0].RequestID)
+	assert.Equal(t, disperser.Confirmed.String(), notifications[0].Status)
+	assert.Equal(t, uint32(3), notifications[0].Confirmation.BatchID)
+	assert.Equal(t, uint32(2), notifications[0].Confirmation.BlobIndex)
+	assert.Equal(t, uint32(150), notifications[0].Confirmation.ConfirmationBlockNumber)
+	assert.Empty(t, notifications[0].FailureReason)
+
+	confirmed, err = blobStore.GetBlobMetadata(ctx, metadata.GetBlobKey())
+	assert.NoError(t, err)
+	assert.False(t, confirmed.HasPendingNotification())
+
+	// Blobs without a callback URL aren't notified
+	metadata = storeBlobWithCallback(t, blobStore, "")
+	notifier.Notify(metadata, disperser.Failed)
+	assert.Never(t, func() bool {
+		return len(server.received()) > 1
+	}, 100*time.Millisecond, 10*time.Millisecond)
+}
+
+func TestNotificationRetries(t *testing.T) {
+	ctx := context.Background()
+	blobStore := newNotifiedBlobStore()
+	server := newCallbackServer(t, 2)
+	notifier := newTestNotifier(t, blobStore)
+	assert.NoError(t, notifier.Start(ctx))
+
+	metadata := storeBlobWithCallback(t, blobStore, server.URL)
+	err := blobStore.MarkBlobFailed(ctx, metadata.GetBlobKey(), &disperser.BlobFailure{Stage: disperser.EncodingStage, Error: "invalid params"})
+	assert.NoError(t, err)
+	notifier.Notify(metadata, disperser.Failed)
+
+	blobStore.waitForNotified(t, metadata.GetBlobKey(), disperser.Failed)
+	notifications := server.received()
+	assert.Len(t, notifications, 1)
+	assert.Equal(t, disperser.Failed.String(), notifications[0].Status)
+	assert.Equal(t, "invalid params", notifications[0].FailureReason)
+	assert.Nil(t, notifications[0].Confirmation)
+}
+
+func TestNotificationGivesUp(t *testing.T) {
+	ctx := context.Background()
+	blobStore := newNotifiedBlobStore()
+	server := newCallbackServer(t, 100)
+	notifier := newTestNotifier(t, blobStore)
+	assert.NoError(t, notifier.Start(ctx))
+
+	metadata := storeBlobWithCallback(t, blobStore, server.URL)
+	err := blobStore.MarkBlobFailed(ctx, metadata.GetBlobKey(), &disperser.BlobFailure{Stage: disperser.EncodingStage, Error: "invalid params"})
+	assert.NoError(t, err)
+	notifier.Notify(metadata, disperser.Failed)
+
+	assert.Eventually(t, func() bool {
+		server.mu.Lock()
+		defer server.mu.Unlock()
+		return server.numRequests == 3
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Never(t, func() bool {
+		server.mu.Lock()
+		defer server.mu.Unlock()
+		return server.numRequests > 3
+	}, 200*time.Millisecond, 10*time.Millisecond)
+
+	// The notification is abandoned, so it's not delivered again when the batcher restarts
+	blobStore.waitForAbandoned(t, metadata.GetBlobKey(), disperser.Failed)
+	assert.NoError(t, newTestNotifier(t, blobStore).Start(ctx))
+	assert.Never(t, func() bool {
+		server.mu.Lock()
+		defer server.mu.Unlock()
+		return server.numRequests > 3
+	}, 200*time.Millisecond, 10*time.Millisecond)
+}
+
+func TestNotifierRefusesPrivateHosts(t *testing.T) {
+	ctx := context.Background()
+	blobStore := newNotifiedBlobStore()
+	server := newCallbackServer(t, 0)
+	notifier := newTestNotifierWithConfig(t, blobStore, batcher.NotifierConfig{
+		SigningKey:               testSigningKey,
+		Timeout:                  time.Second,
+		MaxAttempts:              3,
+		RetryPolicy:              batcher.RetryPolicy{BaseDelay: 10 * time.Millisecond, Multiplier: 2},
+		NumWorkers:               2,
+		MaxBlobsToFetchFromStore: 1,
+	})
+	assert.NoError(t, notifier.Start(ctx))
+
+	// The host name is only resolved to the loopback address when the notification is sent
+	callbackURL := strings.Replace(server.URL, "127.0.0.1", "localhost", 1)
+	metadata := storeBlobWithCallback(t, blobStore, callbackURL)
+	err := blobStore.MarkBlobFailed(ctx, metadata.GetBlobKey(), &disperser.BlobFailure{Stage: disperser.EncodingStage, Error: "invalid params"})
+	assert.NoError(t, err)
+	notifier.Notify(metadata, disperser.Failed)
+
+	// The notification is abandoned right away, since the host won't be allowed on a retry either
+	blobStore.waitForAbandoned(t, metadata.GetBlobKey(), disperser.Failed)
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	assert.Equal(t, 0, server.numRequests)
+}
+
+func TestNotificationDoesNotFollowRedirects(t *testing.T) {
+	ctx := context.Background()
+	blobStore := newNotifiedBlobStore()
+	server := newCallbackServer(t, 0)
+	numRedirects := 0
+	var mu sync.Mutex
+	redirector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		numRedirects++
+		mu.Unlock()
+		http.Redirect(w, r, server.URL, http.StatusTemporaryRedirect)
+	}))
+	t.Cleanup(redirector.Close)
+	notifier := newTestNotifier(t, blobStore)
+	assert.NoError(t, notifier.Start(ctx))
+
+	metadata := storeBlobWithCallback(t, blobStore, redirector.URL)
+	err := blobStore.MarkBlobFailed(ctx, metadata.GetBlobKey(), &disperser.BlobFailure{Stage: disperser.EncodingStage, Error: "invalid params"})
+	assert.NoError(t, err)
+	notifier.Notify(metadata, disperser.Failed)
+
+	// Redirects are failed deliveries
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return numRedirects == 3
+	}, 5*time.Second, 10*time.Millisecond)
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	assert.Equal(t, 0, server.numRequests)
+}
+
+func TestNotifierRecoversPendingNotifications(t *testing.T) {
+	ctx := context.Background()
+	blobStore := newNotifiedBlobStore()
+	server := newCallbackServer(t, 0)
+
+	pending := storeBlobWithCallback(t, blobStore, server.URL)
+	err := blobStore.MarkBlobFailed(ctx, pending.GetBlobKey(), &disperser.BlobFailure{Stage: disperser.ConfirmationStage, Error: "timeout"})
+	assert.NoError(t, err)
+	delivered := storeBlobWithCallback(t, blobStore, server.URL)
+	err = blobStore.MarkBlobFailed(ctx, delivered.GetBlobKey(), &disperser.BlobFailure{Stage: disperser.ConfirmationStage, Error: "timeout"})
+	assert.NoError(t, err)
+	err = blobStore.MarkBlobNotified(ctx, delivered.GetBlobKey(), disperser.Failed)
+	assert.NoError(t, err)
+	<-blobStore.notified
+	// Processing blobs have nothing to notify
+	_ = storeBlobWithCallback(t, blobStore, server.URL)
+
+	notifier := newTestNotifier(t, blobStore)
+	assert.NoError(t, notifier.Start(ctx))
+
+	blobStore.waitForNotified(t, pending.GetBlobKey(), disperser.Failed)
+	notifications := server.received()
+	assert.Len(t, notifications, 1)
+	assert.Equal(t, pending.GetBlobKey().String(), notifications[0].RequestID)
+}
+
+func TestVerifyNotification(t *testing.T) {
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(testSigningKey, "0x"))
+	assert.NoError(t, err)
+	body := []byte(`{"request_id":"abc","status":"Confirmed"}`)
+	signature, err := batcher.SignNotification(key, body)
+	assert.NoError(t, err)
+	assert.NoError(t, batcher.VerifyNotification(testSignerAddress, body, signature))
+
+	// A notification can't be altered, nor signed by anyone other than the disperser
+	assert.Error(t, batcher.VerifyNotification(testSignerAddress, []byte(`{"request_id":"abc","status":"Failed"}`), signature))
+	otherKey, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+	forged, err := batcher.SignNotification(otherKey, body)
+	assert.NoError(t, err)
+	assert.ErrorContains(t, batcher.VerifyNotification(testSignerAddress, body, forged), "notification is signed by")
+	assert.Error(t, batcher.VerifyNotification(testSignerAddress, body, "0x1234"))
+}
@@ -0,0 +1,229 @@
+package batcher
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/Layr-Labs/eigenda/common"
+	"github.com/Layr-Labs/eigenda/disperser"
+	"github.com/ethereum/go-ethereum"
+	gcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/hashicorp/go-multierror"
+)
+
+// blockStaleMeasure is the number of blocks after its reference block within which a batch must be confirmed
+// ref: BLOCK_STALE_MEASURE in contracts/src/core/EigenDAServiceManagerStorage.sol
+const blockStaleMeasure = 150
+
+// RecoverConfirmedBatches marks the blobs of the batches that were confirmed on chain as confirmed, if they're still
+// processing because the batcher stopped between confirming the batch and updating the blobs.
+// The batches are the pending batches recorded in the blob store, and their BatchConfirmed events are looked up in
+// the blocks in which they could have been confirmed, with a single query for the batches whose blocks overlap. The
+// blobs of batches without a BatchConfirmed event are left to be dispersed again.
+func (b *Batcher) RecoverConfirmedBatches(ctx context.Context) error {
+	pendingBatches, err := b.Queue.GetPendingBatches(ctx)
+	if err != nil {
+		return fmt.Errorf("RecoverConfirmedBatches: error getting pending batches: %w", err)
+	}
+	if len(pendingBatches) == 0 {
+		return nil
+	}
+
+	currentBlockNumber, err := b.getCurrentBlockNumber(ctx)
+	if err != nil {
+		return fmt.Errorf("RecoverConfirmedBatches: error getting current block number: %w", err)
+	}
+
+	numRecovered := 0
+	for _, blockRange := range getPendingBatchRanges(pendingBatches, currentBlockNumber) {
+		confirmedLogs, err := b.findBatchConfirmedLogs(ctx, blockRange)
+		if err != nil {
+			b.logger.Error("RecoverConfirmedBatches: error looking up BatchConfirmed events", "fromBlock", blockRange.fromBlock, "toBlock", blockRange.toBlock, "numBatches", len(blockRange.batches), "err", err)
+			continue
+		}
+
+		for _, pendingBatch := range blockRange.batches {
+			batchHeaderHash := gcommon.Hash(pendingBatch.BatchHeaderHash)
+			confirmedLog, ok := confirmedLogs[batchHeaderHash]
+			if !ok {
+				b.logger.Info("RecoverConfirmedBatches: batch wasn't confirmed, its blobs will be dispersed again", "batchHeaderHash", batchHeaderHash.Hex(), "numBlobs", len(pendingBatch.Blobs))
+				// The record is kept while the batch can still be confirmed
+				if uint64(pendingBatch.ReferenceBlockNumber)+blockStaleMeasure <= uint64(currentBlockNumber) {
+					b.removePendingBatch(ctx, pendingBatch)
+				}
+				continue
+			}
+
+			n, err := b.recoverConfirmedBatch(ctx, pendingBatch, confirmedLog)
+			numRecovered += n
+			if err != nil {
+				b.logger.Error("RecoverConfirmedBatches: error recovering confirmed batch", "batchHeaderHash", batchHeaderHash.Hex(), "err", err)
+				continue
+			}
+			b.removePendingBatch(ctx, pendingBatch)
+		}
+	}
+
+	if numRecovered > 0 {
+		b.logger.Info("RecoverConfirmedBatches: recovered blobs of confirmed batches", "numBlobs", numRecovered)
+	}
+	return nil
+}
+
+// pendingBatchRange is a range of blocks in which a set of pending batches could have been confirmed
+type pendingBatchRange struct {
+	fromBlock uint64
+	toBlock   uint64
+	batches   []*disperser.PendingBatch
+}
+
+// getPendingBatchRanges groups the pending batches whose confirmation blocks overlap, so that their BatchConfirmed
+// events can be looked up together. A batch can only be confirmed within blockStaleMeasure blocks after its reference
+// block.
+func getPendingBatchRanges(pendingBatches []*disperser.PendingBatch, currentBlockNumber uint32) []*pendingBatchRange {
+	sorted := make([]*disperser.PendingBatch, len(pendingBatches))
+	copy(sorted, pendingBatches)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].ReferenceBlockNumber < sorted[j].ReferenceBlockNumber
+	})
+
+	var ranges []*pendingBatchRange
+	for _, pendingBatch := range sorted {
+		fromBlock := uint64(pendingBatch.ReferenceBlockNumber)
+		toBlock := fromBlock + blockStaleMeasure
+		if toBlock > uint64(currentBlockNumber) {
+			toBlock = uint64(currentBlockNumber)
+		}
+
+		if len(ranges) > 0 && fromBlock <= ranges[len(ranges)-1].toBlock {
+			last := ranges[len(ranges)-1]
+			if toBlock > last.toBlock {
+				last.toBlock = toBlock
+			}
+			last.batches = append(last.batches, pendingBatch)
+			continue
+		}
+		ranges = append(ranges, &pendingBatchRange{
+			fromBlock: fromBlock,
+			toBlock:   toBlock,
+			batches:   []*disperser.PendingBatch{pendingBatch},
+		})
+	}
+	return ranges
+}
+
+func (b *Batcher) removePendingBatch(ctx context.Context, pendingBatch *disperser.PendingBatch) {
+	if err := b.Queue.RemovePendingBatch(ctx, pendingBatch.BatchHeaderHash); err != nil {
+		b.logger.Error("RecoverConfirmedBatches: error removing pending batch", "batchHeaderHash", gcommon.Hash(pendingBatch.BatchHeaderHash).Hex(), "err", err)
+	}
+}
+
+func (b *Batcher) getCurrentBlockNumber(ctx context.Context) (uint32, error) {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, b.ChainReadTimeout)
+	defer cancel()
+	return b.ethClient.GetCurrentBlockNumber(ctxWithTimeout)
+}
+
+// findBatchConfirmedLogs returns the BatchConfirmed logs of the batches of the block range, by batch header hash.
+// The batches without a log weren't confirmed in the range.
+func (b *Batcher) findBatchConfirmedLogs(ctx context.Context, blockRange *pendingBatchRange) (map[gcommon.Hash]*types.Log, error) {
+	batchHeaderHashes := make([]gcommon.Hash, len(blockRange.batches))
+	for i, pendingBatch := range blockRange.batches {
+		batchHeaderHashes[i] = pendingBatch.BatchHeaderHash
+	}
+
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, b.ChainReadTimeout)
+	defer cancel()
+	logs, err := b.ethClient.FilterLogs(ctxWithTimeout, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(blockRange.fromBlock),
+		ToBlock:   new(big.Int).SetUint64(blockRange.toBlock),
+		Addresses: []gcommon.Address{b.EigenDAServiceManagerAddr},
+		Topics: [][]gcommon.Hash{
+			{common.BatchConfirmedEventSigHash},
+			batchHeaderHashes,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	confirmedLogs := make(map[gcommon.Hash]*types.Log)
+	for i := range logs {
+		if logs[i].Removed || len(logs[i].Topics) < 2 {
+			continue
+		}
+		batchHeaderHash := logs[i].Topics[1]
+		if _, ok := confirmedLogs[batchHeaderHash]; !ok {
+			confirmedLogs[batchHeaderHash] = &logs[i]
+		}
+	}
+	return confirmedLogs, nil
+}
+
+// recoverConfirmedBatch marks the blobs of the batch that are still processing with the status they would have had if
+// the batcher hadn't stopped, and returns the number of blobs it marked
+func (b *Batcher) recoverConfirmedBatch(ctx context.Context, pendingBatch *disperser.PendingBatch, confirmedLog *types.Log) (int, error) {
+	batchID, err := parseBatchIDFromLog(confirmedLog)
+	if err != nil {
+		return 0, fmt.Errorf("error parsing batch ID: %w", err)
+	}
+
+	blobKeys := make([]disperser.BlobKey, len(pendingBatch.Blobs))
+	for i, pending := range pendingBatch.Blobs {
+		blobKeys[i] = pending.BlobKey
+	}
+	metadatas, err := b.Queue.GetBulkBlobMetadata(ctx, blobKeys)
+	if err != nil {
+		return 0, fmt.Errorf("error getting blob metadata: %w", err)
+	}
+	metadataByKey := make(map[disperser.BlobKey]*disperser.BlobMetadata, len(metadatas))
+	for _, metadata := range metadatas {
+		metadataByKey[metadata.GetBlobKey()] = metadata
+	}
+
+	numRecovered := 0
+	var result *multierror.Error
+	for _, pending := range pendingBatch.Blobs {
+		metadata, ok := metadataByKey[pending.BlobKey]
+		// The blob may have been updated since, e.g. cancelled or dispersed again
+		if !ok || metadata.BlobStatus != disperser.Processing {
+			continue
+		}
+		if err := b.recoverConfirmedBlob(ctx, metadata, pending, batchID, confirmedLog); err != nil {
+			result = multierror.Append(result, fmt.Errorf("error marking blob %s as confirmed: %w", pending.BlobKey.String(), err))
+			continue
+		}
+		numRecovered++
+	}
+	return numRecovered, result.ErrorOrNil()
+}
+
+// recoverConfirmedBlob completes the pending confirmation of the blob with the BatchConfirmed log of its batch and
+// marks the blob with the status it would have had if the batcher hadn't stopped
+func (b *Batcher) recoverConfirmedBlob(ctx context.Context, metadata *disperser.BlobMetadata, pending *disperser.PendingConfirmation, batchID uint32, confirmedLog *types.Log) error {
+	confirmationInfo := *pending.ConfirmationInfo
+	confirmationInfo.BatchID = batchID
+	confirmationInfo.ConfirmationTxnHash = confirmedLog.TxHash
+	confirmationInfo.ConfirmationBlockNumber = uint32(confirmedLog.BlockNumber)
+
+	var updatedMetadata *disperser.BlobMetadata
+	var err error
+	switch pending.Status {
+	case disperser.Confirmed:
+		updatedMetadata, err = b.Queue.MarkBlobConfirmed(ctx, metadata, &confirmationInfo)
+	case disperser.InsufficientSignatures:
+		updatedMetadata, err = b.Queue.MarkBlobInsufficientSignatures(ctx, metadata, &confirmationInfo)
+	default:
+		err = fmt.Errorf("pending confirmation has status other than confirmed or insufficient signatures: %s", pending.Status.String())
+	}
+	if err != nil {
+		return err
+	}
+
+	b.notifier.Notify(updatedMetadata, pending.Status)
+	b.Metrics.UpdateCompletedBlob(int(metadata.RequestMetadata.BlobSize), pending.Status)
+	return nil
+}
@@ -0,0 +1,62 @@
+package disperser
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// ErrCallbackHostNotAllowed is returned for callback URLs whose host is internal to the disperser's network
+var ErrCallbackHostNotAllowed = errors.New("callback host is not allowed")
+
+// blockedCallbackNetworks are the special purpose networks that aren't covered by the checks of net.IP
+var blockedCallbackNetworks = mustParseCIDRs(
+	"0.0.0.0/8",     // this network
+	"100.64.0.0/10", // carrier-grade NAT
+	"192.0.0.0/24",  // IETF protocol assignments
+	"198.18.0.0/15", // benchmarking
+	"240.0.0.0/4",   // reserved, including the broadcast address
+	"64:ff9b::/96",  // NAT64, which reaches IPv4 addresses
+)
+
+// IsCallbackIPAllowed returns whether notifications can be sent to the IP address. Loopback, private, link-local
+// (e.g. the cloud metadata endpoint 169.254.169.254) and other non-public addresses aren't allowed, so that a
+// callback URL can't make the disperser send requests to its own network.
+func IsCallbackIPAllowed(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsMulticast() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() {
+		return false
+	}
+	for _, network := range blockedCallbackNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateCallbackHost checks the host of a callback URL. IP addresses are checked with IsCallbackIPAllowed, while
+// names other than localhost can only be checked once they're resolved, when the notification is sent.
+func ValidateCallbackHost(host string) error {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("%w: %s", ErrCallbackHostNotAllowed, host)
+	}
+	if ip := net.ParseIP(host); ip != nil && !IsCallbackIPAllowed(ip) {
+		return fmt.Errorf("%w: %s", ErrCallbackHostNotAllowed, host)
+	}
+	return nil
+}
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, len(cidrs))
+	for i, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks[i] = network
+	}
+	return networks
+}
@@ -1,21 +1,27 @@
 package main
 
 import (
+	"errors"
+
 	"github.com/Layr-Labs/eigenda/common/aws"
 	"github.com/Layr-Labs/eigenda/common/geth"
 	"github.com/Layr-Labs/eigenda/common/logging"
 	"github.com/Layr-Labs/eigenda/common/ratelimit"
 	"github.com/Layr-Labs/eigenda/disperser"
+	"github.com/Layr-Labs/eigenda/disperser/admin"
 	"github.com/Layr-Labs/eigenda/disperser/apiserver"
 	"github.com/Layr-Labs/eigenda/disperser/cmd/apiserver/flags"
 	"github.com/Layr-Labs/eigenda/disperser/common/blobstore"
+	"github.com/Layr-Labs/eigenda/disperser/common/localstore"
 	"github.com/urfave/cli"
 )
 
 type Config struct {
 	AwsClientConfig   aws.ClientConfig
 	BlobstoreConfig   blobstore.Config
+	LocalStoreConfig  localstore.Config
 	ServerConfig      disperser.ServerConfig
+	AdminConfig       admin.Config
 	LoggerConfig      logging.Config
 	MetricsConfig     disperser.MetricsConfig
 	RatelimiterConfig ratelimit.Config
@@ -36,16 +42,41 @@ func NewConfig(ctx *cli.Context) (Config, error) {
 		return Config{}, err
 	}
 
+	adminConfig, err := admin.ReadCLIConfig(ctx, flags.FlagPrefix)
+	if err != nil {
+		return Config{}, err
+	}
+
+	var blockedCallbackPorts []string
+	if adminConfig.Enabled() {
+		blockedCallbackPorts = append(blockedCallbackPorts, adminConfig.GrpcPort)
+	}
+
+	localStoreConfig := localstore.ReadCLIConfig(ctx, flags.FlagPrefix)
+	blobstoreConfig := blobstore.Config{
+		BucketName: ctx.GlobalString(flags.S3BucketNameFlag.Name),
+		TableName:  ctx.GlobalString(flags.DynamoDBTableNameFlag.Name),
+	}
+	if !localStoreConfig.Enabled() && (blobstoreConfig.BucketName == "" || blobstoreConfig.TableName == "") {
+		return Config{}, errors.New("s3 bucket name and dynamodb table name must be set unless the local blob store is used")
+	}
+
 	config := Config{
 		AwsClientConfig: aws.ReadClientConfig(ctx, flags.FlagPrefix),
 		ServerConfig: disperser.ServerConfig{
-			GrpcPort: ctx.GlobalString(flags.GrpcPortFlag.Name),
+			GrpcPort:                ctx.GlobalString(flags.GrpcPortFlag.Name),
+			StatusPollInterval:      ctx.GlobalDuration(flags.StatusPollIntervalFlag.Name),
+			MaxStatusStreams:        ctx.GlobalInt(flags.MaxStatusStreamsFlag.Name),
+			MaxStatusStreamsPerIP:   ctx.GlobalInt(flags.MaxStatusStreamsPerIPFlag.Name),
+			MaxStatusStreamDuration: ctx.GlobalDuration(flags.MaxStatusStreamDurationFlag.Name),
+			MaxBlobSize:             ctx.GlobalInt(flags.MaxBlobSizeFlag.Name),
+			DuplicateWindow:         ctx.GlobalDuration(flags.DuplicateWindowFlag.Name),
+			BlockedCallbackPorts:    blockedCallbackPorts,
 		},
-		BlobstoreConfig: blobstore.Config{
-			BucketName: ctx.GlobalString(flags.S3BucketNameFlag.Name),
-			TableName:  ctx.GlobalString(flags.DynamoDBTableNameFlag.Name),
-		},
-		LoggerConfig: logging.ReadCLIConfig(ctx, flags.FlagPrefix),
+		AdminConfig:      adminConfig,
+		BlobstoreConfig:  blobstoreConfig,
+		LocalStoreConfig: localStoreConfig,
+		LoggerConfig:     logging.ReadCLIConfig(ctx, flags.FlagPrefix),
 		MetricsConfig: disperser.MetricsConfig{
 			HTTPPort:      ctx.GlobalString(flags.MetricsHTTPPort.Name),
 			EnableMetrics: ctx.GlobalBool(flags.EnableMetrics.Name),
@@ -60,5 +91,12 @@ func NewConfig(ctx *cli.Context) (Config, error) {
 		BLSOperatorStateRetrieverAddr: ctx.GlobalString(flags.BlsOperatorStateRetrieverFlag.Name),
 		EigenDAServiceManagerAddr:     ctx.GlobalString(flags.EigenDAServiceManagerFlag.Name),
 	}
+
+	// Blobs larger than what the SRS can encode would be accepted and then fail to encode
+	err = disperser.ValidateMaxBlobSize(config.ServerConfig.MaxBlobSize, ctx.GlobalInt(flags.SRSOrderFlag.Name))
+	if err != nil {
+		return Config{}, err
+	}
+
 	return config, nil
 }
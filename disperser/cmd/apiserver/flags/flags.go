@@ -1,12 +1,17 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/Layr-Labs/eigenda/common"
 	"github.com/Layr-Labs/eigenda/common/aws"
 	"github.com/Layr-Labs/eigenda/common/geth"
 	"github.com/Layr-Labs/eigenda/common/logging"
 	"github.com/Layr-Labs/eigenda/common/ratelimit"
+	"github.com/Layr-Labs/eigenda/disperser"
+	"github.com/Layr-Labs/eigenda/disperser/admin"
 	"github.com/Layr-Labs/eigenda/disperser/apiserver"
+	"github.com/Layr-Labs/eigenda/disperser/common/localstore"
 	"github.com/urfave/cli"
 )
 
@@ -19,14 +24,14 @@ var (
 	/* Required Flags */
 	S3BucketNameFlag = cli.StringFlag{
 		Name:     common.PrefixFlag(FlagPrefix, "s3-bucket-name"),
-		Usage:    "Name of the bucket to store blobs",
-		Required: true,
+		Usage:    "Name of the bucket to store blobs. Required unless the local blob store is used",
+		Required: false,
 		EnvVar:   common.PrefixEnvVar(envVarPrefix, "S3_BUCKET_NAME"),
 	}
 	DynamoDBTableNameFlag = cli.StringFlag{
 		Name:     common.PrefixFlag(FlagPrefix, "dynamodb-table-name"),
-		Usage:    "Name of the dynamodb table to store blob metadata",
-		Required: true,
+		Usage:    "Name of the dynamodb table to store blob metadata. Required unless the local blob store is used",
+		Required: false,
 		EnvVar:   common.PrefixEnvVar(envVarPrefix, "DYNAMODB_TABLE_NAME"),
 	}
 	GrpcPortFlag = cli.StringFlag{
@@ -79,22 +84,77 @@ var (
 		EnvVar:   common.PrefixEnvVar(envVarPrefix, "RATE_BUCKET_STORE_SIZE"),
 		Required: false,
 	}
+	StatusPollIntervalFlag = cli.DurationFlag{
+		Name:     common.PrefixFlag(FlagPrefix, "status-poll-interval"),
+		Usage:    "Interval at which the status of the blobs with SubscribeBlobStatus streams is checked",
+		Value:    1 * time.Second,
+		EnvVar:   common.PrefixEnvVar(envVarPrefix, "STATUS_POLL_INTERVAL"),
+		Required: false,
+	}
+	MaxStatusStreamsFlag = cli.IntFlag{
+		Name:     common.PrefixFlag(FlagPrefix, "max-status-streams"),
+		Usage:    "max number of SubscribeBlobStatus streams open at a time",
+		Value:    disperser.DefaultMaxStatusStreams,
+		EnvVar:   common.PrefixEnvVar(envVarPrefix, "MAX_STATUS_STREAMS"),
+		Required: false,
+	}
+	MaxStatusStreamsPerIPFlag = cli.IntFlag{
+		Name:     common.PrefixFlag(FlagPrefix, "max-status-streams-per-ip"),
+		Usage:    "max number of SubscribeBlobStatus streams open at a time from the same client address",
+		Value:    disperser.DefaultMaxStatusStreamsPerIP,
+		EnvVar:   common.PrefixEnvVar(envVarPrefix, "MAX_STATUS_STREAMS_PER_IP"),
+		Required: false,
+	}
+	MaxStatusStreamDurationFlag = cli.DurationFlag{
+		Name:     common.PrefixFlag(FlagPrefix, "max-status-stream-duration"),
+		Usage:    "max duration of a SubscribeBlobStatus stream, after which the client has to subscribe again",
+		Value:    disperser.DefaultMaxStatusStreamDuration,
+		EnvVar:   common.PrefixEnvVar(envVarPrefix, "MAX_STATUS_STREAM_DURATION"),
+		Required: false,
+	}
+	MaxBlobSizeFlag = cli.IntFlag{
+		Name:     common.PrefixFlag(FlagPrefix, "max-blob-size"),
+		Usage:    "max size of a blob in bytes",
+		Value:    disperser.DefaultMaxBlobSize,
+		EnvVar:   common.PrefixEnvVar(envVarPrefix, "MAX_BLOB_SIZE"),
+		Required: false,
+	}
+	SRSOrderFlag = cli.IntFlag{
+		Name:     common.PrefixFlag(FlagPrefix, "srs-order"),
+		Usage:    "Order of the SRS used by the encoder. The max blob size is validated against it on startup",
+		EnvVar:   common.PrefixEnvVar(envVarPrefix, "SRS_ORDER"),
+		Required: true,
+	}
+	DuplicateWindowFlag = cli.DurationFlag{
+		Name:     common.PrefixFlag(FlagPrefix, "duplicate-window"),
+		Usage:    "Window in which a retried blob (same account, data and security params) returns the existing request ID instead of being dispersed again. 0 disables duplicate detection",
+		Value:    0,
+		EnvVar:   common.PrefixEnvVar(envVarPrefix, "DUPLICATE_WINDOW"),
+		Required: false,
+	}
 )
 
 var requiredFlags = []cli.Flag{
-	S3BucketNameFlag,
-	DynamoDBTableNameFlag,
 	GrpcPortFlag,
 	BucketTableName,
 	BlsOperatorStateRetrieverFlag,
 	EigenDAServiceManagerFlag,
+	SRSOrderFlag,
 }
 
 var optionalFlags = []cli.Flag{
+	S3BucketNameFlag,
+	DynamoDBTableNameFlag,
 	MetricsHTTPPort,
 	EnableMetrics,
 	EnableRatelimiter,
 	BucketStoreSize,
+	StatusPollIntervalFlag,
+	MaxStatusStreamsFlag,
+	MaxStatusStreamsPerIPFlag,
+	MaxStatusStreamDurationFlag,
+	MaxBlobSizeFlag,
+	DuplicateWindowFlag,
 }
 
 // Flags contains the list of configuration options available to the binary.
@@ -107,4 +167,6 @@ func init() {
 	Flags = append(Flags, ratelimit.RatelimiterCLIFlags(envVarPrefix, FlagPrefix)...)
 	Flags = append(Flags, aws.ClientFlags(envVarPrefix, FlagPrefix)...)
 	Flags = append(Flags, apiserver.CLIFlags(envVarPrefix)...)
+	Flags = append(Flags, admin.CLIFlags(envVarPrefix, FlagPrefix)...)
+	Flags = append(Flags, localstore.CLIFlags(envVarPrefix, FlagPrefix)...)
 }
@@ -8,8 +8,10 @@ import (
 	"time"
 
 	"github.com/Layr-Labs/eigenda/common"
+	"github.com/Layr-Labs/eigenda/disperser/admin"
 	"github.com/Layr-Labs/eigenda/disperser/apiserver"
 	"github.com/Layr-Labs/eigenda/disperser/common/blobstore"
+	"github.com/Layr-Labs/eigenda/disperser/common/localstore"
 
 	"github.com/Layr-Labs/eigenda/common/aws/dynamodb"
 	"github.com/Layr-Labs/eigenda/common/aws/s3"
@@ -17,6 +19,7 @@ import (
 	"github.com/Layr-Labs/eigenda/common/logging"
 	"github.com/Layr-Labs/eigenda/common/ratelimit"
 	"github.com/Layr-Labs/eigenda/common/store"
+	"github.com/Layr-Labs/eigenda/core/auth"
 	"github.com/Layr-Labs/eigenda/core/eth"
 	"github.com/Layr-Labs/eigenda/disperser"
 	"github.com/Layr-Labs/eigenda/disperser/cmd/apiserver/flags"
@@ -77,20 +80,28 @@ func RunDisperserServer(ctx *cli.Context) error {
 		return fmt.Errorf("failed to get STORE_DURATION_BLOCKS: %w", err)
 	}
 
-	s3Client, err := s3.NewClient(context.Background(), config.AwsClientConfig, logger)
-	if err != nil {
-		return err
-	}
+	var blobStore disperser.BlobStore
+	if config.LocalStoreConfig.Enabled() {
+		blobStore, err = localstore.NewBlobStore(config.LocalStoreConfig.Dir, logger)
+		if err != nil {
+			return err
+		}
+	} else {
+		s3Client, err := s3.NewClient(context.Background(), config.AwsClientConfig, logger)
+		if err != nil {
+			return err
+		}
 
-	dynamoClient, err := dynamodb.NewClient(config.AwsClientConfig, logger)
-	if err != nil {
-		return err
-	}
+		dynamoClient, err := dynamodb.NewClient(config.AwsClientConfig, logger)
+		if err != nil {
+			return err
+		}
 
-	bucketName := config.BlobstoreConfig.BucketName
-	logger.Info("Creating blob store", "bucket", bucketName)
-	blobMetadataStore := blobstore.NewBlobMetadataStore(dynamoClient, logger, config.BlobstoreConfig.TableName, time.Duration((storeDurationBlocks+blockStaleMeasure)*12)*time.Second)
-	blobStore := blobstore.NewSharedStorage(bucketName, s3Client, blobMetadataStore, logger)
+		bucketName := config.BlobstoreConfig.BucketName
+		logger.Info("Creating blob store", "bucket", bucketName)
+		blobMetadataStore := blobstore.NewBlobMetadataStore(dynamoClient, logger, config.BlobstoreConfig.TableName, time.Duration((storeDurationBlocks+blockStaleMeasure)*12)*time.Second)
+		blobStore = blobstore.NewSharedStorage(bucketName, s3Client, blobMetadataStore, logger)
+	}
 
 	var ratelimiter common.RateLimiter
 	if config.EnableRatelimiter {
@@ -114,7 +125,7 @@ func RunDisperserServer(ctx *cli.Context) error {
 
 	// TODO: create a separate metrics for batcher
 	metrics := disperser.NewMetrics(config.MetricsConfig.HTTPPort, logger)
-	server := apiserver.NewDispersalServer(config.ServerConfig, blobStore, transactor, logger, metrics, ratelimiter, config.RateConfig)
+	server := apiserver.NewDispersalServer(config.ServerConfig, blobStore, transactor, logger, metrics, ratelimiter, config.RateConfig, auth.NewAuthenticator())
 
 	// Enable Metrics Block
 	if config.MetricsConfig.EnableMetrics {
@@ -123,5 +134,14 @@ func RunDisperserServer(ctx *cli.Context) error {
 		logger.Info("Enabled metrics for Disperser", "socket", httpSocket)
 	}
 
+	if config.AdminConfig.Enabled() {
+		adminServer := admin.NewServer(config.AdminConfig, blobStore, server, nil, logger)
+		go func() {
+			if err := adminServer.Start(context.Background()); err != nil {
+				logger.Error("admin server failed", "err", err)
+			}
+		}()
+	}
+
 	return server.Start(context.Background())
 }
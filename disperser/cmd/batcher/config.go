@@ -1,29 +1,37 @@
 package main
 
 import (
+	"errors"
+
 	"github.com/Layr-Labs/eigenda/common/aws"
 	"github.com/Layr-Labs/eigenda/common/geth"
 	"github.com/Layr-Labs/eigenda/common/logging"
 	"github.com/Layr-Labs/eigenda/core/encoding"
+	"github.com/Layr-Labs/eigenda/disperser/admin"
 	"github.com/Layr-Labs/eigenda/disperser/batcher"
 	"github.com/Layr-Labs/eigenda/disperser/cmd/batcher/flags"
 	"github.com/Layr-Labs/eigenda/disperser/common/blobstore"
+	"github.com/Layr-Labs/eigenda/disperser/common/localstore"
 	"github.com/Layr-Labs/eigenda/indexer"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/urfave/cli"
 )
 
 type Config struct {
-	BatcherConfig   batcher.Config
-	TimeoutConfig   batcher.TimeoutConfig
-	BlobstoreConfig blobstore.Config
-	EthClientConfig geth.EthClientConfig
-	AwsClientConfig aws.ClientConfig
-	EncoderConfig   encoding.EncoderConfig
-	LoggerConfig    logging.Config
-	MetricsConfig   batcher.MetricsConfig
-	IndexerConfig   indexer.Config
-	GraphUrl        string
-	UseGraph        bool
+	BatcherConfig    batcher.Config
+	AdminConfig      admin.Config
+	TimeoutConfig    batcher.TimeoutConfig
+	NotifierConfig   batcher.NotifierConfig
+	BlobstoreConfig  blobstore.Config
+	LocalStoreConfig localstore.Config
+	EthClientConfig  geth.EthClientConfig
+	AwsClientConfig  aws.ClientConfig
+	EncoderConfig    encoding.EncoderConfig
+	LoggerConfig     logging.Config
+	MetricsConfig    batcher.MetricsConfig
+	IndexerConfig    indexer.Config
+	GraphUrl         string
+	UseGraph         bool
 
 	IndexerDataDir string
 
@@ -31,25 +39,50 @@ type Config struct {
 	EigenDAServiceManagerAddr     string
 }
 
-func NewConfig(ctx *cli.Context) Config {
+func NewConfig(ctx *cli.Context) (Config, error) {
+	adminConfig, err := admin.ReadCLIConfig(ctx, flags.FlagPrefix)
+	if err != nil {
+		return Config{}, err
+	}
+
+	localStoreConfig := localstore.ReadCLIConfig(ctx, flags.FlagPrefix)
+	blobstoreConfig := blobstore.Config{
+		BucketName: ctx.GlobalString(flags.S3BucketNameFlag.Name),
+		TableName:  ctx.GlobalString(flags.DynamoDBTableNameFlag.Name),
+	}
+	if !localStoreConfig.Enabled() && (blobstoreConfig.BucketName == "" || blobstoreConfig.TableName == "") {
+		return Config{}, errors.New("s3 bucket name and dynamodb table name must be set unless the local blob store is used")
+	}
+
 	config := Config{
-		BlobstoreConfig: blobstore.Config{
-			BucketName: ctx.GlobalString(flags.S3BucketNameFlag.Name),
-			TableName:  ctx.GlobalString(flags.DynamoDBTableNameFlag.Name),
-		},
-		EthClientConfig: geth.ReadEthClientConfig(ctx),
-		AwsClientConfig: aws.ReadClientConfig(ctx, flags.FlagPrefix),
-		EncoderConfig:   encoding.ReadCLIConfig(ctx),
-		LoggerConfig:    logging.ReadCLIConfig(ctx, flags.FlagPrefix),
+		AdminConfig:      adminConfig,
+		BlobstoreConfig:  blobstoreConfig,
+		LocalStoreConfig: localStoreConfig,
+		EthClientConfig:  geth.ReadEthClientConfig(ctx),
+		AwsClientConfig:  aws.ReadClientConfig(ctx, flags.FlagPrefix),
+		EncoderConfig:    encoding.ReadCLIConfig(ctx),
+		LoggerConfig:     logging.ReadCLIConfig(ctx, flags.FlagPrefix),
 		BatcherConfig: batcher.Config{
-			PullInterval:             ctx.GlobalDuration(flags.PullIntervalFlag.Name),
-			FinalizerInterval:        ctx.GlobalDuration(flags.FinalizerIntervalFlag.Name),
-			EncoderSocket:            ctx.GlobalString(flags.EncoderSocket.Name),
-			NumConnections:           ctx.GlobalInt(flags.NumConnectionsFlag.Name),
-			EncodingRequestQueueSize: ctx.GlobalInt(flags.EncodingRequestQueueSizeFlag.Name),
-			BatchSizeMBLimit:         ctx.GlobalUint(flags.BatchSizeLimitFlag.Name),
-			SRSOrder:                 ctx.GlobalInt(flags.SRSOrderFlag.Name),
-			MaxNumRetriesPerBlob:     ctx.GlobalUint(flags.MaxNumRetriesPerBlobFlag.Name),
+			PullInterval:               ctx.GlobalDuration(flags.PullIntervalFlag.Name),
+			FinalizerInterval:          ctx.GlobalDuration(flags.FinalizerIntervalFlag.Name),
+			FinalizerReorgDepth:        ctx.GlobalUint64(flags.FinalizerReorgDepthFlag.Name),
+			EncoderSockets:             ctx.GlobalStringSlice(flags.EncoderSocket.Name),
+			EncoderHealthCheckInterval: ctx.GlobalDuration(flags.EncoderHealthCheckIntervalFlag.Name),
+			NumConnections:             ctx.GlobalInt(flags.NumConnectionsFlag.Name),
+			EncodingRequestQueueSize:   ctx.GlobalInt(flags.EncodingRequestQueueSizeFlag.Name),
+			BatchSizeMBLimit:           ctx.GlobalUint(flags.BatchSizeLimitFlag.Name),
+			SRSOrder:                   ctx.GlobalInt(flags.SRSOrderFlag.Name),
+			MaxNumRetriesPerBlob:       ctx.GlobalUint(flags.MaxNumRetriesPerBlobFlag.Name),
+			MaxBlobsToFetchFromStore:   ctx.GlobalInt(flags.MaxBlobsToFetchFromStoreFlag.Name),
+			MaxBlobsPerEncodingRequest: ctx.GlobalInt(flags.MaxBlobsPerEncodingRequestFlag.Name),
+			MaxBytesPerEncodingRequest: ctx.GlobalInt(flags.MaxBytesPerEncodingRequestFlag.Name),
+			MaxInFlightBatches:         ctx.GlobalInt(flags.MaxInFlightBatchesFlag.Name),
+			RetryPolicy: batcher.RetryPolicy{
+				BaseDelay:  ctx.GlobalDuration(flags.RetryBaseDelayFlag.Name),
+				Multiplier: ctx.GlobalFloat64(flags.RetryDelayMultiplierFlag.Name),
+				Jitter:     ctx.GlobalFloat64(flags.RetryDelayJitterFlag.Name),
+			},
+			EigenDAServiceManagerAddr: gethcommon.HexToAddress(ctx.GlobalString(flags.EigenDAServiceManagerFlag.Name)),
 		},
 		TimeoutConfig: batcher.TimeoutConfig{
 			EncodingTimeout:    ctx.GlobalDuration(flags.EncodingTimeoutFlag.Name),
@@ -57,6 +90,18 @@ func NewConfig(ctx *cli.Context) Config {
 			ChainReadTimeout:   ctx.GlobalDuration(flags.ChainReadTimeoutFlag.Name),
 			ChainWriteTimeout:  ctx.GlobalDuration(flags.ChainWriteTimeoutFlag.Name),
 		},
+		NotifierConfig: batcher.NotifierConfig{
+			SigningKey:  ctx.GlobalString(flags.NotificationSigningKeyFlag.Name),
+			Timeout:     ctx.GlobalDuration(flags.NotificationTimeoutFlag.Name),
+			MaxAttempts: ctx.GlobalUint(flags.NotificationMaxAttemptsFlag.Name),
+			RetryPolicy: batcher.RetryPolicy{
+				BaseDelay:  ctx.GlobalDuration(flags.NotificationRetryBaseDelayFlag.Name),
+				Multiplier: ctx.GlobalFloat64(flags.NotificationRetryDelayMultiplierFlag.Name),
+				Jitter:     ctx.GlobalFloat64(flags.RetryDelayJitterFlag.Name),
+			},
+			NumWorkers:               ctx.GlobalInt(flags.NumNotificationWorkersFlag.Name),
+			MaxBlobsToFetchFromStore: ctx.GlobalInt(flags.MaxBlobsToFetchFromStoreFlag.Name),
+		},
 		MetricsConfig: batcher.MetricsConfig{
 			HTTPPort:      ctx.GlobalString(flags.MetricsHTTPPort.Name),
 			EnableMetrics: ctx.GlobalBool(flags.EnableMetrics.Name),
@@ -68,5 +113,5 @@ func NewConfig(ctx *cli.Context) Config {
 		IndexerDataDir:                ctx.GlobalString(flags.IndexerDataDirFlag.Name),
 		IndexerConfig:                 indexer.ReadIndexerConfig(ctx),
 	}
-	return config
+	return config, nil
 }
@@ -7,6 +7,8 @@ import (
 	"github.com/Layr-Labs/eigenda/common/aws"
 	"github.com/Layr-Labs/eigenda/common/geth"
 	"github.com/Layr-Labs/eigenda/common/logging"
+	"github.com/Layr-Labs/eigenda/disperser/admin"
+	"github.com/Layr-Labs/eigenda/disperser/common/localstore"
 	"github.com/Layr-Labs/eigenda/indexer"
 	"github.com/urfave/cli"
 )
@@ -20,14 +22,14 @@ var (
 	/* Required Flags */
 	S3BucketNameFlag = cli.StringFlag{
 		Name:     common.PrefixFlag(FlagPrefix, "s3-bucket-name"),
-		Usage:    "Name of the bucket to store blobs",
-		Required: true,
+		Usage:    "Name of the bucket to store blobs. Required unless the local blob store is used",
+		Required: false,
 		EnvVar:   common.PrefixEnvVar(envVarPrefix, "S3_BUCKET_NAME"),
 	}
 	DynamoDBTableNameFlag = cli.StringFlag{
 		Name:     common.PrefixFlag(FlagPrefix, "dynamodb-table-name"),
-		Usage:    "Name of the dynamodb table to store blob metadata",
-		Required: true,
+		Usage:    "Name of the dynamodb table to store blob metadata. Required unless the local blob store is used",
+		Required: false,
 		EnvVar:   common.PrefixEnvVar(envVarPrefix, "DYNAMODB_TABLE_NAME"),
 	}
 	PullIntervalFlag = cli.DurationFlag{
@@ -48,9 +50,9 @@ var (
 		Required: true,
 		EnvVar:   common.PrefixEnvVar(envVarPrefix, "EIGENDA_SERVICE_MANAGER"),
 	}
-	EncoderSocket = cli.StringFlag{
+	EncoderSocket = cli.StringSliceFlag{
 		Name:     "encoder-socket",
-		Usage:    "the http ip:port which the distributed encoder server is listening",
+		Usage:    "the http ip:port which the distributed encoder server is listening. Repeat the flag or separate the sockets with commas to spread the encoding requests over several encoder servers",
 		Required: true,
 		EnvVar:   common.PrefixEnvVar(envVarPrefix, "ENCODER_ADDRESS"),
 	}
@@ -134,6 +136,20 @@ var (
 		EnvVar:   common.PrefixEnvVar(envVarPrefix, "FINALIZER_INTERVAL"),
 		Value:    6 * time.Minute,
 	}
+	EncoderHealthCheckIntervalFlag = cli.DurationFlag{
+		Name:     common.PrefixFlag(FlagPrefix, "encoder-health-check-interval"),
+		Usage:    "Interval at which the health of the encoder servers is checked. Unhealthy encoder servers don't receive encoding requests while another one is healthy. 0 disables the health checks",
+		Required: false,
+		EnvVar:   common.PrefixEnvVar(envVarPrefix, "ENCODER_HEALTH_CHECK_INTERVAL"),
+		Value:    10 * time.Second,
+	}
+	FinalizerReorgDepthFlag = cli.Uint64Flag{
+		Name:     common.PrefixFlag(FlagPrefix, "finalizer-reorg-depth"),
+		Usage:    "Number of blocks by which the confirmation block of a blob must be behind the latest finalized block before the blob is dispersed again because its confirmation transaction is missing or reverted",
+		Required: false,
+		EnvVar:   common.PrefixEnvVar(envVarPrefix, "FINALIZER_REORG_DEPTH"),
+		Value:    10,
+	}
 	EncodingRequestQueueSizeFlag = cli.IntFlag{
 		Name:     common.PrefixFlag(FlagPrefix, "encoding-request-queue-size"),
 		Usage:    "Size of the encoding request queue",
@@ -141,6 +157,34 @@ var (
 		EnvVar:   common.PrefixEnvVar(envVarPrefix, "ENCODING_REQUEST_QUEUE_SIZE"),
 		Value:    500,
 	}
+	MaxBlobsToFetchFromStoreFlag = cli.IntFlag{
+		Name:     common.PrefixFlag(FlagPrefix, "max-blobs-to-fetch-from-store"),
+		Usage:    "Maximum number of blobs to fetch from the blob store at a time when encoding, finalizing and notifying blobs",
+		Required: false,
+		EnvVar:   common.PrefixEnvVar(envVarPrefix, "MAX_BLOBS_TO_FETCH_FROM_STORE"),
+		Value:    100,
+	}
+	MaxBlobsPerEncodingRequestFlag = cli.IntFlag{
+		Name:     common.PrefixFlag(FlagPrefix, "max-blobs-per-encoding-request"),
+		Usage:    "Maximum number of blobs with the same encoding params that are sent to the encoder in a single request. It must not exceed the limit of the encoder servers. 1 sends a request per blob",
+		Required: false,
+		EnvVar:   common.PrefixEnvVar(envVarPrefix, "MAX_BLOBS_PER_ENCODING_REQUEST"),
+		Value:    16,
+	}
+	MaxBytesPerEncodingRequestFlag = cli.IntFlag{
+		Name:     common.PrefixFlag(FlagPrefix, "max-bytes-per-encoding-request"),
+		Usage:    "Maximum total size in bytes of the blobs that are sent to the encoder in a single request. It must leave room for the request overhead within the max request size of the encoder servers. A larger blob is sent on its own",
+		Required: false,
+		EnvVar:   common.PrefixEnvVar(envVarPrefix, "MAX_BYTES_PER_ENCODING_REQUEST"),
+		Value:    8 * 1024 * 1024, // 8 MiB
+	}
+	MaxInFlightBatchesFlag = cli.IntFlag{
+		Name:     common.PrefixFlag(FlagPrefix, "max-in-flight-batches"),
+		Usage:    "Maximum number of batches that are being dispersed or confirmed at a time. The next batch is dispersed while the previous ones are being confirmed. 1 disperses and confirms one batch at a time",
+		Required: false,
+		EnvVar:   common.PrefixEnvVar(envVarPrefix, "MAX_IN_FLIGHT_BATCHES"),
+		Value:    2,
+	}
 	SRSOrderFlag = cli.IntFlag{
 		Name:     common.PrefixFlag(FlagPrefix, "srs-order"),
 		Usage:    "Size of the encoding request queue",
@@ -154,11 +198,71 @@ var (
 		EnvVar:   common.PrefixEnvVar(envVarPrefix, "MAX_NUM_RETRIES_PER_BLOB"),
 		Value:    2,
 	}
+	RetryBaseDelayFlag = cli.DurationFlag{
+		Name:     common.PrefixFlag(FlagPrefix, "retry-base-delay"),
+		Usage:    "Delay before a blob that failed in a batch is retried for the first time. Set to 0 to retry right away",
+		Required: false,
+		EnvVar:   common.PrefixEnvVar(envVarPrefix, "RETRY_BASE_DELAY"),
+		Value:    10 * time.Second,
+	}
+	RetryDelayMultiplierFlag = cli.Float64Flag{
+		Name:     common.PrefixFlag(FlagPrefix, "retry-delay-multiplier"),
+		Usage:    "Factor by which the retry delay of a blob grows with each retry",
+		Required: false,
+		EnvVar:   common.PrefixEnvVar(envVarPrefix, "RETRY_DELAY_MULTIPLIER"),
+		Value:    2,
+	}
+	RetryDelayJitterFlag = cli.Float64Flag{
+		Name:     common.PrefixFlag(FlagPrefix, "retry-delay-jitter"),
+		Usage:    "Maximum fraction of the retry delay that is randomly subtracted from it, between 0 and 1",
+		Required: false,
+		EnvVar:   common.PrefixEnvVar(envVarPrefix, "RETRY_DELAY_JITTER"),
+		Value:    0.2,
+	}
+	NotificationSigningKeyFlag = cli.StringFlag{
+		Name:     common.PrefixFlag(FlagPrefix, "notification-signing-key"),
+		Usage:    "Hex encoded ECDSA private key that signs the notifications sent to the callback URLs of blobs. Clients verify the notifications against its address. Notifications are disabled if it's not set",
+		Required: false,
+		EnvVar:   common.PrefixEnvVar(envVarPrefix, "NOTIFICATION_SIGNING_KEY"),
+	}
+	NotificationTimeoutFlag = cli.DurationFlag{
+		Name:     common.PrefixFlag(FlagPrefix, "notification-timeout"),
+		Usage:    "Timeout of a single notification request",
+		Required: false,
+		EnvVar:   common.PrefixEnvVar(envVarPrefix, "NOTIFICATION_TIMEOUT"),
+		Value:    10 * time.Second,
+	}
+	NotificationMaxAttemptsFlag = cli.UintFlag{
+		Name:     common.PrefixFlag(FlagPrefix, "notification-max-attempts"),
+		Usage:    "Number of failed deliveries after which a notification is abandoned",
+		Required: false,
+		EnvVar:   common.PrefixEnvVar(envVarPrefix, "NOTIFICATION_MAX_ATTEMPTS"),
+		Value:    10,
+	}
+	NotificationRetryBaseDelayFlag = cli.DurationFlag{
+		Name:     common.PrefixFlag(FlagPrefix, "notification-retry-base-delay"),
+		Usage:    "Delay before a failed notification is delivered again for the first time",
+		Required: false,
+		EnvVar:   common.PrefixEnvVar(envVarPrefix, "NOTIFICATION_RETRY_BASE_DELAY"),
+		Value:    5 * time.Second,
+	}
+	NotificationRetryDelayMultiplierFlag = cli.Float64Flag{
+		Name:     common.PrefixFlag(FlagPrefix, "notification-retry-delay-multiplier"),
+		Usage:    "Factor by which the retry delay of a notification grows with each failed delivery",
+		Required: false,
+		EnvVar:   common.PrefixEnvVar(envVarPrefix, "NOTIFICATION_RETRY_DELAY_MULTIPLIER"),
+		Value:    2,
+	}
+	NumNotificationWorkersFlag = cli.IntFlag{
+		Name:     common.PrefixFlag(FlagPrefix, "num-notification-workers"),
+		Usage:    "Number of notifications that are delivered concurrently",
+		Required: false,
+		EnvVar:   common.PrefixEnvVar(envVarPrefix, "NUM_NOTIFICATION_WORKERS"),
+		Value:    32,
+	}
 )
 
 var requiredFlags = []cli.Flag{
-	S3BucketNameFlag,
-	DynamoDBTableNameFlag,
 	PullIntervalFlag,
 	BlsOperatorStateRetrieverFlag,
 	EigenDAServiceManagerFlag,
@@ -171,6 +275,8 @@ var requiredFlags = []cli.Flag{
 }
 
 var optionalFlags = []cli.Flag{
+	S3BucketNameFlag,
+	DynamoDBTableNameFlag,
 	MetricsHTTPPort,
 	IndexerDataDirFlag,
 	EncodingTimeoutFlag,
@@ -179,8 +285,23 @@ var optionalFlags = []cli.Flag{
 	ChainWriteTimeoutFlag,
 	NumConnectionsFlag,
 	FinalizerIntervalFlag,
+	EncoderHealthCheckIntervalFlag,
+	FinalizerReorgDepthFlag,
 	EncodingRequestQueueSizeFlag,
+	MaxBlobsToFetchFromStoreFlag,
+	MaxBlobsPerEncodingRequestFlag,
+	MaxBytesPerEncodingRequestFlag,
+	MaxInFlightBatchesFlag,
 	MaxNumRetriesPerBlobFlag,
+	RetryBaseDelayFlag,
+	RetryDelayMultiplierFlag,
+	RetryDelayJitterFlag,
+	NotificationSigningKeyFlag,
+	NotificationTimeoutFlag,
+	NotificationMaxAttemptsFlag,
+	NotificationRetryBaseDelayFlag,
+	NotificationRetryDelayMultiplierFlag,
+	NumNotificationWorkersFlag,
 }
 
 // Flags contains the list of configuration options available to the binary.
@@ -192,4 +313,6 @@ func init() {
 	Flags = append(Flags, logging.CLIFlags(envVarPrefix, FlagPrefix)...)
 	Flags = append(Flags, indexer.CLIFlags(envVarPrefix)...)
 	Flags = append(Flags, aws.ClientFlags(envVarPrefix, FlagPrefix)...)
+	Flags = append(Flags, admin.CLIFlags(envVarPrefix, FlagPrefix)...)
+	Flags = append(Flags, localstore.CLIFlags(envVarPrefix, FlagPrefix)...)
 }
@@ -21,11 +21,14 @@ import (
 	"github.com/Layr-Labs/eigenda/common/logging"
 	"github.com/Layr-Labs/eigenda/core"
 	coreeth "github.com/Layr-Labs/eigenda/core/eth"
+	"github.com/Layr-Labs/eigenda/disperser"
+	"github.com/Layr-Labs/eigenda/disperser/admin"
 	"github.com/Layr-Labs/eigenda/disperser/batcher"
 	"github.com/Layr-Labs/eigenda/disperser/batcher/eth"
 	dispatcher "github.com/Layr-Labs/eigenda/disperser/batcher/grpc"
 	"github.com/Layr-Labs/eigenda/disperser/cmd/batcher/flags"
 	"github.com/Layr-Labs/eigenda/disperser/common/blobstore"
+	"github.com/Layr-Labs/eigenda/disperser/common/localstore"
 	"github.com/Layr-Labs/eigenda/disperser/encoder"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/urfave/cli"
@@ -56,21 +59,12 @@ func main() {
 }
 
 func RunBatcher(ctx *cli.Context) error {
-	config := NewConfig(ctx)
-
-	logger, err := logging.GetLogger(config.LoggerConfig)
+	config, err := NewConfig(ctx)
 	if err != nil {
 		return err
 	}
 
-	bucketName := config.BlobstoreConfig.BucketName
-	s3Client, err := s3.NewClient(context.Background(), config.AwsClientConfig, logger)
-	if err != nil {
-		return err
-	}
-	logger.Info("Initialized S3 client", "bucket", bucketName)
-
-	dynamoClient, err := dynamodb.NewClient(config.AwsClientConfig, logger)
+	logger, err := logging.GetLogger(config.LoggerConfig)
 	if err != nil {
 		return err
 	}
@@ -107,8 +101,28 @@ func RunBatcher(ctx *cli.Context) error {
 	if err != nil || storeDurationBlocks == 0 {
 		return fmt.Errorf("failed to get STORE_DURATION_BLOCKS: %w", err)
 	}
-	blobMetadataStore := blobstore.NewBlobMetadataStore(dynamoClient, logger, config.BlobstoreConfig.TableName, time.Duration((storeDurationBlocks+blockStaleMeasure)*12)*time.Second)
-	queue := blobstore.NewSharedStorage(bucketName, s3Client, blobMetadataStore, logger)
+	var queue disperser.BlobStore
+	if config.LocalStoreConfig.Enabled() {
+		queue, err = localstore.NewBlobStore(config.LocalStoreConfig.Dir, logger)
+		if err != nil {
+			return err
+		}
+	} else {
+		bucketName := config.BlobstoreConfig.BucketName
+		s3Client, err := s3.NewClient(context.Background(), config.AwsClientConfig, logger)
+		if err != nil {
+			return err
+		}
+		logger.Info("Initialized S3 client", "bucket", bucketName)
+
+		dynamoClient, err := dynamodb.NewClient(config.AwsClientConfig, logger)
+		if err != nil {
+			return err
+		}
+
+		blobMetadataStore := blobstore.NewBlobMetadataStore(dynamoClient, logger, config.BlobstoreConfig.TableName, time.Duration((storeDurationBlocks+blockStaleMeasure)*12)*time.Second)
+		queue = blobstore.NewSharedStorage(bucketName, s3Client, blobMetadataStore, logger)
+	}
 
 	cs := coreeth.NewChainState(tx, client)
 
@@ -131,15 +145,25 @@ func RunBatcher(ctx *cli.Context) error {
 
 	metrics := batcher.NewMetrics(config.MetricsConfig.HTTPPort, logger)
 
-	if len(config.BatcherConfig.EncoderSocket) == 0 {
+	if len(config.BatcherConfig.EncoderSockets) == 0 {
 		return fmt.Errorf("encoder socket must be specified")
 	}
-	encoderClient, err := encoder.NewEncoderClient(config.BatcherConfig.EncoderSocket, config.TimeoutConfig.EncodingTimeout)
+	encoderClient, err := encoder.NewEncoderPool(config.BatcherConfig.EncoderSockets, config.BatcherConfig.EncoderHealthCheckInterval, logger)
 	if err != nil {
 		return err
 	}
-	finalizer := batcher.NewFinalizer(config.TimeoutConfig.ChainReadTimeout, config.BatcherConfig.FinalizerInterval, queue, client, rpcClient, logger)
-	batcher, err := batcher.NewBatcher(config.BatcherConfig, config.TimeoutConfig, queue, dispatcher, confirmer, ics, asgn, encoderClient, agg, client, finalizer, logger, metrics)
+	encoderClient.Start(context.Background())
+	notifier := batcher.NewNoopNotifier()
+	if config.NotifierConfig.SigningKey != "" {
+		notifier, err = batcher.NewNotifier(config.NotifierConfig, queue, logger)
+		if err != nil {
+			return err
+		}
+	} else {
+		logger.Info("Notification signing key is not set, callback URLs won't be notified")
+	}
+	finalizer := batcher.NewFinalizer(config.TimeoutConfig.ChainReadTimeout, config.BatcherConfig.FinalizerInterval, int32(config.BatcherConfig.MaxBlobsToFetchFromStore), config.BatcherConfig.FinalizerReorgDepth, queue, client, rpcClient, notifier, logger, metrics)
+	batcher, err := batcher.NewBatcher(config.BatcherConfig, config.TimeoutConfig, queue, dispatcher, confirmer, ics, asgn, encoderClient, agg, client, finalizer, notifier, logger, metrics)
 	if err != nil {
 		return err
 	}
@@ -156,6 +180,15 @@ func RunBatcher(ctx *cli.Context) error {
 		return err
 	}
 
+	if config.AdminConfig.Enabled() {
+		adminServer := admin.NewServer(config.AdminConfig, queue, nil, batcher, logger)
+		go func() {
+			if err := adminServer.Start(context.Background()); err != nil {
+				logger.Error("admin server failed", "err", err)
+			}
+		}()
+	}
+
 	return nil
 
 }
@@ -25,6 +25,7 @@ type Config struct {
 	SubgraphApiOperatorStateAddr string
 	ServerMode                   string
 	AllowOrigins                 []string
+	AdminAPIKey                  string
 
 	BLSOperatorStateRetrieverAddr string
 	EigenDAServiceManagerAddr     string
@@ -52,6 +53,7 @@ func NewConfig(ctx *cli.Context) Config {
 			Cluster:   ctx.GlobalString(flags.PrometheusMetricsClusterLabelFlag.Name),
 		},
 		AllowOrigins: ctx.GlobalStringSlice(flags.AllowOriginsFlag.Name),
+		AdminAPIKey:  ctx.GlobalString(flags.AdminAPIKeyFlag.Name),
 		MetricsConfig: dataapi.MetricsConfig{
 			HTTPPort:      ctx.GlobalString(flags.MetricsHTTPPort.Name),
 			EnableMetrics: ctx.GlobalBool(flags.EnableMetrics.Name),
@@ -110,6 +110,12 @@ var (
 		Value:    "9100",
 		EnvVar:   common.PrefixEnvVar(envVarPrefix, "METRICS_HTTP_PORT"),
 	}
+	AdminAPIKeyFlag = cli.StringFlag{
+		Name:     common.PrefixFlag(FlagPrefix, "admin-api-key"),
+		Usage:    "the bearer token required by the admin endpoints (e.g. requeueing failed blobs). The admin endpoints are disabled if not set",
+		Required: false,
+		EnvVar:   common.PrefixEnvVar(envVarPrefix, "ADMIN_API_KEY"),
+	}
 )
 
 var requiredFlags = []cli.Flag{
@@ -131,6 +137,7 @@ var requiredFlags = []cli.Flag{
 var optionalFlags = []cli.Flag{
 	ServerModeFlag,
 	MetricsHTTPPort,
+	AdminAPIKeyFlag,
 }
 
 // Flags contains the list of configuration options available to the binary.
@@ -93,6 +93,7 @@ func RunDataApi(ctx *cli.Context) error {
 				ServerMode:   config.ServerMode,
 				SocketAddr:   config.SocketAddr,
 				AllowOrigins: config.AllowOrigins,
+				AdminAPIKey:  config.AdminAPIKey,
 			},
 			sharedStorage,
 			promClient,
@@ -23,6 +23,9 @@ func NewConfig(ctx *cli.Context) Config {
 			GrpcPort:              ctx.GlobalString(flags.GrpcPortFlag.Name),
 			MaxConcurrentRequests: ctx.GlobalInt(flags.MaxConcurrentRequestsFlag.Name),
 			RequestPoolSize:       ctx.GlobalInt(flags.RequestPoolSizeFlag.Name),
+			StreamFrameSize:       ctx.GlobalInt(flags.StreamFrameSizeFlag.Name),
+			MaxRequestSize:        ctx.GlobalInt(flags.MaxRequestSizeFlag.Name),
+			MaxBlobsPerRequest:    ctx.GlobalInt(flags.MaxBlobsPerRequestFlag.Name),
 		},
 		MetricsConfig: encoder.MetrisConfig{
 			HTTPPort:      ctx.GlobalString(flags.MetricsHTTPPort.Name),
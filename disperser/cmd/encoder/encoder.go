@@ -14,6 +14,9 @@ type EncoderGRPCServer struct {
 }
 
 func NewEncoderGRPCServer(config Config, logger common.Logger) (*EncoderGRPCServer, error) {
+	if config.ServerConfig.StreamFrameSize > encoder.MaxStreamFrameSize {
+		return nil, fmt.Errorf("stream frame size must not exceed %d bytes, but found %d", encoder.MaxStreamFrameSize, config.ServerConfig.StreamFrameSize)
+	}
 
 	coreEncoder, err := encoding.NewEncoder(config.EncoderConfig)
 	if err != nil {
@@ -43,11 +43,32 @@ var (
 	}
 	RequestPoolSizeFlag = cli.IntFlag{
 		Name:     common.PrefixFlag(FlagPrefix, "request-pool-size"),
-		Usage:    "maximum number of requests in the request pool",
+		Usage:    "maximum number of requests in the request pool, counting both the running requests and the ones queued by deadline. Requests are rejected while the pool is full",
 		Required: false,
 		Value:    32,
 		EnvVar:   common.PrefixEnvVar(envVarPrefix, "REQUEST_POOL_SIZE"),
 	}
+	StreamFrameSizeFlag = cli.IntFlag{
+		Name:     common.PrefixFlag(FlagPrefix, "stream-frame-size"),
+		Usage:    "maximum size in bytes of the chunks sent in a single message of a streamed encoding reply. It must not exceed 4 MiB",
+		Required: false,
+		Value:    1024 * 1024, // 1 MiB
+		EnvVar:   common.PrefixEnvVar(envVarPrefix, "STREAM_FRAME_SIZE"),
+	}
+	MaxRequestSizeFlag = cli.IntFlag{
+		Name:     common.PrefixFlag(FlagPrefix, "max-request-size"),
+		Usage:    "maximum size in bytes of an encoding request, which bounds the total size of the blobs of a request that encodes several blobs together",
+		Required: false,
+		Value:    16 * 1024 * 1024, // 16 MiB
+		EnvVar:   common.PrefixEnvVar(envVarPrefix, "MAX_REQUEST_SIZE"),
+	}
+	MaxBlobsPerRequestFlag = cli.IntFlag{
+		Name:     common.PrefixFlag(FlagPrefix, "max-blobs-per-request"),
+		Usage:    "maximum number of blobs in a request that encodes several blobs together. Each blob of the request takes a slot of the request pool",
+		Required: false,
+		Value:    16,
+		EnvVar:   common.PrefixEnvVar(envVarPrefix, "MAX_BLOBS_PER_REQUEST"),
+	}
 )
 
 var requiredFlags = []cli.Flag{
@@ -59,6 +80,9 @@ var optionalFlags = []cli.Flag{
 	EnableMetrics,
 	MaxConcurrentRequestsFlag,
 	RequestPoolSizeFlag,
+	StreamFrameSizeFlag,
+	MaxRequestSizeFlag,
+	MaxBlobsPerRequestFlag,
 }
 
 // Flags contains the list of configuration options available to the binary.
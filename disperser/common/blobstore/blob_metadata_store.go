@@ -2,6 +2,7 @@ package blobstore
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/Layr-Labs/eigenda/disperser"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 )
@@ -18,6 +20,10 @@ import (
 const (
 	statusIndexName = "StatusIndex"
 	batchIndexName  = "BatchIndex"
+
+	// maxFailureHistoryUpdateAttempts is the number of times a failure is appended to a full failure history when the
+	// history keeps changing concurrently
+	maxFailureHistoryUpdateAttempts = 3
 )
 
 // BlobMetadataStore is a blob metadata storage backed by DynamoDB
@@ -73,9 +79,60 @@ func (s *BlobMetadataStore) GetBlobMetadata(ctx context.Context, metadataKey dis
 	return metadata, nil
 }
 
+// GetBulkBlobMetadata returns the metadata of the blobs with the given keys, reading them in batches
+// The blobs that aren't found are left out, and the order of the metadata isn't guaranteed to match the order of the keys.
+func (s *BlobMetadataStore) GetBulkBlobMetadata(ctx context.Context, metadataKeys []disperser.BlobKey) ([]*disperser.BlobMetadata, error) {
+	keys := make([]commondynamodb.Key, len(metadataKeys))
+	for i, metadataKey := range metadataKeys {
+		keys[i] = commondynamodb.Key{
+			"BlobHash": &types.AttributeValueMemberS{
+				Value: metadataKey.BlobHash,
+			},
+			"MetadataHash": &types.AttributeValueMemberS{
+				Value: metadataKey.MetadataHash,
+			},
+		}
+	}
+	items, err := s.dynamoDBClient.GetItems(ctx, s.tableName, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := make([]*disperser.BlobMetadata, len(items))
+	for i, item := range items {
+		metadata[i], err = UnmarshalBlobMetadata(item)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return metadata, nil
+}
+
+// GetAllBlobMetadataByBlobHash returns the metadata of all the requests for the blob with the given hash
+func (s *BlobMetadataStore) GetAllBlobMetadataByBlobHash(ctx context.Context, blobHash disperser.BlobHash) ([]*disperser.BlobMetadata, error) {
+	items, err := s.dynamoDBClient.Query(ctx, s.tableName, "BlobHash = :blob_hash", commondynamodb.ExpresseionValues{
+		":blob_hash": &types.AttributeValueMemberS{
+			Value: blobHash,
+		}})
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := make([]*disperser.BlobMetadata, len(items))
+	for i, item := range items {
+		metadata[i], err = UnmarshalBlobMetadata(item)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return metadata, nil
+}
+
 // GetBlobMetadataByStatus returns all the metadata with the given status
-// Because this function scans the entire index, it should only be used for status with a limited number of items.
-// It should only be used to filter "Processing" status. To support other status, a streaming version should be implemented.
+// Because this function reads the entire index, it should only be used for status with a limited number of items.
+// GetBlobMetadataByStatusWithPagination should be used to go through a large number of items.
 func (s *BlobMetadataStore) GetBlobMetadataByStatus(ctx context.Context, status disperser.BlobStatus) ([]*disperser.BlobMetadata, error) {
 	items, err := s.dynamoDBClient.QueryIndex(ctx, s.tableName, statusIndexName, "BlobStatus = :status", commondynamodb.ExpresseionValues{
 		":status": &types.AttributeValueMemberN{
@@ -96,6 +153,48 @@ func (s *BlobMetadataStore) GetBlobMetadataByStatus(ctx context.Context, status
 	return metadata, nil
 }
 
+// GetBlobMetadataByStatusWithPagination returns at most limit metadata with the given status, ordered by request time
+// and starting after exclusiveStartKey (from the beginning if it's nil).
+// It also returns the key to start the next page from, which is nil once the index has been read to the end.
+func (s *BlobMetadataStore) GetBlobMetadataByStatusWithPagination(ctx context.Context, status disperser.BlobStatus, limit int32, exclusiveStartKey *disperser.BlobStoreExclusiveStartKey) ([]*disperser.BlobMetadata, *disperser.BlobStoreExclusiveStartKey, error) {
+	var startKey commondynamodb.Key
+	if exclusiveStartKey != nil {
+		var err error
+		startKey, err = attributevalue.MarshalMap(exclusiveStartKey)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	result, err := s.dynamoDBClient.QueryIndexWithPagination(ctx, s.tableName, statusIndexName, "BlobStatus = :status", commondynamodb.ExpresseionValues{
+		":status": &types.AttributeValueMemberN{
+			Value: strconv.Itoa(int(status)),
+		}}, limit, startKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metadata := make([]*disperser.BlobMetadata, len(result.Items))
+	for i, item := range result.Items {
+		metadata[i], err = UnmarshalBlobMetadata(item)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if result.LastEvaluatedKey == nil {
+		return metadata, nil, nil
+	}
+
+	lastEvaluatedKey := &disperser.BlobStoreExclusiveStartKey{}
+	err = attributevalue.UnmarshalMap(result.LastEvaluatedKey, lastEvaluatedKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return metadata, lastEvaluatedKey, nil
+}
+
 func (s *BlobMetadataStore) GetAllBlobMetadataByBatch(ctx context.Context, batchHeaderHash [32]byte) ([]*disperser.BlobMetadata, error) {
 	items, err := s.dynamoDBClient.QueryIndex(ctx, s.tableName, batchIndexName, "BatchHeaderHash = :batch_header_hash", commondynamodb.ExpresseionValues{
 		":batch_header_hash": &types.AttributeValueMemberB{
@@ -148,21 +247,99 @@ func (s *BlobMetadataStore) GetBlobMetadataInBatch(ctx context.Context, batchHea
 	return metadata, nil
 }
 
-func (s *BlobMetadataStore) IncrementNumRetries(ctx context.Context, existingMetadata *disperser.BlobMetadata) error {
-	_, err := s.dynamoDBClient.UpdateItem(ctx, s.tableName, map[string]types.AttributeValue{
+// IncrementNumRetries increments the retry count of the blob, appends the failure to its failure history and sets
+// the time before which it won't be retried.
+// It returns commondynamodb.ErrConditionFailed if the blob is no longer processing.
+func (s *BlobMetadataStore) IncrementNumRetries(ctx context.Context, existingMetadata *disperser.BlobMetadata, failure *disperser.BlobFailure, notBefore uint64) error {
+	condition := expression.Name("BlobStatus").Equal(expression.Value(int(disperser.Processing)))
+	return s.addBlobFailure(ctx, existingMetadata.GetBlobKey(), failure, commondynamodb.Item{
+		"NumRetries": &types.AttributeValueMemberN{
+			Value: strconv.Itoa(int(existingMetadata.NumRetries + 1)),
+		},
+		"NotBefore": &types.AttributeValueMemberN{
+			Value: strconv.FormatUint(notBefore, 10),
+		},
+	}, &condition)
+}
+
+// AddBlobFailure appends the failure to the failure history of the blob and sets the given attributes in the same update.
+// The failure is appended to the history in the table with list_append, rather than to the caller's copy of the
+// metadata, so that the failures recorded concurrently or since the caller fetched the metadata aren't lost.
+func (s *BlobMetadataStore) AddBlobFailure(ctx context.Context, metadataKey disperser.BlobKey, failure *disperser.BlobFailure, attributes commondynamodb.Item) error {
+	return s.addBlobFailure(ctx, metadataKey, failure, attributes, nil)
+}
+
+// addBlobFailure is AddBlobFailure with an optional condition on the existing item.
+// Once the history holds MaxFailureHistoryLength failures, the oldest ones are dropped by writing the whole history,
+// which is only done if the history hasn't changed since it was read.
+func (s *BlobMetadataStore) addBlobFailure(ctx context.Context, metadataKey disperser.BlobKey, failure *disperser.BlobFailure, attributes commondynamodb.Item, condition *expression.ConditionBuilder) error {
+	key := map[string]types.AttributeValue{
 		"BlobHash": &types.AttributeValueMemberS{
-			Value: existingMetadata.BlobHash,
+			Value: metadataKey.BlobHash,
 		},
 		"MetadataHash": &types.AttributeValueMemberS{
-			Value: existingMetadata.MetadataHash,
-		},
-	}, commondynamodb.Item{
-		"NumRetries": &types.AttributeValueMemberN{
-			Value: strconv.Itoa(int(existingMetadata.NumRetries + 1)),
+			Value: metadataKey.MetadataHash,
 		},
-	})
+	}
+	appended, err := attributevalue.Marshal([]*disperser.BlobFailure{failure})
+	if err != nil {
+		return err
+	}
+	historyName := expression.Name("FailureHistory")
+	notFull := historyName.AttributeNotExists().Or(historyName.Size().LessThan(expression.Value(disperser.MaxFailureHistoryLength)))
+
+	for attempt := 0; attempt < maxFailureHistoryUpdateAttempts; attempt++ {
+		appendCondition := withCondition(notFull, condition)
+		_, err = s.dynamoDBClient.UpdateItemAndAppend(ctx, s.tableName, key, attributes, commondynamodb.Item{
+			"FailureHistory": appended,
+		}, &appendCondition)
+		if !errors.Is(err, commondynamodb.ErrConditionFailed) {
+			return err
+		}
 
-	return err
+		// Either the given condition doesn't hold or the history is full
+		item, err := s.dynamoDBClient.GetItem(ctx, s.tableName, key)
+		if err != nil {
+			return err
+		}
+		existingHistory, ok := item["FailureHistory"]
+		if !ok {
+			return commondynamodb.ErrConditionFailed
+		}
+		var history []*disperser.BlobFailure
+		if err := attributevalue.Unmarshal(existingHistory, &history); err != nil {
+			return err
+		}
+		if len(history) < disperser.MaxFailureHistoryLength {
+			return commondynamodb.ErrConditionFailed
+		}
+
+		trimmedHistory, err := attributevalue.Marshal(disperser.AppendBlobFailure(history, failure))
+		if err != nil {
+			return err
+		}
+		updated := commondynamodb.Item{
+			"FailureHistory": trimmedHistory,
+		}
+		for name, value := range attributes {
+			updated[name] = value
+		}
+		_, err = s.dynamoDBClient.UpdateItemWithCondition(ctx, s.tableName, key, updated, withCondition(historyName.Equal(expression.Value(existingHistory)), condition))
+		if !errors.Is(err, commondynamodb.ErrConditionFailed) {
+			return err
+		}
+		// The history was changed concurrently, or the given condition doesn't hold anymore
+	}
+
+	return commondynamodb.ErrConditionFailed
+}
+
+// withCondition returns the conjunction of the conditions, the second of which is optional
+func withCondition(condition expression.ConditionBuilder, other *expression.ConditionBuilder) expression.ConditionBuilder {
+	if other == nil {
+		return condition
+	}
+	return condition.And(*other)
 }
 
 func (s *BlobMetadataStore) UpdateBlobMetadata(ctx context.Context, metadataKey disperser.BlobKey, updated *disperser.BlobMetadata) error {
@@ -183,6 +360,27 @@ func (s *BlobMetadataStore) UpdateBlobMetadata(ctx context.Context, metadataKey
 	return err
 }
 
+// CompareAndUpdateBlobMetadata updates the blob metadata only if its current status is expectedStatus.
+// It returns commondynamodb.ErrConditionFailed if the blob is in any other status.
+func (s *BlobMetadataStore) CompareAndUpdateBlobMetadata(ctx context.Context, metadataKey disperser.BlobKey, expectedStatus disperser.BlobStatus, updated *disperser.BlobMetadata) error {
+	item, err := MarshalBlobMetadata(updated)
+	if err != nil {
+		return err
+	}
+
+	condition := expression.Name("BlobStatus").Equal(expression.Value(int(expectedStatus)))
+	_, err = s.dynamoDBClient.UpdateItemWithCondition(ctx, s.tableName, map[string]types.AttributeValue{
+		"BlobHash": &types.AttributeValueMemberS{
+			Value: metadataKey.BlobHash,
+		},
+		"MetadataHash": &types.AttributeValueMemberS{
+			Value: metadataKey.MetadataHash,
+		},
+	}, item, condition)
+
+	return err
+}
+
 func (s *BlobMetadataStore) SetBlobStatus(ctx context.Context, metadataKey disperser.BlobKey, status disperser.BlobStatus) error {
 	_, err := s.dynamoDBClient.UpdateItem(ctx, s.tableName, map[string]types.AttributeValue{
 		"BlobHash": &types.AttributeValueMemberS{
@@ -200,6 +398,168 @@ func (s *BlobMetadataStore) SetBlobStatus(ctx context.Context, metadataKey dispe
 	return err
 }
 
+// SetBlobFailed sets the status of a processing blob to Failed and appends the failure to its failure history.
+// It returns commondynamodb.ErrConditionFailed if the blob is in any other status.
+func (s *BlobMetadataStore) SetBlobFailed(ctx context.Context, metadataKey disperser.BlobKey, failure *disperser.BlobFailure) error {
+	condition := expression.Name("BlobStatus").Equal(expression.Value(int(disperser.Processing)))
+	return s.addBlobFailure(ctx, metadataKey, failure, commondynamodb.Item{
+		"BlobStatus": &types.AttributeValueMemberN{
+			Value: strconv.Itoa(int(disperser.Failed)),
+		},
+	}, &condition)
+}
+
+// SetBlobProcessing sets the status of the blob to Processing unless it was cancelled.
+// It returns commondynamodb.ErrConditionFailed if the blob is cancelled.
+func (s *BlobMetadataStore) SetBlobProcessing(ctx context.Context, metadataKey disperser.BlobKey) error {
+	condition := expression.Name("BlobStatus").NotEqual(expression.Value(int(disperser.Cancelled)))
+	_, err := s.dynamoDBClient.UpdateItemWithCondition(ctx, s.tableName, map[string]types.AttributeValue{
+		"BlobHash": &types.AttributeValueMemberS{
+			Value: metadataKey.BlobHash,
+		},
+		"MetadataHash": &types.AttributeValueMemberS{
+			Value: metadataKey.MetadataHash,
+		},
+	}, commondynamodb.Item{
+		"BlobStatus": &types.AttributeValueMemberN{
+			Value: strconv.Itoa(int(disperser.Processing)),
+		},
+	}, condition)
+
+	return err
+}
+
+// CompareAndSetBlobStatus sets the status of the blob only if its current status is expectedStatus.
+// It returns commondynamodb.ErrConditionFailed if the blob is in any other status.
+func (s *BlobMetadataStore) CompareAndSetBlobStatus(ctx context.Context, metadataKey disperser.BlobKey, expectedStatus disperser.BlobStatus, status disperser.BlobStatus) error {
+	condition := expression.Name("BlobStatus").Equal(expression.Value(int(expectedStatus)))
+	_, err := s.dynamoDBClient.UpdateItemWithCondition(ctx, s.tableName, map[string]types.AttributeValue{
+		"BlobHash": &types.AttributeValueMemberS{
+			Value: metadataKey.BlobHash,
+		},
+		"MetadataHash": &types.AttributeValueMemberS{
+			Value: metadataKey.MetadataHash,
+		},
+	}, commondynamodb.Item{
+		"BlobStatus": &types.AttributeValueMemberN{
+			Value: strconv.Itoa(int(status)),
+		},
+	}, condition)
+
+	return err
+}
+
+// RequeueFailedBlob sets the status of a failed blob to Processing and resets its retry count, delay and notified and
+// abandoned notification statuses.
+// It returns commondynamodb.ErrConditionFailed if the blob is in any other status.
+func (s *BlobMetadataStore) RequeueFailedBlob(ctx context.Context, metadataKey disperser.BlobKey) error {
+	condition := expression.Name("BlobStatus").Equal(expression.Value(int(disperser.Failed)))
+	_, err := s.dynamoDBClient.UpdateItemWithCondition(ctx, s.tableName, map[string]types.AttributeValue{
+		"BlobHash": &types.AttributeValueMemberS{
+			Value: metadataKey.BlobHash,
+		},
+		"MetadataHash": &types.AttributeValueMemberS{
+			Value: metadataKey.MetadataHash,
+		},
+	}, commondynamodb.Item{
+		"BlobStatus": &types.AttributeValueMemberN{
+			Value: strconv.Itoa(int(disperser.Processing)),
+		},
+		"NumRetries": &types.AttributeValueMemberN{
+			Value: "0",
+		},
+		"NotBefore": &types.AttributeValueMemberN{
+			Value: "0",
+		},
+		"NotifiedStatus": &types.AttributeValueMemberN{
+			Value: strconv.Itoa(int(disperser.Processing)),
+		},
+		"AbandonedNotificationStatus": &types.AttributeValueMemberN{
+			Value: strconv.Itoa(int(disperser.Processing)),
+		},
+	}, condition)
+
+	return err
+}
+
+// RequeueConfirmedBlob sets the status of a confirmed blob to Processing, removes its confirmation info and resets its
+// notified and abandoned notification statuses, so that the blob is dispersed again.
+// It returns commondynamodb.ErrConditionFailed if the blob is in any other status.
+func (s *BlobMetadataStore) RequeueConfirmedBlob(ctx context.Context, metadataKey disperser.BlobKey) error {
+	// The confirmation info is flattened into the item, so all its attributes are removed.
+	// This also removes the blob from the batch index.
+	confirmationInfo, err := attributevalue.MarshalMap(disperser.ConfirmationInfo{})
+	if err != nil {
+		return err
+	}
+	removedAttributes := make([]string, 0, len(confirmationInfo))
+	for attribute := range confirmationInfo {
+		removedAttributes = append(removedAttributes, attribute)
+	}
+
+	condition := expression.Name("BlobStatus").Equal(expression.Value(int(disperser.Confirmed)))
+	_, err = s.dynamoDBClient.UpdateItemWithConditionAndRemove(ctx, s.tableName, map[string]types.AttributeValue{
+		"BlobHash": &types.AttributeValueMemberS{
+			Value: metadataKey.BlobHash,
+		},
+		"MetadataHash": &types.AttributeValueMemberS{
+			Value: metadataKey.MetadataHash,
+		},
+	}, commondynamodb.Item{
+		"BlobStatus": &types.AttributeValueMemberN{
+			Value: strconv.Itoa(int(disperser.Processing)),
+		},
+		"NotifiedStatus": &types.AttributeValueMemberN{
+			Value: strconv.Itoa(int(disperser.Processing)),
+		},
+		"AbandonedNotificationStatus": &types.AttributeValueMemberN{
+			Value: strconv.Itoa(int(disperser.Processing)),
+		},
+	}, removedAttributes, condition)
+
+	return err
+}
+
+// SetNotifiedStatus records the last status of the blob that was delivered to its callback URL.
+// It returns commondynamodb.ErrConditionFailed if the blob doesn't exist, e.g. because it expired.
+func (s *BlobMetadataStore) SetNotifiedStatus(ctx context.Context, metadataKey disperser.BlobKey, status disperser.BlobStatus) error {
+	condition := expression.AttributeExists(expression.Name("BlobStatus"))
+	_, err := s.dynamoDBClient.UpdateItemWithCondition(ctx, s.tableName, map[string]types.AttributeValue{
+		"BlobHash": &types.AttributeValueMemberS{
+			Value: metadataKey.BlobHash,
+		},
+		"MetadataHash": &types.AttributeValueMemberS{
+			Value: metadataKey.MetadataHash,
+		},
+	}, commondynamodb.Item{
+		"NotifiedStatus": &types.AttributeValueMemberN{
+			Value: strconv.Itoa(int(status)),
+		},
+	}, condition)
+
+	return err
+}
+
+// SetAbandonedNotificationStatus records the last status of the blob whose delivery to its callback URL was given up on.
+// It returns commondynamodb.ErrConditionFailed if the blob doesn't exist, e.g. because it expired.
+func (s *BlobMetadataStore) SetAbandonedNotificationStatus(ctx context.Context, metadataKey disperser.BlobKey, status disperser.BlobStatus) error {
+	condition := expression.AttributeExists(expression.Name("BlobStatus"))
+	_, err := s.dynamoDBClient.UpdateItemWithCondition(ctx, s.tableName, map[string]types.AttributeValue{
+		"BlobHash": &types.AttributeValueMemberS{
+			Value: metadataKey.BlobHash,
+		},
+		"MetadataHash": &types.AttributeValueMemberS{
+			Value: metadataKey.MetadataHash,
+		},
+	}, commondynamodb.Item{
+		"AbandonedNotificationStatus": &types.AttributeValueMemberN{
+			Value: strconv.Itoa(int(status)),
+		},
+	}, condition)
+
+	return err
+}
+
 func GenerateTableSchema(metadataTableName string, readCapacityUnits int64, writeCapacityUnits int64) *dynamodb.CreateTableInput {
 	return &dynamodb.CreateTableInput{
 		AttributeDefinitions: []types.AttributeDefinition{
@@ -338,7 +698,8 @@ func UnmarshalBlobMetadata(item commondynamodb.Item) (*disperser.BlobMetadata, e
 		return nil, err
 	}
 	metadata.RequestMetadata = &requestMetadata
-	if metadata.BlobStatus != disperser.Confirmed && metadata.BlobStatus != disperser.Finalized {
+	// Blobs with insufficient signatures were included in a confirmed batch, so they also have confirmation info
+	if metadata.BlobStatus != disperser.Confirmed && metadata.BlobStatus != disperser.Finalized && metadata.BlobStatus != disperser.InsufficientSignatures {
 		return &metadata, nil
 	}
 
@@ -62,23 +62,51 @@ func TestBlobMetadataStoreOperations(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, metadata2, fetchedMetadata)
 
+	byBlobHash, err := blobMetadataStore.GetAllBlobMetadataByBlobHash(ctx, blobHash)
+	assert.NoError(t, err)
+	assert.Len(t, byBlobHash, 1)
+	assert.Equal(t, metadata1, byBlobHash[0])
+
 	processing, err := blobMetadataStore.GetBlobMetadataByStatus(ctx, disperser.Processing)
 	assert.NoError(t, err)
 	assert.Len(t, processing, 1)
 	assert.Equal(t, metadata1, processing[0])
 
-	err = blobMetadataStore.IncrementNumRetries(ctx, metadata1)
+	failure := &disperser.BlobFailure{Stage: disperser.AggregationStage, Error: "test failure", Attempt: 0, Timestamp: 123}
+	err = blobMetadataStore.IncrementNumRetries(ctx, metadata1, failure, 456)
 	assert.NoError(t, err)
 	fetchedMetadata, err = blobMetadataStore.GetBlobMetadata(ctx, blobKey1)
 	assert.NoError(t, err)
 	metadata1.NumRetries = 1
+	metadata1.NotBefore = 456
+	metadata1.FailureHistory = []*disperser.BlobFailure{failure}
 	assert.Equal(t, metadata1, fetchedMetadata)
 
+	// The failure history is kept in the table and capped at MaxFailureHistoryLength
+	for i := 0; i < disperser.MaxFailureHistoryLength; i++ {
+		err = blobMetadataStore.AddBlobFailure(ctx, blobKey1, &disperser.BlobFailure{Stage: disperser.EncodingStage, Error: "encoding failure", Attempt: 1, Timestamp: uint64(i)}, nil)
+		assert.NoError(t, err)
+	}
+	fetchedMetadata, err = blobMetadataStore.GetBlobMetadata(ctx, blobKey1)
+	assert.NoError(t, err)
+	assert.Len(t, fetchedMetadata.FailureHistory, disperser.MaxFailureHistoryLength)
+	assert.Equal(t, disperser.EncodingStage, fetchedMetadata.FailureHistory[0].Stage)
+	assert.Equal(t, uint64(disperser.MaxFailureHistoryLength-1), fetchedMetadata.LastFailure().Timestamp)
+	metadata1.FailureHistory = fetchedMetadata.FailureHistory
+
 	finalized, err := blobMetadataStore.GetBlobMetadataByStatus(ctx, disperser.Finalized)
 	assert.NoError(t, err)
 	assert.Len(t, finalized, 1)
 	assert.Equal(t, metadata2, finalized[0])
 
+	err = blobMetadataStore.SetNotifiedStatus(ctx, blobKey2, disperser.Finalized)
+	assert.NoError(t, err)
+	fetchedMetadata, err = blobMetadataStore.GetBlobMetadata(ctx, blobKey2)
+	assert.NoError(t, err)
+	assert.Equal(t, disperser.Finalized, fetchedMetadata.NotifiedStatus)
+	err = blobMetadataStore.SetNotifiedStatus(ctx, disperser.BlobKey{BlobHash: "missing", MetadataHash: "missing"}, disperser.Finalized)
+	assert.ErrorIs(t, err, commondynamodb.ErrConditionFailed)
+
 	confirmedMetadata := getConfirmedMetadata(t, blobKey1)
 	err = blobMetadataStore.UpdateBlobMetadata(ctx, blobKey1, confirmedMetadata)
 	assert.NoError(t, err)
@@ -4,11 +4,13 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
 
 	"github.com/Layr-Labs/eigenda/common"
+	commondynamodb "github.com/Layr-Labs/eigenda/common/aws/dynamodb"
 	"github.com/Layr-Labs/eigenda/common/aws/s3"
 	"github.com/Layr-Labs/eigenda/core"
 	"github.com/Layr-Labs/eigenda/disperser"
@@ -78,8 +80,8 @@ func (s *SharedBlobStore) StoreBlob(ctx context.Context, blob *core.Blob, reques
 		return metadataKey, errors.New("blob is nil")
 	}
 
-	blobHash := getBlobHash(blob)
-	metadataHash, err := getMetadataHash(requestedAt, blob.RequestHeader.SecurityParams)
+	blobHash := GetBlobHash(blob)
+	metadataHash, err := GetMetadataHash(requestedAt, blob.RequestHeader.SecurityParams)
 	if err != nil {
 		s.logger.Error("error creating metadata key", "err", err)
 		return metadataKey, err
@@ -142,14 +144,27 @@ func (s *SharedBlobStore) MarkBlobConfirmed(ctx context.Context, existingMetadat
 	}
 	newMetadata.BlobStatus = disperser.Confirmed
 	newMetadata.ConfirmationInfo = confirmationInfo
-	return &newMetadata, s.blobMetadataStore.UpdateBlobMetadata(ctx, existingMetadata.GetBlobKey(), &newMetadata)
+	return s.updateProcessingBlobMetadata(ctx, existingMetadata.GetBlobKey(), &newMetadata)
 }
 
 func (s *SharedBlobStore) MarkBlobInsufficientSignatures(ctx context.Context, existingMetadata *disperser.BlobMetadata, confirmationInfo *disperser.ConfirmationInfo) (*disperser.BlobMetadata, error) {
 	newMetadata := *existingMetadata
 	newMetadata.BlobStatus = disperser.InsufficientSignatures
 	newMetadata.ConfirmationInfo = confirmationInfo
-	return &newMetadata, s.blobMetadataStore.UpdateBlobMetadata(ctx, existingMetadata.GetBlobKey(), &newMetadata)
+	return s.updateProcessingBlobMetadata(ctx, existingMetadata.GetBlobKey(), &newMetadata)
+}
+
+// updateProcessingBlobMetadata writes the updated metadata of a blob that is still processing, so that a blob that
+// was cancelled or failed in the meantime isn't overwritten
+func (s *SharedBlobStore) updateProcessingBlobMetadata(ctx context.Context, metadataKey disperser.BlobKey, updated *disperser.BlobMetadata) (*disperser.BlobMetadata, error) {
+	err := s.blobMetadataStore.CompareAndUpdateBlobMetadata(ctx, metadataKey, disperser.Processing, updated)
+	if errors.Is(err, commondynamodb.ErrConditionFailed) {
+		return nil, disperser.ErrBlobNotProcessing
+	}
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
 }
 
 func (s *SharedBlobStore) MarkBlobFinalized(ctx context.Context, metadataKey disperser.BlobKey) error {
@@ -157,15 +172,109 @@ func (s *SharedBlobStore) MarkBlobFinalized(ctx context.Context, metadataKey dis
 }
 
 func (s *SharedBlobStore) MarkBlobProcessing(ctx context.Context, metadataKey disperser.BlobKey) error {
-	return s.blobMetadataStore.SetBlobStatus(ctx, metadataKey, disperser.Processing)
+	err := s.blobMetadataStore.SetBlobProcessing(ctx, metadataKey)
+	if errors.Is(err, commondynamodb.ErrConditionFailed) {
+		return disperser.ErrBlobCancelled
+	}
+	return err
+}
+
+func (s *SharedBlobStore) MarkBlobFailed(ctx context.Context, metadataKey disperser.BlobKey, failure *disperser.BlobFailure) error {
+	err := s.blobMetadataStore.SetBlobFailed(ctx, metadataKey, failure)
+	if errors.Is(err, commondynamodb.ErrConditionFailed) {
+		return disperser.ErrBlobNotProcessing
+	}
+	return err
+}
+
+func (s *SharedBlobStore) MarkBlobCancelled(ctx context.Context, metadataKey disperser.BlobKey) error {
+	err := s.blobMetadataStore.CompareAndSetBlobStatus(ctx, metadataKey, disperser.Processing, disperser.Cancelled)
+	if errors.Is(err, commondynamodb.ErrConditionFailed) {
+		return disperser.ErrBlobNotProcessing
+	}
+	return err
+}
+
+func (s *SharedBlobStore) IncrementBlobRetryCount(ctx context.Context, existingMetadata *disperser.BlobMetadata, failure *disperser.BlobFailure, notBefore uint64) error {
+	err := s.blobMetadataStore.IncrementNumRetries(ctx, existingMetadata, failure, notBefore)
+	if errors.Is(err, commondynamodb.ErrConditionFailed) {
+		return disperser.ErrBlobNotProcessing
+	}
+	return err
+}
+
+func (s *SharedBlobStore) RecordBlobFailure(ctx context.Context, metadataKey disperser.BlobKey, failure *disperser.BlobFailure) error {
+	return s.blobMetadataStore.AddBlobFailure(ctx, metadataKey, failure, nil)
+}
+
+func (s *SharedBlobStore) RequeueBlob(ctx context.Context, metadataKey disperser.BlobKey) error {
+	err := s.blobMetadataStore.RequeueFailedBlob(ctx, metadataKey)
+	if errors.Is(err, commondynamodb.ErrConditionFailed) {
+		return disperser.ErrBlobNotFailed
+	}
+	return err
+}
+
+// SetPendingBatch stores the pending batch as a single S3 object, so that recording it takes one write however many
+// blobs the batch has
+func (s *SharedBlobStore) SetPendingBatch(ctx context.Context, pendingBatch *disperser.PendingBatch) error {
+	data, err := json.Marshal(pendingBatch)
+	if err != nil {
+		return err
+	}
+	return s.s3Client.UploadObject(ctx, s.bucketName, pendingBatchObjectKey(pendingBatch.BatchHeaderHash), data)
 }
 
-func (s *SharedBlobStore) MarkBlobFailed(ctx context.Context, metadataKey disperser.BlobKey) error {
-	return s.blobMetadataStore.SetBlobStatus(ctx, metadataKey, disperser.Failed)
+func (s *SharedBlobStore) GetPendingBatches(ctx context.Context) ([]*disperser.PendingBatch, error) {
+	objects, err := s.s3Client.ListObjects(ctx, s.bucketName, pendingBatchPrefix)
+	if err != nil {
+		return nil, err
+	}
+	pendingBatches := make([]*disperser.PendingBatch, 0, len(objects))
+	for _, object := range objects {
+		data, err := s.s3Client.DownloadObject(ctx, s.bucketName, object.Key)
+		if errors.Is(err, s3.ErrObjectNotFound) {
+			// The batch was removed since it was listed
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		pendingBatch := &disperser.PendingBatch{}
+		if err := json.Unmarshal(data, pendingBatch); err != nil {
+			return nil, fmt.Errorf("failed to decode pending batch %s: %w", object.Key, err)
+		}
+		pendingBatches = append(pendingBatches, pendingBatch)
+	}
+	return pendingBatches, nil
+}
+
+func (s *SharedBlobStore) RemovePendingBatch(ctx context.Context, batchHeaderHash [32]byte) error {
+	return s.s3Client.DeleteObject(ctx, s.bucketName, pendingBatchObjectKey(batchHeaderHash))
+}
+
+func (s *SharedBlobStore) RequeueConfirmedBlob(ctx context.Context, metadataKey disperser.BlobKey) error {
+	err := s.blobMetadataStore.RequeueConfirmedBlob(ctx, metadataKey)
+	if errors.Is(err, commondynamodb.ErrConditionFailed) {
+		return disperser.ErrBlobNotConfirmed
+	}
+	return err
+}
+
+func (s *SharedBlobStore) MarkBlobNotified(ctx context.Context, metadataKey disperser.BlobKey, status disperser.BlobStatus) error {
+	err := s.blobMetadataStore.SetNotifiedStatus(ctx, metadataKey, status)
+	if errors.Is(err, commondynamodb.ErrConditionFailed) {
+		return disperser.ErrBlobNotFound
+	}
+	return err
 }
 
-func (s *SharedBlobStore) IncrementBlobRetryCount(ctx context.Context, existingMetadata *disperser.BlobMetadata) error {
-	return s.blobMetadataStore.IncrementNumRetries(ctx, existingMetadata)
+func (s *SharedBlobStore) MarkBlobNotificationAbandoned(ctx context.Context, metadataKey disperser.BlobKey, status disperser.BlobStatus) error {
+	err := s.blobMetadataStore.SetAbandonedNotificationStatus(ctx, metadataKey, status)
+	if errors.Is(err, commondynamodb.ErrConditionFailed) {
+		return disperser.ErrBlobNotFound
+	}
+	return err
 }
 
 func (s *SharedBlobStore) GetBlobsByMetadata(ctx context.Context, metadata []*disperser.BlobMetadata) (map[disperser.BlobKey]*core.Blob, error) {
@@ -203,6 +312,10 @@ func (s *SharedBlobStore) GetBlobMetadataByStatus(ctx context.Context, blobStatu
 	return s.blobMetadataStore.GetBlobMetadataByStatus(ctx, blobStatus)
 }
 
+func (s *SharedBlobStore) GetBlobMetadataByStatusWithPagination(ctx context.Context, blobStatus disperser.BlobStatus, limit int32, exclusiveStartKey *disperser.BlobStoreExclusiveStartKey) ([]*disperser.BlobMetadata, *disperser.BlobStoreExclusiveStartKey, error) {
+	return s.blobMetadataStore.GetBlobMetadataByStatusWithPagination(ctx, blobStatus, limit, exclusiveStartKey)
+}
+
 func (s *SharedBlobStore) GetMetadataInBatch(ctx context.Context, batchHeaderHash [32]byte, blobIndex uint32) (*disperser.BlobMetadata, error) {
 	return s.blobMetadataStore.GetBlobMetadataInBatch(ctx, batchHeaderHash, blobIndex)
 }
@@ -211,12 +324,23 @@ func (s *SharedBlobStore) GetAllBlobMetadataByBatch(ctx context.Context, batchHe
 	return s.blobMetadataStore.GetAllBlobMetadataByBatch(ctx, batchHeaderHash)
 }
 
+// GetAllBlobMetadataByContent returns the metadata of all the dispersal requests for a blob with the given content
+func (s *SharedBlobStore) GetAllBlobMetadataByContent(ctx context.Context, data []byte) ([]*disperser.BlobMetadata, error) {
+	return s.blobMetadataStore.GetAllBlobMetadataByBlobHash(ctx, GetBlobHash(&core.Blob{Data: data}))
+}
+
 // GetMetadata returns a blob metadata given a metadata key
 func (s *SharedBlobStore) GetBlobMetadata(ctx context.Context, metadataKey disperser.BlobKey) (*disperser.BlobMetadata, error) {
 	return s.blobMetadataStore.GetBlobMetadata(ctx, metadataKey)
 }
 
-func getMetadataHash(requestedAt uint64, securityParams []*core.SecurityParam) (string, error) {
+// GetBulkBlobMetadata returns the metadata of the blobs with the given keys, leaving out the ones that aren't found
+func (s *SharedBlobStore) GetBulkBlobMetadata(ctx context.Context, metadataKeys []disperser.BlobKey) ([]*disperser.BlobMetadata, error) {
+	return s.blobMetadataStore.GetBulkBlobMetadata(ctx, metadataKeys)
+}
+
+// GetMetadataHash returns the hash that distinguishes the requests for the same blob
+func GetMetadataHash(requestedAt uint64, securityParams []*core.SecurityParam) (string, error) {
 	var str string
 	str = fmt.Sprintf("%d/", requestedAt)
 	for _, param := range securityParams {
@@ -232,7 +356,14 @@ func blobObjectKey(blobHash disperser.BlobHash) string {
 	return fmt.Sprintf("blob/%s.json", blobHash)
 }
 
-func getBlobHash(blob *core.Blob) disperser.BlobHash {
+const pendingBatchPrefix = "pending_batch/"
+
+func pendingBatchObjectKey(batchHeaderHash [32]byte) string {
+	return fmt.Sprintf("%s%s.json", pendingBatchPrefix, hex.EncodeToString(batchHeaderHash[:]))
+}
+
+// GetBlobHash returns the hash of the content of the blob
+func GetBlobHash(blob *core.Blob) disperser.BlobHash {
 	hasher := sha256.New()
 	hasher.Write(blob.Data)
 	hash := hasher.Sum(nil)
@@ -10,6 +10,7 @@ import (
 
 	"github.com/Layr-Labs/eigenda/core"
 	"github.com/Layr-Labs/eigenda/disperser"
+	"github.com/Layr-Labs/eigenda/disperser/common/blobstoretest"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -36,33 +37,48 @@ func TestSharedBlobStore(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, blob.Data, data)
 
-	err = sharedStorage.MarkBlobFailed(ctx, blobKey)
+	failure := &disperser.BlobFailure{Stage: disperser.ConfirmationStage, Error: "test failure", Attempt: 0, Timestamp: 1}
+	err = sharedStorage.MarkBlobFailed(ctx, blobKey, failure)
 	assert.Nil(t, err)
 
 	metadata1, err := sharedStorage.GetBlobMetadata(ctx, blobKey)
 	assert.Nil(t, err)
 	assertMetadata(t, blobKey, blobSize, requestedAt, disperser.Failed, metadata1)
+	assert.Equal(t, []*disperser.BlobFailure{failure}, metadata1.FailureHistory)
 
-	err = sharedStorage.MarkBlobProcessing(ctx, blobKey)
+	err = sharedStorage.RequeueBlob(ctx, blobKey)
 	assert.Nil(t, err)
+	err = sharedStorage.RequeueBlob(ctx, blobKey)
+	assert.ErrorIs(t, err, disperser.ErrBlobNotFailed)
+
+	metadata1, err = sharedStorage.GetBlobMetadata(ctx, blobKey)
+	assert.Nil(t, err)
+	assertMetadata(t, blobKey, blobSize, requestedAt, disperser.Processing, metadata1)
+	assert.Equal(t, []*disperser.BlobFailure{failure}, metadata1.FailureHistory)
 
 	metadata1, err = sharedStorage.GetBlobMetadata(ctx, blobKey)
 	assert.Nil(t, err)
 	assertMetadata(t, blobKey, blobSize, requestedAt, disperser.Processing, metadata1)
 
-	err = sharedStorage.IncrementBlobRetryCount(ctx, metadata1)
+	err = sharedStorage.IncrementBlobRetryCount(ctx, metadata1, failure, 0)
 	assert.Nil(t, err)
 	metadata1, err = sharedStorage.GetBlobMetadata(ctx, blobKey)
 	fmt.Println("Num Retries", metadata1.NumRetries)
 	assert.Nil(t, err)
 	assert.Equal(t, uint(1), metadata1.NumRetries)
 
-	err = sharedStorage.IncrementBlobRetryCount(ctx, metadata1)
+	err = sharedStorage.RecordBlobFailure(ctx, blobKey, failure)
+	assert.Nil(t, err)
+	notBefore := uint64(time.Now().Add(time.Minute).UnixNano())
+	err = sharedStorage.IncrementBlobRetryCount(ctx, metadata1, failure, notBefore)
 	assert.Nil(t, err)
 	metadata1, err = sharedStorage.GetBlobMetadata(ctx, blobKey)
 	fmt.Println("Num Retries", metadata1.NumRetries)
 	assert.Nil(t, err)
 	assert.Equal(t, uint(2), metadata1.NumRetries)
+	assert.Equal(t, notBefore, metadata1.NotBefore)
+	assert.False(t, metadata1.IsEligibleForDispersal(time.Now()))
+	assert.Len(t, metadata1.FailureHistory, 4)
 
 	batchHeaderHash := [32]byte{1, 2, 3}
 	blobIndex := uint32(0)
@@ -166,6 +182,40 @@ func TestSharedBlobStore(t *testing.T) {
 	assertMetadata(t, blobKey2, blobSize2, requestedAt, disperser.InsufficientSignatures, blob2Metadata)
 }
 
+func TestSharedBlobStoreMarkBlobCancelled(t *testing.T) {
+	ctx := context.Background()
+	blobKey, err := sharedStorage.StoreBlob(ctx, blob, uint64(time.Now().UnixNano()))
+	assert.Nil(t, err)
+
+	err = sharedStorage.MarkBlobCancelled(ctx, blobKey)
+	assert.Nil(t, err)
+	metadata, err := sharedStorage.GetBlobMetadata(ctx, blobKey)
+	assert.Nil(t, err)
+	assert.Equal(t, disperser.Cancelled, metadata.BlobStatus)
+
+	// Only processing blobs can be cancelled
+	err = sharedStorage.MarkBlobCancelled(ctx, blobKey)
+	assert.ErrorIs(t, err, disperser.ErrBlobNotProcessing)
+
+	// A cancelled blob isn't moved to another status by the batcher
+	err = sharedStorage.MarkBlobProcessing(ctx, blobKey)
+	assert.ErrorIs(t, err, disperser.ErrBlobCancelled)
+	err = sharedStorage.MarkBlobFailed(ctx, blobKey, &disperser.BlobFailure{Stage: disperser.ConfirmationStage, Error: "test failure"})
+	assert.ErrorIs(t, err, disperser.ErrBlobNotProcessing)
+	err = sharedStorage.IncrementBlobRetryCount(ctx, metadata, &disperser.BlobFailure{Stage: disperser.ConfirmationStage, Error: "test failure"}, 0)
+	assert.ErrorIs(t, err, disperser.ErrBlobNotProcessing)
+	_, err = sharedStorage.MarkBlobConfirmed(ctx, metadata, &disperser.ConfirmationInfo{})
+	assert.ErrorIs(t, err, disperser.ErrBlobNotProcessing)
+	metadata, err = sharedStorage.GetBlobMetadata(ctx, blobKey)
+	assert.Nil(t, err)
+	assert.Equal(t, disperser.Cancelled, metadata.BlobStatus)
+	assert.Equal(t, uint(0), metadata.NumRetries)
+}
+
+func TestSharedBlobStoreBehavior(t *testing.T) {
+	blobstoretest.RunBlobStoreTests(t, sharedStorage)
+}
+
 func assertMetadata(t *testing.T, blobKey disperser.BlobKey, expectedBlobSize uint, expectedRequestedAt uint64, expectedStatus disperser.BlobStatus, actualMetadata *disperser.BlobMetadata) {
 	assert.NotNil(t, actualMetadata)
 	assert.Equal(t, expectedStatus, actualMetadata.BlobStatus)
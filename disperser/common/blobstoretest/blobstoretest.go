@@ -0,0 +1,508 @@
+// Package blobstoretest checks that implementations of disperser.BlobStore behave the same way.
+package blobstoretest
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/Layr-Labs/eigenda/core"
+	"github.com/Layr-Labs/eigenda/disperser"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// RunBlobStoreTests runs the behavior tests of disperser.BlobStore against blobStore.
+// The tests store blobs with random content, so the store doesn't have to be empty.
+func RunBlobStoreTests(t *testing.T, blobStore disperser.BlobStore) {
+	t.Run("StoreBlob", func(t *testing.T) { testStoreBlob(t, blobStore) })
+	t.Run("BlobLifecycle", func(t *testing.T) { testBlobLifecycle(t, blobStore) })
+	t.Run("Batch", func(t *testing.T) { testBatch(t, blobStore) })
+	t.Run("CancelBlob", func(t *testing.T) { testCancelBlob(t, blobStore) })
+	t.Run("FailureHistory", func(t *testing.T) { testFailureHistory(t, blobStore) })
+	t.Run("Pagination", func(t *testing.T) { testPagination(t, blobStore) })
+	t.Run("RequeueConfirmedBlob", func(t *testing.T) { testRequeueConfirmedBlob(t, blobStore) })
+	t.Run("PendingBatch", func(t *testing.T) { testPendingBatch(t, blobStore) })
+}
+
+var securityParams = []*core.SecurityParam{
+	{
+		QuorumID:           0,
+		AdversaryThreshold: 80,
+		QuorumThreshold:    100,
+	},
+	{
+		QuorumID:           1,
+		AdversaryThreshold: 50,
+		QuorumThreshold:    90,
+	},
+}
+
+func makeBlob(t *testing.T) *core.Blob {
+	data := make([]byte, 256)
+	_, err := rand.Read(data)
+	require.NoError(t, err)
+	return &core.Blob{
+		RequestHeader: core.BlobRequestHeader{
+			SecurityParams: securityParams,
+			AccountID:      "account",
+			CallbackURL:    "https://example.com/callback",
+		},
+		Data: data,
+	}
+}
+
+func storeBlob(t *testing.T, blobStore disperser.BlobStore, blob *core.Blob, requestedAt uint64) (disperser.BlobKey, *disperser.BlobMetadata) {
+	ctx := context.Background()
+	blobKey, err := blobStore.StoreBlob(ctx, blob, requestedAt)
+	require.NoError(t, err)
+	metadata, err := blobStore.GetBlobMetadata(ctx, blobKey)
+	require.NoError(t, err)
+	return blobKey, metadata
+}
+
+func makeConfirmationInfo(batchHeaderHash [32]byte, blobIndex uint32) *disperser.ConfirmationInfo {
+	return &disperser.ConfirmationInfo{
+		BatchHeaderHash:         batchHeaderHash,
+		BlobIndex:               blobIndex,
+		BlobCount:               2,
+		SignatoryRecordHash:     [32]byte{1},
+		ReferenceBlockNumber:    132,
+		BatchRoot:               []byte("root"),
+		BlobInclusionProof:      []byte("proof"),
+		BlobCommitment:          &core.BlobCommitments{Length: 8},
+		BatchID:                 99,
+		ConfirmationTxnHash:     common.HexToHash("0x123"),
+		ConfirmationBlockNumber: 150,
+		Fee:                     []byte{0},
+	}
+}
+
+// hasBlob returns whether the blob is in the list of metadata
+func hasBlob(metadatas []*disperser.BlobMetadata, blobKey disperser.BlobKey) bool {
+	for _, metadata := range metadatas {
+		if metadata.GetBlobKey() == blobKey {
+			return true
+		}
+	}
+	return false
+}
+
+func testStoreBlob(t *testing.T, blobStore disperser.BlobStore) {
+	ctx := context.Background()
+	blob := makeBlob(t)
+	requestedAt := uint64(time.Now().UnixNano())
+	blobKey, metadata := storeBlob(t, blobStore, blob, requestedAt)
+
+	assert.Equal(t, blobKey, metadata.GetBlobKey())
+	assert.Equal(t, disperser.Processing, metadata.BlobStatus)
+	assert.Equal(t, uint(0), metadata.NumRetries)
+	assert.Empty(t, metadata.FailureHistory)
+	assert.Nil(t, metadata.ConfirmationInfo)
+	assert.Equal(t, blob.RequestHeader, metadata.RequestMetadata.BlobRequestHeader)
+	assert.Equal(t, uint(len(blob.Data)), metadata.RequestMetadata.BlobSize)
+	assert.Equal(t, requestedAt, metadata.RequestMetadata.RequestedAt)
+
+	data, err := blobStore.GetBlobContent(ctx, blobKey.BlobHash)
+	assert.NoError(t, err)
+	assert.Equal(t, blob.Data, data)
+
+	processing, err := blobStore.GetBlobMetadataByStatus(ctx, disperser.Processing)
+	assert.NoError(t, err)
+	assert.True(t, hasBlob(processing, blobKey))
+
+	blobs, err := blobStore.GetBlobsByMetadata(ctx, []*disperser.BlobMetadata{metadata})
+	assert.NoError(t, err)
+	assert.Len(t, blobs, 1)
+	assert.Equal(t, blob.Data, blobs[blobKey].Data)
+	assert.Equal(t, blob.RequestHeader, blobs[blobKey].RequestHeader)
+
+	// The same content requested again is a different blob
+	blobKey2, _ := storeBlob(t, blobStore, blob, requestedAt+1)
+	assert.NotEqual(t, blobKey, blobKey2)
+	byContent, err := blobStore.GetAllBlobMetadataByContent(ctx, blob.Data)
+	assert.NoError(t, err)
+	assert.Len(t, byContent, 2)
+	assert.True(t, hasBlob(byContent, blobKey))
+	assert.True(t, hasBlob(byContent, blobKey2))
+
+	// The blobs that aren't found are left out of a bulk read
+	missingKey := disperser.BlobKey{BlobHash: blobKey.BlobHash, MetadataHash: blobKey.BlobHash}
+	bulk, err := blobStore.GetBulkBlobMetadata(ctx, []disperser.BlobKey{blobKey, missingKey, blobKey2})
+	assert.NoError(t, err)
+	assert.Len(t, bulk, 2)
+	assert.True(t, hasBlob(bulk, blobKey))
+	assert.True(t, hasBlob(bulk, blobKey2))
+}
+
+func testBlobLifecycle(t *testing.T, blobStore disperser.BlobStore) {
+	ctx := context.Background()
+	blobKey, metadata := storeBlob(t, blobStore, makeBlob(t), uint64(time.Now().UnixNano()))
+
+	// Retry the blob after a failed attempt
+	failure := &disperser.BlobFailure{Stage: disperser.DispersalStage, Error: "dispersal failure", Attempt: 0, Timestamp: 1}
+	notBefore := uint64(time.Now().Add(time.Minute).UnixNano())
+	err := blobStore.IncrementBlobRetryCount(ctx, metadata, failure, notBefore)
+	assert.NoError(t, err)
+	metadata, err = blobStore.GetBlobMetadata(ctx, blobKey)
+	assert.NoError(t, err)
+	assert.Equal(t, disperser.Processing, metadata.BlobStatus)
+	assert.Equal(t, uint(1), metadata.NumRetries)
+	assert.Equal(t, notBefore, metadata.NotBefore)
+	assert.Equal(t, []*disperser.BlobFailure{failure}, metadata.FailureHistory)
+
+	// Failures that don't count towards the retries keep the status and retry count
+	encodingFailure := &disperser.BlobFailure{Stage: disperser.EncodingStage, Error: "encoding failure", Attempt: 1, Timestamp: 2}
+	err = blobStore.RecordBlobFailure(ctx, blobKey, encodingFailure)
+	assert.NoError(t, err)
+	metadata, err = blobStore.GetBlobMetadata(ctx, blobKey)
+	assert.NoError(t, err)
+	assert.Equal(t, disperser.Processing, metadata.BlobStatus)
+	assert.Equal(t, uint(1), metadata.NumRetries)
+	assert.Equal(t, []*disperser.BlobFailure{failure, encodingFailure}, metadata.FailureHistory)
+
+	// Fail the blob
+	finalFailure := &disperser.BlobFailure{Stage: disperser.ConfirmationStage, Error: "confirmation failure", Attempt: 1, Timestamp: 3}
+	err = blobStore.MarkBlobFailed(ctx, blobKey, finalFailure)
+	assert.NoError(t, err)
+	metadata, err = blobStore.GetBlobMetadata(ctx, blobKey)
+	assert.NoError(t, err)
+	assert.Equal(t, disperser.Failed, metadata.BlobStatus)
+	assert.Equal(t, finalFailure, metadata.LastFailure())
+	assert.True(t, metadata.HasPendingNotification())
+
+	err = blobStore.MarkBlobNotificationAbandoned(ctx, blobKey, disperser.Failed)
+	assert.NoError(t, err)
+	metadata, err = blobStore.GetBlobMetadata(ctx, blobKey)
+	assert.NoError(t, err)
+	assert.Equal(t, disperser.Failed, metadata.AbandonedNotificationStatus)
+	assert.Equal(t, disperser.Processing, metadata.NotifiedStatus)
+	assert.False(t, metadata.HasPendingNotification())
+
+	failed, err := blobStore.GetBlobMetadataByStatus(ctx, disperser.Failed)
+	assert.NoError(t, err)
+	assert.True(t, hasBlob(failed, blobKey))
+	processing, err := blobStore.GetBlobMetadataByStatus(ctx, disperser.Processing)
+	assert.NoError(t, err)
+	assert.False(t, hasBlob(processing, blobKey))
+
+	err = blobStore.MarkBlobNotified(ctx, blobKey, disperser.Failed)
+	assert.NoError(t, err)
+	metadata, err = blobStore.GetBlobMetadata(ctx, blobKey)
+	assert.NoError(t, err)
+	assert.Equal(t, disperser.Failed, metadata.NotifiedStatus)
+	assert.False(t, metadata.HasPendingNotification())
+
+	// Requeue the failed blob
+	err = blobStore.RequeueBlob(ctx, blobKey)
+	assert.NoError(t, err)
+	err = blobStore.RequeueBlob(ctx, blobKey)
+	assert.ErrorIs(t, err, disperser.ErrBlobNotFailed)
+	metadata, err = blobStore.GetBlobMetadata(ctx, blobKey)
+	assert.NoError(t, err)
+	assert.Equal(t, disperser.Processing, metadata.BlobStatus)
+	assert.Equal(t, uint(0), metadata.NumRetries)
+	assert.Equal(t, uint64(0), metadata.NotBefore)
+	assert.Equal(t, disperser.Processing, metadata.NotifiedStatus)
+	assert.Equal(t, disperser.Processing, metadata.AbandonedNotificationStatus)
+	assert.Len(t, metadata.FailureHistory, 3)
+
+	processing, err = blobStore.GetBlobMetadataByStatus(ctx, disperser.Processing)
+	assert.NoError(t, err)
+	assert.True(t, hasBlob(processing, blobKey))
+	failed, err = blobStore.GetBlobMetadataByStatus(ctx, disperser.Failed)
+	assert.NoError(t, err)
+	assert.False(t, hasBlob(failed, blobKey))
+}
+
+func testBatch(t *testing.T, blobStore disperser.BlobStore) {
+	ctx := context.Background()
+	requestedAt := uint64(time.Now().UnixNano())
+	blobKey1, metadata1 := storeBlob(t, blobStore, makeBlob(t), requestedAt)
+	blobKey2, metadata2 := storeBlob(t, blobStore, makeBlob(t), requestedAt)
+
+	var batchHeaderHash [32]byte
+	_, err := rand.Read(batchHeaderHash[:])
+	require.NoError(t, err)
+
+	confirmationInfo1 := makeConfirmationInfo(batchHeaderHash, 0)
+	confirmed, err := blobStore.MarkBlobConfirmed(ctx, metadata1, confirmationInfo1)
+	assert.NoError(t, err)
+	assert.Equal(t, disperser.Confirmed, confirmed.BlobStatus)
+	assert.Equal(t, confirmationInfo1, confirmed.ConfirmationInfo)
+
+	confirmationInfo2 := makeConfirmationInfo(batchHeaderHash, 1)
+	insufficient, err := blobStore.MarkBlobInsufficientSignatures(ctx, metadata2, confirmationInfo2)
+	assert.NoError(t, err)
+	assert.Equal(t, disperser.InsufficientSignatures, insufficient.BlobStatus)
+
+	metadata, err := blobStore.GetMetadataInBatch(ctx, batchHeaderHash, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, blobKey1, metadata.GetBlobKey())
+	assert.Equal(t, disperser.Confirmed, metadata.BlobStatus)
+	assert.Equal(t, confirmationInfo1, metadata.ConfirmationInfo)
+	metadata, err = blobStore.GetMetadataInBatch(ctx, batchHeaderHash, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, blobKey2, metadata.GetBlobKey())
+	assert.Equal(t, disperser.InsufficientSignatures, metadata.BlobStatus)
+	_, err = blobStore.GetMetadataInBatch(ctx, batchHeaderHash, 2)
+	assert.Error(t, err)
+
+	confirmedBlobs, err := blobStore.GetBlobMetadataByStatus(ctx, disperser.Confirmed)
+	assert.NoError(t, err)
+	assert.True(t, hasBlob(confirmedBlobs, blobKey1))
+	processing, err := blobStore.GetBlobMetadataByStatus(ctx, disperser.Processing)
+	assert.NoError(t, err)
+	assert.False(t, hasBlob(processing, blobKey1))
+	assert.False(t, hasBlob(processing, blobKey2))
+
+	err = blobStore.MarkBlobFinalized(ctx, blobKey1)
+	assert.NoError(t, err)
+	metadata, err = blobStore.GetBlobMetadata(ctx, blobKey1)
+	assert.NoError(t, err)
+	assert.Equal(t, disperser.Finalized, metadata.BlobStatus)
+	assert.Equal(t, confirmationInfo1, metadata.ConfirmationInfo)
+	finalized, err := blobStore.GetBlobMetadataByStatus(ctx, disperser.Finalized)
+	assert.NoError(t, err)
+	assert.True(t, hasBlob(finalized, blobKey1))
+	confirmedBlobs, err = blobStore.GetBlobMetadataByStatus(ctx, disperser.Confirmed)
+	assert.NoError(t, err)
+	assert.False(t, hasBlob(confirmedBlobs, blobKey1))
+
+	inBatch, err := blobStore.GetAllBlobMetadataByBatch(ctx, batchHeaderHash)
+	assert.NoError(t, err)
+	assert.Len(t, inBatch, 2)
+	assert.True(t, hasBlob(inBatch, blobKey1))
+	assert.True(t, hasBlob(inBatch, blobKey2))
+
+	var otherBatchHeaderHash [32]byte
+	_, err = rand.Read(otherBatchHeaderHash[:])
+	require.NoError(t, err)
+	// Some stores return an error for an unknown batch rather than no blobs
+	inOtherBatch, err := blobStore.GetAllBlobMetadataByBatch(ctx, otherBatchHeaderHash)
+	if err == nil {
+		assert.Empty(t, inOtherBatch)
+	}
+}
+
+func testCancelBlob(t *testing.T, blobStore disperser.BlobStore) {
+	ctx := context.Background()
+	blobKey, _ := storeBlob(t, blobStore, makeBlob(t), uint64(time.Now().UnixNano()))
+
+	err := blobStore.MarkBlobCancelled(ctx, blobKey)
+	assert.NoError(t, err)
+	metadata, err := blobStore.GetBlobMetadata(ctx, blobKey)
+	assert.NoError(t, err)
+	assert.Equal(t, disperser.Cancelled, metadata.BlobStatus)
+	cancelled, err := blobStore.GetBlobMetadataByStatus(ctx, disperser.Cancelled)
+	assert.NoError(t, err)
+	assert.True(t, hasBlob(cancelled, blobKey))
+
+	// Only processing blobs can be cancelled
+	err = blobStore.MarkBlobCancelled(ctx, blobKey)
+	assert.ErrorIs(t, err, disperser.ErrBlobNotProcessing)
+
+	// The writes of the batcher don't overwrite the cancellation
+	failure := &disperser.BlobFailure{Stage: disperser.DispersalStage, Error: "timeout"}
+	err = blobStore.IncrementBlobRetryCount(ctx, metadata, failure, 0)
+	assert.ErrorIs(t, err, disperser.ErrBlobNotProcessing)
+	err = blobStore.MarkBlobFailed(ctx, blobKey, failure)
+	assert.ErrorIs(t, err, disperser.ErrBlobNotProcessing)
+	_, err = blobStore.MarkBlobConfirmed(ctx, metadata, makeConfirmationInfo([32]byte{1}, 0))
+	assert.ErrorIs(t, err, disperser.ErrBlobNotProcessing)
+	_, err = blobStore.MarkBlobInsufficientSignatures(ctx, metadata, makeConfirmationInfo([32]byte{1}, 0))
+	assert.ErrorIs(t, err, disperser.ErrBlobNotProcessing)
+	err = blobStore.MarkBlobProcessing(ctx, blobKey)
+	assert.ErrorIs(t, err, disperser.ErrBlobCancelled)
+	metadata, err = blobStore.GetBlobMetadata(ctx, blobKey)
+	assert.NoError(t, err)
+	assert.Equal(t, disperser.Cancelled, metadata.BlobStatus)
+	assert.Equal(t, uint(0), metadata.NumRetries)
+	assert.Empty(t, metadata.FailureHistory)
+	assert.Nil(t, metadata.ConfirmationInfo)
+
+	otherBlobKey, _ := storeBlob(t, blobStore, makeBlob(t), uint64(time.Now().UnixNano()))
+	err = blobStore.MarkBlobFailed(ctx, otherBlobKey, &disperser.BlobFailure{Stage: disperser.AdminStage, Error: "failed"})
+	assert.NoError(t, err)
+	err = blobStore.MarkBlobCancelled(ctx, otherBlobKey)
+	assert.ErrorIs(t, err, disperser.ErrBlobNotProcessing)
+}
+
+func testFailureHistory(t *testing.T, blobStore disperser.BlobStore) {
+	ctx := context.Background()
+	blobKey, _ := storeBlob(t, blobStore, makeBlob(t), uint64(time.Now().UnixNano()))
+
+	for i := 0; i < disperser.MaxFailureHistoryLength+2; i++ {
+		err := blobStore.RecordBlobFailure(ctx, blobKey, &disperser.BlobFailure{Stage: disperser.EncodingStage, Error: "encoding failure", Timestamp: uint64(i)})
+		assert.NoError(t, err)
+	}
+	metadata, err := blobStore.GetBlobMetadata(ctx, blobKey)
+	assert.NoError(t, err)
+	assert.Len(t, metadata.FailureHistory, disperser.MaxFailureHistoryLength)
+	assert.Equal(t, uint64(2), metadata.FailureHistory[0].Timestamp)
+	assert.Equal(t, uint64(disperser.MaxFailureHistoryLength+1), metadata.LastFailure().Timestamp)
+}
+
+func testPagination(t *testing.T, blobStore disperser.BlobStore) {
+	ctx := context.Background()
+	requestedAt := uint64(time.Now().UnixNano())
+	blobKeys := make([]disperser.BlobKey, 5)
+	for i := range blobKeys {
+		blobKeys[i], _ = storeBlob(t, blobStore, makeBlob(t), requestedAt+uint64(i))
+	}
+
+	limit := int32(2)
+	seen := make(map[disperser.BlobKey]bool)
+	fetched := make([]*disperser.BlobMetadata, 0)
+	var exclusiveStartKey *disperser.BlobStoreExclusiveStartKey
+	for {
+		var page []*disperser.BlobMetadata
+		var err error
+		page, exclusiveStartKey, err = blobStore.GetBlobMetadataByStatusWithPagination(ctx, disperser.Processing, limit, exclusiveStartKey)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, len(page), int(limit))
+		for _, metadata := range page {
+			assert.Equal(t, disperser.Processing, metadata.BlobStatus)
+			assert.False(t, seen[metadata.GetBlobKey()], "blob returned twice")
+			seen[metadata.GetBlobKey()] = true
+		}
+		fetched = append(fetched, page...)
+		if exclusiveStartKey == nil {
+			break
+		}
+	}
+
+	for _, blobKey := range blobKeys {
+		assert.True(t, hasBlob(fetched, blobKey))
+	}
+	for i := 1; i < len(fetched); i++ {
+		assert.LessOrEqual(t, fetched[i-1].RequestMetadata.RequestedAt, fetched[i].RequestMetadata.RequestedAt)
+	}
+
+	// Blobs in other status aren't returned
+	err := blobStore.MarkBlobCancelled(ctx, blobKeys[0])
+	require.NoError(t, err)
+	processing, _, err := blobStore.GetBlobMetadataByStatusWithPagination(ctx, disperser.Processing, 0, nil)
+	require.NoError(t, err)
+	assert.False(t, hasBlob(processing, blobKeys[0]))
+	cancelled, _, err := blobStore.GetBlobMetadataByStatusWithPagination(ctx, disperser.Cancelled, 0, nil)
+	require.NoError(t, err)
+	assert.True(t, hasBlob(cancelled, blobKeys[0]))
+}
+
+func testRequeueConfirmedBlob(t *testing.T, blobStore disperser.BlobStore) {
+	ctx := context.Background()
+	requestedAt := uint64(time.Now().UnixNano())
+	blobKey1, metadata1 := storeBlob(t, blobStore, makeBlob(t), requestedAt)
+	blobKey2, metadata2 := storeBlob(t, blobStore, makeBlob(t), requestedAt)
+
+	// Only confirmed blobs can be requeued
+	err := blobStore.RequeueConfirmedBlob(ctx, blobKey1)
+	assert.ErrorIs(t, err, disperser.ErrBlobNotConfirmed)
+
+	var batchHeaderHash [32]byte
+	_, err = rand.Read(batchHeaderHash[:])
+	require.NoError(t, err)
+	_, err = blobStore.MarkBlobConfirmed(ctx, metadata1, makeConfirmationInfo(batchHeaderHash, 0))
+	require.NoError(t, err)
+	_, err = blobStore.MarkBlobConfirmed(ctx, metadata2, makeConfirmationInfo(batchHeaderHash, 1))
+	require.NoError(t, err)
+	err = blobStore.MarkBlobNotified(ctx, blobKey1, disperser.Confirmed)
+	require.NoError(t, err)
+
+	err = blobStore.RequeueConfirmedBlob(ctx, blobKey1)
+	assert.NoError(t, err)
+	metadata, err := blobStore.GetBlobMetadata(ctx, blobKey1)
+	assert.NoError(t, err)
+	assert.Equal(t, disperser.Processing, metadata.BlobStatus)
+	assert.Nil(t, metadata.ConfirmationInfo)
+	assert.Equal(t, disperser.Processing, metadata.NotifiedStatus)
+
+	processing, err := blobStore.GetBlobMetadataByStatus(ctx, disperser.Processing)
+	assert.NoError(t, err)
+	assert.True(t, hasBlob(processing, blobKey1))
+	confirmedBlobs, err := blobStore.GetBlobMetadataByStatus(ctx, disperser.Confirmed)
+	assert.NoError(t, err)
+	assert.False(t, hasBlob(confirmedBlobs, blobKey1))
+	assert.True(t, hasBlob(confirmedBlobs, blobKey2))
+
+	// The blob is no longer part of the batch
+	inBatch, err := blobStore.GetAllBlobMetadataByBatch(ctx, batchHeaderHash)
+	assert.NoError(t, err)
+	assert.False(t, hasBlob(inBatch, blobKey1))
+	assert.True(t, hasBlob(inBatch, blobKey2))
+	_, err = blobStore.GetMetadataInBatch(ctx, batchHeaderHash, 0)
+	assert.Error(t, err)
+
+	err = blobStore.RequeueConfirmedBlob(ctx, blobKey1)
+	assert.ErrorIs(t, err, disperser.ErrBlobNotConfirmed)
+}
+
+func testPendingBatch(t *testing.T, blobStore disperser.BlobStore) {
+	ctx := context.Background()
+	blobKey1, _ := storeBlob(t, blobStore, makeBlob(t), uint64(time.Now().UnixNano()))
+	blobKey2, _ := storeBlob(t, blobStore, makeBlob(t), uint64(time.Now().UnixNano()))
+
+	var batchHeaderHash [32]byte
+	_, err := rand.Read(batchHeaderHash[:])
+	require.NoError(t, err)
+	pendingBatch := &disperser.PendingBatch{
+		BatchHeaderHash:      batchHeaderHash,
+		ReferenceBlockNumber: 132,
+		Blobs: []*disperser.PendingConfirmation{
+			{
+				BlobKey:          blobKey1,
+				Status:           disperser.Confirmed,
+				ConfirmationInfo: makeConfirmationInfo(batchHeaderHash, 0),
+			},
+			{
+				BlobKey:          blobKey2,
+				Status:           disperser.InsufficientSignatures,
+				ConfirmationInfo: makeConfirmationInfo(batchHeaderHash, 1),
+			},
+		},
+	}
+	err = blobStore.SetPendingBatch(ctx, pendingBatch)
+	assert.NoError(t, err)
+	pendingBatches, err := blobStore.GetPendingBatches(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, pendingBatch, findPendingBatch(pendingBatches, batchHeaderHash))
+
+	// The blobs are left as they are until the batch is confirmed
+	metadata, err := blobStore.GetBlobMetadata(ctx, blobKey1)
+	assert.NoError(t, err)
+	assert.Equal(t, disperser.Processing, metadata.BlobStatus)
+	assert.Nil(t, metadata.ConfirmationInfo)
+	_, err = blobStore.GetMetadataInBatch(ctx, batchHeaderHash, 0)
+	assert.Error(t, err)
+
+	// Recording the batch again replaces it
+	pendingBatch.Blobs = pendingBatch.Blobs[:1]
+	err = blobStore.SetPendingBatch(ctx, pendingBatch)
+	assert.NoError(t, err)
+	pendingBatches, err = blobStore.GetPendingBatches(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, pendingBatch, findPendingBatch(pendingBatches, batchHeaderHash))
+
+	err = blobStore.RemovePendingBatch(ctx, batchHeaderHash)
+	assert.NoError(t, err)
+	pendingBatches, err = blobStore.GetPendingBatches(ctx)
+	assert.NoError(t, err)
+	assert.Nil(t, findPendingBatch(pendingBatches, batchHeaderHash))
+
+	// Removing a batch that isn't recorded is a no-op
+	err = blobStore.RemovePendingBatch(ctx, batchHeaderHash)
+	assert.NoError(t, err)
+}
+
+// findPendingBatch returns the pending batch with the given header hash, or nil if there is none
+func findPendingBatch(pendingBatches []*disperser.PendingBatch, batchHeaderHash [32]byte) *disperser.PendingBatch {
+	for _, pendingBatch := range pendingBatches {
+		if pendingBatch.BatchHeaderHash == batchHeaderHash {
+			return pendingBatch
+		}
+	}
+	return nil
+}
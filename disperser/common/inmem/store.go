@@ -1,19 +1,24 @@
 package inmem
 
 import (
+	"bytes"
 	"context"
 	"crypto/rand"
 	"encoding/hex"
 	"strconv"
+	"sync"
 
 	"github.com/Layr-Labs/eigenda/core"
 	"github.com/Layr-Labs/eigenda/disperser"
 )
 
-// BlobStore is an in-memory implementation of the BlobStore interface
+// BlobStore is an in-memory implementation of the BlobStore interface that's safe for concurrent use
 type BlobStore struct {
-	Blobs    map[disperser.BlobHash]*BlobHolder
-	Metadata map[disperser.BlobKey]*disperser.BlobMetadata
+	mu sync.RWMutex
+
+	Blobs          map[disperser.BlobHash]*BlobHolder
+	Metadata       map[disperser.BlobKey]*disperser.BlobMetadata
+	PendingBatches map[[32]byte]*disperser.PendingBatch
 }
 
 // BlobHolder stores the blob along with its status and any other metadata
@@ -26,12 +31,16 @@ var _ disperser.BlobStore = (*BlobStore)(nil)
 // NewBlobStore creates an empty BlobStore
 func NewBlobStore() disperser.BlobStore {
 	return &BlobStore{
-		Blobs:    make(map[disperser.BlobHash]*BlobHolder),
-		Metadata: make(map[disperser.BlobKey]*disperser.BlobMetadata),
+		Blobs:          make(map[disperser.BlobHash]*BlobHolder),
+		Metadata:       make(map[disperser.BlobKey]*disperser.BlobMetadata),
+		PendingBatches: make(map[[32]byte]*disperser.PendingBatch),
 	}
 }
 
 func (q *BlobStore) StoreBlob(ctx context.Context, blob *core.Blob, requestedAt uint64) (disperser.BlobKey, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
 	blobKey := disperser.BlobKey{}
 	// Generate the blob key
 	blobHash, err := q.getNewBlobHash()
@@ -62,6 +71,9 @@ func (q *BlobStore) StoreBlob(ctx context.Context, blob *core.Blob, requestedAt
 }
 
 func (q *BlobStore) GetBlobContent(ctx context.Context, blobHash disperser.BlobHash) ([]byte, error) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
 	if holder, ok := q.Blobs[blobHash]; ok {
 		return holder.Data, nil
 	} else {
@@ -70,10 +82,17 @@ func (q *BlobStore) GetBlobContent(ctx context.Context, blobHash disperser.BlobH
 }
 
 func (q *BlobStore) MarkBlobConfirmed(ctx context.Context, existingMetadata *disperser.BlobMetadata, confirmationInfo *disperser.ConfirmationInfo) (*disperser.BlobMetadata, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
 	blobKey := existingMetadata.GetBlobKey()
-	if _, ok := q.Metadata[blobKey]; !ok {
+	metadata, ok := q.Metadata[blobKey]
+	if !ok {
 		return nil, disperser.ErrBlobNotFound
 	}
+	if metadata.BlobStatus != disperser.Processing {
+		return nil, disperser.ErrBlobNotProcessing
+	}
 	newMetadata := *existingMetadata
 	newMetadata.BlobStatus = disperser.Confirmed
 	newMetadata.ConfirmationInfo = confirmationInfo
@@ -82,10 +101,17 @@ func (q *BlobStore) MarkBlobConfirmed(ctx context.Context, existingMetadata *dis
 }
 
 func (q *BlobStore) MarkBlobInsufficientSignatures(ctx context.Context, existingMetadata *disperser.BlobMetadata, confirmationInfo *disperser.ConfirmationInfo) (*disperser.BlobMetadata, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
 	blobKey := existingMetadata.GetBlobKey()
-	if _, ok := q.Metadata[blobKey]; !ok {
+	metadata, ok := q.Metadata[blobKey]
+	if !ok {
 		return nil, disperser.ErrBlobNotFound
 	}
+	if metadata.BlobStatus != disperser.Processing {
+		return nil, disperser.ErrBlobNotProcessing
+	}
 	newMetadata := *existingMetadata
 	newMetadata.BlobStatus = disperser.InsufficientSignatures
 	newMetadata.ConfirmationInfo = confirmationInfo
@@ -94,6 +120,9 @@ func (q *BlobStore) MarkBlobInsufficientSignatures(ctx context.Context, existing
 }
 
 func (q *BlobStore) MarkBlobFinalized(ctx context.Context, blobKey disperser.BlobKey) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
 	if _, ok := q.Metadata[blobKey]; !ok {
 		return disperser.ErrBlobNotFound
 	}
@@ -103,33 +132,181 @@ func (q *BlobStore) MarkBlobFinalized(ctx context.Context, blobKey disperser.Blo
 }
 
 func (q *BlobStore) MarkBlobProcessing(ctx context.Context, blobKey disperser.BlobKey) error {
-	if _, ok := q.Metadata[blobKey]; !ok {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	metadata, ok := q.Metadata[blobKey]
+	if !ok {
 		return disperser.ErrBlobNotFound
 	}
+	if metadata.BlobStatus == disperser.Cancelled {
+		return disperser.ErrBlobCancelled
+	}
 
-	q.Metadata[blobKey].BlobStatus = disperser.Processing
+	metadata.BlobStatus = disperser.Processing
 	return nil
 }
 
-func (q *BlobStore) MarkBlobFailed(ctx context.Context, blobKey disperser.BlobKey) error {
-	if _, ok := q.Metadata[blobKey]; !ok {
+func (q *BlobStore) MarkBlobFailed(ctx context.Context, blobKey disperser.BlobKey, failure *disperser.BlobFailure) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	metadata, ok := q.Metadata[blobKey]
+	if !ok {
+		return disperser.ErrBlobNotFound
+	}
+	if metadata.BlobStatus != disperser.Processing {
+		return disperser.ErrBlobNotProcessing
+	}
+
+	metadata.BlobStatus = disperser.Failed
+	metadata.FailureHistory = disperser.AppendBlobFailure(metadata.FailureHistory, failure)
+	return nil
+}
+
+func (q *BlobStore) MarkBlobCancelled(ctx context.Context, blobKey disperser.BlobKey) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	metadata, ok := q.Metadata[blobKey]
+	if !ok {
+		return disperser.ErrBlobNotFound
+	}
+	if metadata.BlobStatus != disperser.Processing {
+		return disperser.ErrBlobNotProcessing
+	}
+
+	metadata.BlobStatus = disperser.Cancelled
+	return nil
+}
+
+func (q *BlobStore) IncrementBlobRetryCount(ctx context.Context, existingMetadata *disperser.BlobMetadata, failure *disperser.BlobFailure, notBefore uint64) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	metadata, ok := q.Metadata[existingMetadata.GetBlobKey()]
+	if !ok {
+		return disperser.ErrBlobNotFound
+	}
+	if metadata.BlobStatus != disperser.Processing {
+		return disperser.ErrBlobNotProcessing
+	}
+
+	metadata.NumRetries++
+	metadata.NotBefore = notBefore
+	metadata.FailureHistory = disperser.AppendBlobFailure(metadata.FailureHistory, failure)
+	return nil
+}
+
+func (q *BlobStore) RecordBlobFailure(ctx context.Context, blobKey disperser.BlobKey, failure *disperser.BlobFailure) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	metadata, ok := q.Metadata[blobKey]
+	if !ok {
+		return disperser.ErrBlobNotFound
+	}
+
+	metadata.FailureHistory = disperser.AppendBlobFailure(metadata.FailureHistory, failure)
+	return nil
+}
+
+func (q *BlobStore) RequeueBlob(ctx context.Context, blobKey disperser.BlobKey) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	metadata, ok := q.Metadata[blobKey]
+	if !ok {
+		return disperser.ErrBlobNotFound
+	}
+	if metadata.BlobStatus != disperser.Failed {
+		return disperser.ErrBlobNotFailed
+	}
+
+	metadata.BlobStatus = disperser.Processing
+	metadata.NumRetries = 0
+	metadata.NotBefore = 0
+	metadata.NotifiedStatus = disperser.Processing
+	metadata.AbandonedNotificationStatus = disperser.Processing
+	return nil
+}
+
+func (q *BlobStore) SetPendingBatch(ctx context.Context, pendingBatch *disperser.PendingBatch) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.PendingBatches[pendingBatch.BatchHeaderHash] = pendingBatch
+	return nil
+}
+
+func (q *BlobStore) GetPendingBatches(ctx context.Context) ([]*disperser.PendingBatch, error) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	pendingBatches := make([]*disperser.PendingBatch, 0, len(q.PendingBatches))
+	for _, pendingBatch := range q.PendingBatches {
+		pendingBatches = append(pendingBatches, pendingBatch)
+	}
+	return pendingBatches, nil
+}
+
+func (q *BlobStore) RemovePendingBatch(ctx context.Context, batchHeaderHash [32]byte) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	delete(q.PendingBatches, batchHeaderHash)
+	return nil
+}
+
+func (q *BlobStore) RequeueConfirmedBlob(ctx context.Context, blobKey disperser.BlobKey) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	metadata, ok := q.Metadata[blobKey]
+	if !ok {
+		return disperser.ErrBlobNotFound
+	}
+	if metadata.BlobStatus != disperser.Confirmed {
+		return disperser.ErrBlobNotConfirmed
+	}
+
+	metadata.BlobStatus = disperser.Processing
+	metadata.ConfirmationInfo = nil
+	metadata.NotifiedStatus = disperser.Processing
+	metadata.AbandonedNotificationStatus = disperser.Processing
+	return nil
+}
+
+func (q *BlobStore) MarkBlobNotified(ctx context.Context, blobKey disperser.BlobKey, status disperser.BlobStatus) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	metadata, ok := q.Metadata[blobKey]
+	if !ok {
 		return disperser.ErrBlobNotFound
 	}
 
-	q.Metadata[blobKey].BlobStatus = disperser.Failed
+	metadata.NotifiedStatus = status
 	return nil
 }
 
-func (q *BlobStore) IncrementBlobRetryCount(ctx context.Context, existingMetadata *disperser.BlobMetadata) error {
-	if _, ok := q.Metadata[existingMetadata.GetBlobKey()]; !ok {
+func (q *BlobStore) MarkBlobNotificationAbandoned(ctx context.Context, blobKey disperser.BlobKey, status disperser.BlobStatus) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	metadata, ok := q.Metadata[blobKey]
+	if !ok {
 		return disperser.ErrBlobNotFound
 	}
 
-	q.Metadata[existingMetadata.GetBlobKey()].NumRetries++
+	metadata.AbandonedNotificationStatus = status
 	return nil
 }
 
 func (q *BlobStore) GetBlobsByMetadata(ctx context.Context, metadata []*disperser.BlobMetadata) (map[disperser.BlobKey]*core.Blob, error) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
 	blobs := make(map[disperser.BlobKey]*core.Blob)
 	for _, meta := range metadata {
 		if holder, ok := q.Blobs[meta.BlobHash]; ok {
@@ -145,6 +322,9 @@ func (q *BlobStore) GetBlobsByMetadata(ctx context.Context, metadata []*disperse
 }
 
 func (q *BlobStore) GetBlobMetadataByStatus(ctx context.Context, status disperser.BlobStatus) ([]*disperser.BlobMetadata, error) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
 	metas := make([]*disperser.BlobMetadata, 0)
 	for _, meta := range q.Metadata {
 		if meta.BlobStatus == status {
@@ -154,7 +334,19 @@ func (q *BlobStore) GetBlobMetadataByStatus(ctx context.Context, status disperse
 	return metas, nil
 }
 
+func (q *BlobStore) GetBlobMetadataByStatusWithPagination(ctx context.Context, status disperser.BlobStatus, limit int32, exclusiveStartKey *disperser.BlobStoreExclusiveStartKey) ([]*disperser.BlobMetadata, *disperser.BlobStoreExclusiveStartKey, error) {
+	metas, err := q.GetBlobMetadataByStatus(ctx, status)
+	if err != nil {
+		return nil, nil, err
+	}
+	metas, lastEvaluatedKey := disperser.PaginateBlobMetadata(metas, limit, exclusiveStartKey)
+	return metas, lastEvaluatedKey, nil
+}
+
 func (q *BlobStore) GetMetadataInBatch(ctx context.Context, batchHeaderHash [32]byte, blobIndex uint32) (*disperser.BlobMetadata, error) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
 	for _, meta := range q.Metadata {
 		if meta.ConfirmationInfo != nil && meta.ConfirmationInfo.BatchHeaderHash == batchHeaderHash && meta.ConfirmationInfo.BlobIndex == blobIndex {
 			return meta, nil
@@ -165,6 +357,9 @@ func (q *BlobStore) GetMetadataInBatch(ctx context.Context, batchHeaderHash [32]
 }
 
 func (q *BlobStore) GetAllBlobMetadataByBatch(ctx context.Context, batchHeaderHash [32]byte) ([]*disperser.BlobMetadata, error) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
 	metas := make([]*disperser.BlobMetadata, 0)
 	for _, meta := range q.Metadata {
 		if meta.ConfirmationInfo != nil && meta.ConfirmationInfo.BatchHeaderHash == batchHeaderHash {
@@ -174,13 +369,42 @@ func (q *BlobStore) GetAllBlobMetadataByBatch(ctx context.Context, batchHeaderHa
 	return metas, nil
 }
 
+func (q *BlobStore) GetAllBlobMetadataByContent(ctx context.Context, data []byte) ([]*disperser.BlobMetadata, error) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	metas := make([]*disperser.BlobMetadata, 0)
+	for _, meta := range q.Metadata {
+		if holder, ok := q.Blobs[meta.BlobHash]; ok && bytes.Equal(holder.Data, data) {
+			metas = append(metas, meta)
+		}
+	}
+	return metas, nil
+}
+
 func (q *BlobStore) GetBlobMetadata(ctx context.Context, blobKey disperser.BlobKey) (*disperser.BlobMetadata, error) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
 	if meta, ok := q.Metadata[blobKey]; ok {
 		return meta, nil
 	}
 	return nil, disperser.ErrBlobNotFound
 }
 
+func (q *BlobStore) GetBulkBlobMetadata(ctx context.Context, blobKeys []disperser.BlobKey) ([]*disperser.BlobMetadata, error) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	metas := make([]*disperser.BlobMetadata, 0, len(blobKeys))
+	for _, blobKey := range blobKeys {
+		if meta, ok := q.Metadata[blobKey]; ok {
+			metas = append(metas, meta)
+		}
+	}
+	return metas, nil
+}
+
 // getNewBlobHash generates a new blob key
 func (q *BlobStore) getNewBlobHash() (disperser.BlobHash, error) {
 	var key disperser.BlobHash
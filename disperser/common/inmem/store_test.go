@@ -7,6 +7,7 @@ import (
 
 	"github.com/Layr-Labs/eigenda/core"
 	"github.com/Layr-Labs/eigenda/disperser"
+	"github.com/Layr-Labs/eigenda/disperser/common/blobstoretest"
 	"github.com/Layr-Labs/eigenda/disperser/common/inmem"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
@@ -24,6 +25,7 @@ func TestBlobStore(t *testing.T) {
 		blobKey, err := bs.StoreBlob(ctx, &core.Blob{
 			RequestHeader: core.BlobRequestHeader{
 				SecurityParams: []*core.SecurityParam{},
+				CallbackURL:    "https://example.com/callback",
 			},
 			Data: []byte{byte(i)},
 		}, requestedAt)
@@ -35,6 +37,11 @@ func TestBlobStore(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Len(t, metas, numBlobs)
 
+	metas, err = bs.GetAllBlobMetadataByContent(ctx, []byte{byte(3)})
+	assert.Nil(t, err)
+	assert.Len(t, metas, 1)
+	assert.Equal(t, keys[3], metas[0].GetBlobKey())
+
 	data, err := bs.GetBlobContent(ctx, keys[1].BlobHash)
 	assert.Nil(t, err)
 	assert.Equal(t, data, []byte{byte(1)})
@@ -102,15 +109,68 @@ func TestBlobStore(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, meta1.BlobStatus, disperser.Processing)
 
-	err = bs.MarkBlobFailed(ctx, blobKey1)
+	failure := &disperser.BlobFailure{Stage: disperser.ConfirmationStage, Error: "test failure", Attempt: 0, Timestamp: 1}
+	err = bs.MarkBlobFailed(ctx, blobKey1, failure)
 	assert.Nil(t, err)
 
 	meta1, err = bs.GetBlobMetadata(ctx, blobKey1)
 	assert.Nil(t, err)
 	assert.Equal(t, meta1.BlobStatus, disperser.Failed)
+	assert.Equal(t, []*disperser.BlobFailure{failure}, meta1.FailureHistory)
+
+	// Only failed blobs can be requeued
+	err = bs.RequeueBlob(ctx, blobKey2)
+	assert.ErrorIs(t, err, disperser.ErrBlobNotFailed)
+	err = bs.RequeueBlob(ctx, blobKey1)
+	assert.Nil(t, err)
+	meta1, err = bs.GetBlobMetadata(ctx, blobKey1)
+	assert.Nil(t, err)
+	assert.Equal(t, disperser.Processing, meta1.BlobStatus)
+	assert.Equal(t, uint(0), meta1.NumRetries)
+	assert.Equal(t, []*disperser.BlobFailure{failure}, meta1.FailureHistory)
+	err = bs.MarkBlobFailed(ctx, blobKey1, failure)
+	assert.Nil(t, err)
+
+	// Requeueing a blob resets its notified status
+	assert.True(t, meta1.HasPendingNotification())
+	err = bs.MarkBlobNotified(ctx, blobKey1, disperser.Failed)
+	assert.Nil(t, err)
+	meta1, err = bs.GetBlobMetadata(ctx, blobKey1)
+	assert.Nil(t, err)
+	assert.False(t, meta1.HasPendingNotification())
+	err = bs.RequeueBlob(ctx, blobKey1)
+	assert.Nil(t, err)
+	assert.Equal(t, disperser.Processing, meta1.NotifiedStatus)
+	err = bs.MarkBlobFailed(ctx, blobKey1, failure)
+	assert.Nil(t, err)
+	assert.True(t, meta1.HasPendingNotification())
+	err = bs.MarkBlobNotified(ctx, disperser.BlobKey{BlobHash: "missing", MetadataHash: "missing"}, disperser.Failed)
+	assert.ErrorIs(t, err, disperser.ErrBlobNotFound)
 
 	allMeta, err := bs.GetAllBlobMetadataByBatch(ctx, batchHeaderHash)
 	assert.Nil(t, err)
 	assert.Equal(t, 1, len(allMeta))
 	assert.Equal(t, allMeta[0].BlobStatus, disperser.Confirmed)
+
+	for _, key := range keys {
+		if key == blobKey1 || key == blobKey2 {
+			continue
+		}
+		err = bs.MarkBlobCancelled(ctx, key)
+		assert.Nil(t, err)
+		meta, err := bs.GetBlobMetadata(ctx, key)
+		assert.Nil(t, err)
+		assert.Equal(t, disperser.Cancelled, meta.BlobStatus)
+		break
+	}
+
+	// Only processing blobs can be cancelled
+	err = bs.MarkBlobCancelled(ctx, blobKey1)
+	assert.ErrorIs(t, err, disperser.ErrBlobNotProcessing)
+	err = bs.MarkBlobCancelled(ctx, disperser.BlobKey{BlobHash: "missing", MetadataHash: "missing"})
+	assert.ErrorIs(t, err, disperser.ErrBlobNotFound)
+}
+
+func TestBlobStoreBehavior(t *testing.T) {
+	blobstoretest.RunBlobStoreTests(t, inmem.NewBlobStore())
 }
@@ -0,0 +1,37 @@
+package localstore
+
+import (
+	"github.com/Layr-Labs/eigenda/common"
+	"github.com/urfave/cli"
+)
+
+const (
+	DirFlagName = "local-blob-store-dir"
+)
+
+type Config struct {
+	// Dir is the directory of the local blob store. S3 and DynamoDB are used if it's empty.
+	Dir string
+}
+
+func CLIFlags(envPrefix string, flagPrefix string) []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:     common.PrefixFlag(flagPrefix, DirFlagName),
+			Usage:    "Directory in which blobs and their metadata are stored instead of S3 and DynamoDB. All the disperser components of the host must use the same directory",
+			Required: false,
+			EnvVar:   common.PrefixEnvVar(envPrefix, "LOCAL_BLOB_STORE_DIR"),
+		},
+	}
+}
+
+func ReadCLIConfig(ctx *cli.Context, flagPrefix string) Config {
+	return Config{
+		Dir: ctx.GlobalString(common.PrefixFlag(flagPrefix, DirFlagName)),
+	}
+}
+
+// Enabled returns whether the local blob store should be used
+func (c Config) Enabled() bool {
+	return c.Dir != ""
+}
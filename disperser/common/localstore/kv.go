@@ -0,0 +1,109 @@
+package localstore
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	lockFileName = "LOCK"
+	tmpSuffix    = ".tmp"
+)
+
+var errKeyNotFound = errors.New("key not found")
+
+// fileKV is an on-disk key-value store that keeps each value in its own file. Keys are slash separated paths
+// relative to the root directory, and the keys that share a prefix up to a slash can be listed.
+//
+// Each value is written atomically by renaming a synced temporary file. Operations that span several keys
+// are serialized with an advisory lock on a file of the root directory, so the store can be shared by the
+// processes of a single host.
+type fileKV struct {
+	dir string
+}
+
+func newFileKV(dir string) (*fileKV, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	// Check that the store can be locked on this platform
+	unlock, err := lockFile(filepath.Join(dir, lockFileName), true)
+	if err != nil {
+		return nil, err
+	}
+	unlock()
+	return &fileKV{dir: dir}, nil
+}
+
+// lock takes the lock of the store until the returned function is called. An exclusive lock must be held
+// while writing, and a shared lock while reading several keys that must be consistent with each other.
+func (kv *fileKV) lock(exclusive bool) (func(), error) {
+	return lockFile(filepath.Join(kv.dir, lockFileName), exclusive)
+}
+
+func (kv *fileKV) path(key string) string {
+	return filepath.Join(kv.dir, filepath.FromSlash(key))
+}
+
+func (kv *fileKV) get(key string) ([]byte, error) {
+	value, err := os.ReadFile(kv.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, errKeyNotFound
+	}
+	return value, err
+}
+
+func (kv *fileKV) put(key string, value []byte) error {
+	path := kv.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+"-*"+tmpSuffix)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(value); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func (kv *fileKV) delete(key string) error {
+	err := os.Remove(kv.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// list returns the last element of the keys that start with prefix followed by a slash
+func (kv *fileKV) list(prefix string) ([]string, error) {
+	entries, err := os.ReadDir(kv.path(prefix))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasSuffix(entry.Name(), tmpSuffix) {
+			continue
+		}
+		names = append(names, entry.Name())
+	}
+	return names, nil
+}
@@ -0,0 +1,9 @@
+//go:build !unix
+
+package localstore
+
+import "errors"
+
+func lockFile(path string, exclusive bool) (func(), error) {
+	return nil, errors.New("the local blob store is only supported on unix platforms")
+}
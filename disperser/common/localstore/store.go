@@ -0,0 +1,559 @@
+package localstore
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/Layr-Labs/eigenda/common"
+	"github.com/Layr-Labs/eigenda/core"
+	"github.com/Layr-Labs/eigenda/disperser"
+	"github.com/Layr-Labs/eigenda/disperser/common/blobstore"
+)
+
+const (
+	contentPrefix  = "blobs"
+	metadataPrefix = "metadata"
+	statusPrefix   = "status"
+	batchPrefix    = "batch"
+	pendingPrefix  = "pending"
+)
+
+// BlobStore is a disperser.BlobStore that keeps the blobs and their metadata in a local directory, for
+// deployments where all the disperser components run on a single host. The blob keys are the same as the
+// ones of blobstore.SharedBlobStore. Unlike the DynamoDB table, the store doesn't expire blobs.
+//
+// The directory is laid out as follows:
+//   - blobs/<BlobHash> -> blob content
+//   - metadata/<BlobHash>/<MetadataHash> -> JSON encoded metadata
+//   - Indexes
+//   - status/<BlobStatus>/<BlobKey> -> empty
+//   - batch/<BatchHeaderHash>/<BlobIndex> -> BlobKey
+//   - pending/<BatchHeaderHash> -> JSON encoded pending batch
+//
+// The metadata is written before the indexes, so an index may refer to a blob that has since moved out of it
+// if a write was interrupted. Such entries are skipped when the index is read.
+type BlobStore struct {
+	kv     *fileKV
+	logger common.Logger
+}
+
+var _ disperser.BlobStore = (*BlobStore)(nil)
+
+// NewBlobStore opens the blob store in the given directory, creating the directory if it doesn't exist
+func NewBlobStore(dir string, logger common.Logger) (*BlobStore, error) {
+	kv, err := newFileKV(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open local blob store at %s: %w", dir, err)
+	}
+	logger.Info("opened local blob store", "dir", dir)
+	return &BlobStore{
+		kv:     kv,
+		logger: logger,
+	}, nil
+}
+
+func (s *BlobStore) StoreBlob(ctx context.Context, blob *core.Blob, requestedAt uint64) (disperser.BlobKey, error) {
+	blobKey := disperser.BlobKey{}
+	if blob == nil {
+		return blobKey, errors.New("blob is nil")
+	}
+
+	blobHash := blobstore.GetBlobHash(blob)
+	metadataHash, err := blobstore.GetMetadataHash(requestedAt, blob.RequestHeader.SecurityParams)
+	if err != nil {
+		return blobKey, err
+	}
+	blobKey.BlobHash = blobHash
+	blobKey.MetadataHash = metadataHash
+
+	if err := s.kv.put(contentKey(blobHash), blob.Data); err != nil {
+		return blobKey, fmt.Errorf("failed to store blob content: %w", err)
+	}
+
+	metadata := &disperser.BlobMetadata{
+		BlobHash:     blobHash,
+		MetadataHash: metadataHash,
+		NumRetries:   0,
+		BlobStatus:   disperser.Processing,
+		RequestMetadata: &disperser.RequestMetadata{
+			BlobRequestHeader: blob.RequestHeader,
+			BlobSize:          uint(len(blob.Data)),
+			RequestedAt:       requestedAt,
+		},
+	}
+
+	unlock, err := s.kv.lock(true)
+	if err != nil {
+		return blobKey, err
+	}
+	defer unlock()
+	if err := s.putMetadata(metadata, nil); err != nil {
+		return blobKey, fmt.Errorf("failed to store blob metadata: %w", err)
+	}
+	return blobKey, nil
+}
+
+func (s *BlobStore) GetBlobContent(ctx context.Context, blobHash disperser.BlobHash) ([]byte, error) {
+	if !isHash(blobHash) {
+		return nil, disperser.ErrBlobNotFound
+	}
+	data, err := s.kv.get(contentKey(blobHash))
+	if errors.Is(err, errKeyNotFound) {
+		return nil, disperser.ErrBlobNotFound
+	}
+	return data, err
+}
+
+func (s *BlobStore) MarkBlobConfirmed(ctx context.Context, existingMetadata *disperser.BlobMetadata, confirmationInfo *disperser.ConfirmationInfo) (*disperser.BlobMetadata, error) {
+	return s.markBlobInBatch(existingMetadata, disperser.Confirmed, confirmationInfo)
+}
+
+func (s *BlobStore) MarkBlobInsufficientSignatures(ctx context.Context, existingMetadata *disperser.BlobMetadata, confirmationInfo *disperser.ConfirmationInfo) (*disperser.BlobMetadata, error) {
+	return s.markBlobInBatch(existingMetadata, disperser.InsufficientSignatures, confirmationInfo)
+}
+
+// markBlobInBatch writes the caller's metadata with the given status and confirmation info if the blob is still
+// processing, as the DynamoDB store does
+func (s *BlobStore) markBlobInBatch(existingMetadata *disperser.BlobMetadata, status disperser.BlobStatus, confirmationInfo *disperser.ConfirmationInfo) (*disperser.BlobMetadata, error) {
+	newMetadata := *existingMetadata
+	newMetadata.BlobStatus = status
+	newMetadata.ConfirmationInfo = confirmationInfo
+	err := s.updateMetadata(existingMetadata.GetBlobKey(), func(metadata *disperser.BlobMetadata) error {
+		if metadata.BlobStatus != disperser.Processing {
+			return disperser.ErrBlobNotProcessing
+		}
+		*metadata = newMetadata
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &newMetadata, nil
+}
+
+func (s *BlobStore) MarkBlobFinalized(ctx context.Context, blobKey disperser.BlobKey) error {
+	return s.setBlobStatus(blobKey, disperser.Finalized)
+}
+
+func (s *BlobStore) MarkBlobProcessing(ctx context.Context, blobKey disperser.BlobKey) error {
+	return s.updateMetadata(blobKey, func(metadata *disperser.BlobMetadata) error {
+		if metadata.BlobStatus == disperser.Cancelled {
+			return disperser.ErrBlobCancelled
+		}
+		metadata.BlobStatus = disperser.Processing
+		return nil
+	})
+}
+
+func (s *BlobStore) setBlobStatus(blobKey disperser.BlobKey, status disperser.BlobStatus) error {
+	return s.updateMetadata(blobKey, func(metadata *disperser.BlobMetadata) error {
+		metadata.BlobStatus = status
+		return nil
+	})
+}
+
+func (s *BlobStore) MarkBlobFailed(ctx context.Context, blobKey disperser.BlobKey, failure *disperser.BlobFailure) error {
+	return s.updateMetadata(blobKey, func(metadata *disperser.BlobMetadata) error {
+		if metadata.BlobStatus != disperser.Processing {
+			return disperser.ErrBlobNotProcessing
+		}
+		metadata.BlobStatus = disperser.Failed
+		metadata.FailureHistory = disperser.AppendBlobFailure(metadata.FailureHistory, failure)
+		return nil
+	})
+}
+
+func (s *BlobStore) MarkBlobCancelled(ctx context.Context, blobKey disperser.BlobKey) error {
+	return s.updateMetadata(blobKey, func(metadata *disperser.BlobMetadata) error {
+		if metadata.BlobStatus != disperser.Processing {
+			return disperser.ErrBlobNotProcessing
+		}
+		metadata.BlobStatus = disperser.Cancelled
+		return nil
+	})
+}
+
+func (s *BlobStore) IncrementBlobRetryCount(ctx context.Context, existingMetadata *disperser.BlobMetadata, failure *disperser.BlobFailure, notBefore uint64) error {
+	return s.updateMetadata(existingMetadata.GetBlobKey(), func(metadata *disperser.BlobMetadata) error {
+		if metadata.BlobStatus != disperser.Processing {
+			return disperser.ErrBlobNotProcessing
+		}
+		metadata.NumRetries = existingMetadata.NumRetries + 1
+		metadata.NotBefore = notBefore
+		metadata.FailureHistory = disperser.AppendBlobFailure(metadata.FailureHistory, failure)
+		return nil
+	})
+}
+
+func (s *BlobStore) RecordBlobFailure(ctx context.Context, blobKey disperser.BlobKey, failure *disperser.BlobFailure) error {
+	return s.updateMetadata(blobKey, func(metadata *disperser.BlobMetadata) error {
+		metadata.FailureHistory = disperser.AppendBlobFailure(metadata.FailureHistory, failure)
+		return nil
+	})
+}
+
+func (s *BlobStore) RequeueBlob(ctx context.Context, blobKey disperser.BlobKey) error {
+	return s.updateMetadata(blobKey, func(metadata *disperser.BlobMetadata) error {
+		if metadata.BlobStatus != disperser.Failed {
+			return disperser.ErrBlobNotFailed
+		}
+		metadata.BlobStatus = disperser.Processing
+		metadata.NumRetries = 0
+		metadata.NotBefore = 0
+		metadata.NotifiedStatus = disperser.Processing
+		metadata.AbandonedNotificationStatus = disperser.Processing
+		return nil
+	})
+}
+
+func (s *BlobStore) SetPendingBatch(ctx context.Context, pendingBatch *disperser.PendingBatch) error {
+	value, err := json.Marshal(pendingBatch)
+	if err != nil {
+		return err
+	}
+	return s.kv.put(pendingBatchKey(pendingBatch.BatchHeaderHash), value)
+}
+
+func (s *BlobStore) GetPendingBatches(ctx context.Context) ([]*disperser.PendingBatch, error) {
+	names, err := s.kv.list(pendingPrefix)
+	if err != nil {
+		return nil, err
+	}
+	pendingBatches := make([]*disperser.PendingBatch, 0, len(names))
+	for _, name := range names {
+		value, err := s.kv.get(pendingPrefix + "/" + name)
+		if errors.Is(err, errKeyNotFound) {
+			// The batch was removed since it was listed
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		pendingBatch := &disperser.PendingBatch{}
+		if err := json.Unmarshal(value, pendingBatch); err != nil {
+			return nil, fmt.Errorf("failed to decode pending batch %s: %w", name, err)
+		}
+		pendingBatches = append(pendingBatches, pendingBatch)
+	}
+	return pendingBatches, nil
+}
+
+func (s *BlobStore) RemovePendingBatch(ctx context.Context, batchHeaderHash [32]byte) error {
+	return s.kv.delete(pendingBatchKey(batchHeaderHash))
+}
+
+// RequeueConfirmedBlob leaves the blob in the index of its batch, as reads skip the entries of blobs that are no
+// longer in the batch
+func (s *BlobStore) RequeueConfirmedBlob(ctx context.Context, blobKey disperser.BlobKey) error {
+	return s.updateMetadata(blobKey, func(metadata *disperser.BlobMetadata) error {
+		if metadata.BlobStatus != disperser.Confirmed {
+			return disperser.ErrBlobNotConfirmed
+		}
+		metadata.BlobStatus = disperser.Processing
+		metadata.ConfirmationInfo = nil
+		metadata.NotifiedStatus = disperser.Processing
+		metadata.AbandonedNotificationStatus = disperser.Processing
+		return nil
+	})
+}
+
+func (s *BlobStore) MarkBlobNotified(ctx context.Context, blobKey disperser.BlobKey, status disperser.BlobStatus) error {
+	return s.updateMetadata(blobKey, func(metadata *disperser.BlobMetadata) error {
+		metadata.NotifiedStatus = status
+		return nil
+	})
+}
+
+func (s *BlobStore) MarkBlobNotificationAbandoned(ctx context.Context, blobKey disperser.BlobKey, status disperser.BlobStatus) error {
+	return s.updateMetadata(blobKey, func(metadata *disperser.BlobMetadata) error {
+		metadata.AbandonedNotificationStatus = status
+		return nil
+	})
+}
+
+func (s *BlobStore) GetBlobsByMetadata(ctx context.Context, metadata []*disperser.BlobMetadata) (map[disperser.BlobKey]*core.Blob, error) {
+	blobs := make(map[disperser.BlobKey]*core.Blob, len(metadata))
+	for _, m := range metadata {
+		data, err := s.GetBlobContent(ctx, m.BlobHash)
+		if err != nil {
+			return nil, err
+		}
+		blobs[m.GetBlobKey()] = &core.Blob{
+			RequestHeader: m.RequestMetadata.BlobRequestHeader,
+			Data:          data,
+		}
+	}
+	return blobs, nil
+}
+
+func (s *BlobStore) GetBlobMetadataByStatus(ctx context.Context, status disperser.BlobStatus) ([]*disperser.BlobMetadata, error) {
+	unlock, err := s.kv.lock(false)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	names, err := s.kv.list(statusIndexPrefix(status))
+	if err != nil {
+		return nil, err
+	}
+	metadatas := make([]*disperser.BlobMetadata, 0, len(names))
+	for _, name := range names {
+		blobKey, err := disperser.ParseBlobKey(name)
+		if err != nil {
+			s.logger.Warn("skipping invalid entry of the status index", "status", status.String(), "entry", name)
+			continue
+		}
+		metadata, err := s.getMetadata(blobKey)
+		if errors.Is(err, disperser.ErrBlobNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if metadata.BlobStatus != status {
+			continue
+		}
+		metadatas = append(metadatas, metadata)
+	}
+	return metadatas, nil
+}
+
+// GetBlobMetadataByStatusWithPagination reads the whole status index, as its entries aren't ordered by request time
+func (s *BlobStore) GetBlobMetadataByStatusWithPagination(ctx context.Context, status disperser.BlobStatus, limit int32, exclusiveStartKey *disperser.BlobStoreExclusiveStartKey) ([]*disperser.BlobMetadata, *disperser.BlobStoreExclusiveStartKey, error) {
+	metadatas, err := s.GetBlobMetadataByStatus(ctx, status)
+	if err != nil {
+		return nil, nil, err
+	}
+	metadatas, lastEvaluatedKey := disperser.PaginateBlobMetadata(metadatas, limit, exclusiveStartKey)
+	return metadatas, lastEvaluatedKey, nil
+}
+
+func (s *BlobStore) GetMetadataInBatch(ctx context.Context, batchHeaderHash [32]byte, blobIndex uint32) (*disperser.BlobMetadata, error) {
+	unlock, err := s.kv.lock(false)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	metadata, err := s.getMetadataInBatch(batchHeaderHash, strconv.FormatUint(uint64(blobIndex), 10))
+	if err != nil {
+		return nil, err
+	}
+	if metadata == nil {
+		return nil, fmt.Errorf("there is no metadata for batch %x and blob index %d", batchHeaderHash, blobIndex)
+	}
+	return metadata, nil
+}
+
+func (s *BlobStore) GetAllBlobMetadataByBatch(ctx context.Context, batchHeaderHash [32]byte) ([]*disperser.BlobMetadata, error) {
+	unlock, err := s.kv.lock(false)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	names, err := s.kv.list(batchIndexPrefix(batchHeaderHash))
+	if err != nil {
+		return nil, err
+	}
+	metadatas := make([]*disperser.BlobMetadata, 0, len(names))
+	for _, name := range names {
+		metadata, err := s.getMetadataInBatch(batchHeaderHash, name)
+		if err != nil {
+			return nil, err
+		}
+		if metadata != nil {
+			metadatas = append(metadatas, metadata)
+		}
+	}
+	if len(metadatas) == 0 {
+		return nil, fmt.Errorf("there is no metadata for batch %x", batchHeaderHash)
+	}
+	return metadatas, nil
+}
+
+func (s *BlobStore) GetAllBlobMetadataByContent(ctx context.Context, data []byte) ([]*disperser.BlobMetadata, error) {
+	blobHash := blobstore.GetBlobHash(&core.Blob{Data: data})
+
+	unlock, err := s.kv.lock(false)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	names, err := s.kv.list(metadataPrefix + "/" + blobHash)
+	if err != nil {
+		return nil, err
+	}
+	metadatas := make([]*disperser.BlobMetadata, 0, len(names))
+	for _, metadataHash := range names {
+		metadata, err := s.getMetadata(disperser.BlobKey{BlobHash: blobHash, MetadataHash: metadataHash})
+		if err != nil {
+			return nil, err
+		}
+		metadatas = append(metadatas, metadata)
+	}
+	return metadatas, nil
+}
+
+func (s *BlobStore) GetBlobMetadata(ctx context.Context, blobKey disperser.BlobKey) (*disperser.BlobMetadata, error) {
+	return s.getMetadata(blobKey)
+}
+
+func (s *BlobStore) GetBulkBlobMetadata(ctx context.Context, blobKeys []disperser.BlobKey) ([]*disperser.BlobMetadata, error) {
+	unlock, err := s.kv.lock(false)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	metadatas := make([]*disperser.BlobMetadata, 0, len(blobKeys))
+	for _, blobKey := range blobKeys {
+		metadata, err := s.getMetadata(blobKey)
+		if errors.Is(err, disperser.ErrBlobNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		metadatas = append(metadatas, metadata)
+	}
+	return metadatas, nil
+}
+
+// updateMetadata applies update to the metadata of the blob under the exclusive lock of the store and writes it
+// back along with its indexes. The blob is left unchanged if update returns an error.
+func (s *BlobStore) updateMetadata(blobKey disperser.BlobKey, update func(metadata *disperser.BlobMetadata) error) error {
+	unlock, err := s.kv.lock(true)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	metadata, err := s.getMetadata(blobKey)
+	if err != nil {
+		return err
+	}
+	oldStatus := metadata.BlobStatus
+	if err := update(metadata); err != nil {
+		return err
+	}
+	return s.putMetadata(metadata, &oldStatus)
+}
+
+// putMetadata writes the metadata and adds it to the indexes of its status and batch.
+// If the status changed from oldStatus, the blob is removed from the index of oldStatus.
+func (s *BlobStore) putMetadata(metadata *disperser.BlobMetadata, oldStatus *disperser.BlobStatus) error {
+	blobKey := metadata.GetBlobKey()
+	value, err := json.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+	if err := s.kv.put(metadataKey(blobKey), value); err != nil {
+		return err
+	}
+
+	if err := s.kv.put(statusIndexKey(metadata.BlobStatus, blobKey), nil); err != nil {
+		return err
+	}
+	if info := metadata.ConfirmationInfo; info != nil {
+		if err := s.kv.put(batchIndexKey(info.BatchHeaderHash, info.BlobIndex), []byte(blobKey.String())); err != nil {
+			return err
+		}
+	}
+	if oldStatus != nil && *oldStatus != metadata.BlobStatus {
+		return s.kv.delete(statusIndexKey(*oldStatus, blobKey))
+	}
+	return nil
+}
+
+func (s *BlobStore) getMetadata(blobKey disperser.BlobKey) (*disperser.BlobMetadata, error) {
+	if !isHash(blobKey.BlobHash) || !isHash(blobKey.MetadataHash) {
+		return nil, disperser.ErrBlobNotFound
+	}
+	value, err := s.kv.get(metadataKey(blobKey))
+	if errors.Is(err, errKeyNotFound) {
+		return nil, disperser.ErrBlobNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := &disperser.BlobMetadata{}
+	if err := json.Unmarshal(value, metadata); err != nil {
+		return nil, fmt.Errorf("failed to decode metadata of blob %s: %w", blobKey.String(), err)
+	}
+	return metadata, nil
+}
+
+// getMetadataInBatch returns the metadata of the blob at the given index of the batch, or nil if there is none
+func (s *BlobStore) getMetadataInBatch(batchHeaderHash [32]byte, blobIndex string) (*disperser.BlobMetadata, error) {
+	value, err := s.kv.get(batchIndexPrefix(batchHeaderHash) + "/" + blobIndex)
+	if errors.Is(err, errKeyNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	blobKey, err := disperser.ParseBlobKey(string(value))
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := s.getMetadata(blobKey)
+	if errors.Is(err, disperser.ErrBlobNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The blob may have been confirmed in another batch since it was indexed
+	info := metadata.ConfirmationInfo
+	if info == nil || info.BatchHeaderHash != batchHeaderHash || strconv.FormatUint(uint64(info.BlobIndex), 10) != blobIndex {
+		return nil, nil
+	}
+	return metadata, nil
+}
+
+func contentKey(blobHash disperser.BlobHash) string {
+	return contentPrefix + "/" + blobHash
+}
+
+func metadataKey(blobKey disperser.BlobKey) string {
+	return metadataPrefix + "/" + blobKey.BlobHash + "/" + blobKey.MetadataHash
+}
+
+func statusIndexPrefix(status disperser.BlobStatus) string {
+	return statusPrefix + "/" + strconv.Itoa(int(status))
+}
+
+func statusIndexKey(status disperser.BlobStatus, blobKey disperser.BlobKey) string {
+	return statusIndexPrefix(status) + "/" + blobKey.String()
+}
+
+func batchIndexPrefix(batchHeaderHash [32]byte) string {
+	return batchPrefix + "/" + hex.EncodeToString(batchHeaderHash[:])
+}
+
+func batchIndexKey(batchHeaderHash [32]byte, blobIndex uint32) string {
+	return batchIndexPrefix(batchHeaderHash) + "/" + strconv.FormatUint(uint64(blobIndex), 10)
+}
+
+func pendingBatchKey(batchHeaderHash [32]byte) string {
+	return pendingPrefix + "/" + hex.EncodeToString(batchHeaderHash[:])
+}
+
+// isHash returns whether the hash is hex encoded, so that it's safe to use in a path.
+// The blob keys of read requests come from the clients.
+func isHash(hash string) bool {
+	if hash == "" {
+		return false
+	}
+	_, err := hex.DecodeString(hash)
+	return err == nil
+}
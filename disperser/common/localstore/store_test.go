@@ -0,0 +1,74 @@
+package localstore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Layr-Labs/eigenda/common/logging"
+	"github.com/Layr-Labs/eigenda/core"
+	"github.com/Layr-Labs/eigenda/disperser"
+	"github.com/Layr-Labs/eigenda/disperser/common/blobstoretest"
+	"github.com/Layr-Labs/eigenda/disperser/common/localstore"
+	"github.com/stretchr/testify/assert"
+)
+
+func newBlobStore(t *testing.T, dir string) *localstore.BlobStore {
+	logger, err := logging.GetLogger(logging.DefaultCLIConfig())
+	assert.NoError(t, err)
+	blobStore, err := localstore.NewBlobStore(dir, logger)
+	assert.NoError(t, err)
+	return blobStore
+}
+
+func TestBlobStore(t *testing.T) {
+	blobstoretest.RunBlobStoreTests(t, newBlobStore(t, t.TempDir()))
+}
+
+func TestBlobStoreIsShared(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	blob := &core.Blob{
+		RequestHeader: core.BlobRequestHeader{
+			SecurityParams: []*core.SecurityParam{{QuorumID: 0, AdversaryThreshold: 80, QuorumThreshold: 100}},
+		},
+		Data: []byte("data"),
+	}
+
+	// The apiserver and the batcher open the same directory
+	apiserverStore := newBlobStore(t, dir)
+	batcherStore := newBlobStore(t, dir)
+	blobKey, err := apiserverStore.StoreBlob(ctx, blob, uint64(time.Now().UnixNano()))
+	assert.NoError(t, err)
+	processing, err := batcherStore.GetBlobMetadataByStatus(ctx, disperser.Processing)
+	assert.NoError(t, err)
+	assert.Len(t, processing, 1)
+	assert.Equal(t, blobKey, processing[0].GetBlobKey())
+
+	err = batcherStore.MarkBlobFailed(ctx, blobKey, &disperser.BlobFailure{Stage: disperser.EncodingStage, Error: "failed"})
+	assert.NoError(t, err)
+	metadata, err := apiserverStore.GetBlobMetadata(ctx, blobKey)
+	assert.NoError(t, err)
+	assert.Equal(t, disperser.Failed, metadata.BlobStatus)
+
+	// The blobs survive a restart
+	restartedStore := newBlobStore(t, dir)
+	metadata, err = restartedStore.GetBlobMetadata(ctx, blobKey)
+	assert.NoError(t, err)
+	assert.Equal(t, disperser.Failed, metadata.BlobStatus)
+	data, err := restartedStore.GetBlobContent(ctx, blobKey.BlobHash)
+	assert.NoError(t, err)
+	assert.Equal(t, blob.Data, data)
+}
+
+func TestInvalidBlobKey(t *testing.T) {
+	ctx := context.Background()
+	blobStore := newBlobStore(t, t.TempDir())
+
+	_, err := blobStore.GetBlobMetadata(ctx, disperser.BlobKey{BlobHash: "..", MetadataHash: "LOCK"})
+	assert.ErrorIs(t, err, disperser.ErrBlobNotFound)
+	_, err = blobStore.GetBlobContent(ctx, "../LOCK")
+	assert.ErrorIs(t, err, disperser.ErrBlobNotFound)
+	err = blobStore.MarkBlobFinalized(ctx, disperser.BlobKey{BlobHash: "00", MetadataHash: "00"})
+	assert.ErrorIs(t, err, disperser.ErrBlobNotFound)
+}
@@ -3,6 +3,7 @@ package dataapi
 import (
 	"context"
 	"encoding/hex"
+	"fmt"
 	"sort"
 
 	"github.com/Layr-Labs/eigenda/disperser"
@@ -35,6 +36,54 @@ func (s *server) getBlobs(ctx context.Context, limit int) ([]*BlobMetadataRespon
 	return s.convertBlobMetadatasToBlobMetadataResponse(ctx, blobMetadatas)
 }
 
+// getDeadLetterBlobs returns a page of the blobs that failed to be dispersed, ordered by request time, and the token of
+// the next page. The next page token is empty if there are no more blobs.
+func (s *server) getDeadLetterBlobs(ctx context.Context, limit int, pageToken string) ([]*DeadLetterBlobResponse, string, error) {
+	if limit <= 0 || limit > maxDeadLetterBlobsLimit {
+		return nil, "", fmt.Errorf("%w: limit must be in range [1, %d]", errBadRequest, maxDeadLetterBlobsLimit)
+	}
+	var exclusiveStartKey *disperser.BlobStoreExclusiveStartKey
+	if pageToken != "" {
+		var err error
+		exclusiveStartKey, err = disperser.ParsePageToken(pageToken, disperser.Failed)
+		if err != nil {
+			return nil, "", fmt.Errorf("%w: %v", errBadRequest, err)
+		}
+	}
+
+	metadatas, lastEvaluatedKey, err := s.blobstore.GetBlobMetadataByStatusWithPagination(ctx, disperser.Failed, int32(limit), exclusiveStartKey)
+	if err != nil {
+		return nil, "", err
+	}
+
+	responses := make([]*DeadLetterBlobResponse, len(metadatas))
+	for i, metadata := range metadatas {
+		responses[i] = &DeadLetterBlobResponse{
+			BlobKey:        metadata.GetBlobKey().String(),
+			SecurityParams: metadata.RequestMetadata.SecurityParams,
+			RequestAt:      ConvertNanosecondToSecond(metadata.RequestMetadata.RequestedAt),
+			BlobStatus:     metadata.BlobStatus,
+			NumRetries:     metadata.NumRetries,
+			FailureHistory: metadata.FailureHistory,
+		}
+	}
+	nextPageToken := ""
+	if lastEvaluatedKey != nil {
+		nextPageToken = disperser.FormatPageToken(lastEvaluatedKey)
+	}
+	return responses, nextPageToken, nil
+}
+
+// requeueBlob moves a failed blob back to processing so that the batcher disperses it again
+func (s *server) requeueBlob(ctx context.Context, key string) error {
+	blobKey, err := disperser.ParseBlobKey(key)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errBadRequest, err)
+	}
+	s.logger.Info("Requeueing failed blob", "key", key)
+	return s.blobstore.RequeueBlob(ctx, blobKey)
+}
+
 func (s *server) convertBlobMetadatasToBlobMetadataResponse(ctx context.Context, metadatas []*disperser.BlobMetadata) ([]*BlobMetadataResponse, error) {
 	var (
 		err               error
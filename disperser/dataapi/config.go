@@ -4,4 +4,6 @@ type Config struct {
 	SocketAddr   string
 	ServerMode   string
 	AllowOrigins []string
+	// AdminAPIKey is the bearer token required by the admin endpoints. The admin endpoints are disabled if it's empty.
+	AdminAPIKey string
 }
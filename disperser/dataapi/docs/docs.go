@@ -15,6 +15,122 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/dead_letter/blobs": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "DeadLetter"
+                ],
+                "summary": "Fetch a page of the blobs that failed to be dispersed, oldest request first",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Limit [default: 10, max: 1000]",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token of the page, from next_page_token of the previous page",
+                        "name": "page_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dataapi.DeadLetterBlobsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error: Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/dataapi.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error: Server error",
+                        "schema": {
+                            "$ref": "#/definitions/dataapi.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/dead_letter/blobs/{blob_key}/requeue": {
+            "post": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "DeadLetter"
+                ],
+                "summary": "Move a failed blob back to processing so that it's dispersed again",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer admin API key",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Blob Key",
+                        "name": "blob_key",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dataapi.BlobMetadataResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error: Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/dataapi.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error: Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dataapi.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error: Admin API is disabled",
+                        "schema": {
+                            "$ref": "#/definitions/dataapi.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error: Not found",
+                        "schema": {
+                            "$ref": "#/definitions/dataapi.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "error: Blob is not failed",
+                        "schema": {
+                            "$ref": "#/definitions/dataapi.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error: Server error",
+                        "schema": {
+                            "$ref": "#/definitions/dataapi.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/feed/blobs": {
             "get": {
                 "produces": [
@@ -376,6 +492,52 @@ const docTemplate = `{
                 }
             }
         },
+        "dataapi.DeadLetterBlobResponse": {
+            "type": "object",
+            "properties": {
+                "blob_key": {
+                    "type": "string"
+                },
+                "blob_status": {
+                    "$ref": "#/definitions/github_com_Layr-Labs_eigenda_disperser.BlobStatus"
+                },
+                "failure_history": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/disperser.BlobFailure"
+                    }
+                },
+                "num_retries": {
+                    "type": "integer"
+                },
+                "requested_at": {
+                    "type": "integer"
+                },
+                "security_params": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/core.SecurityParam"
+                    }
+                }
+            }
+        },
+        "dataapi.DeadLetterBlobsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dataapi.DeadLetterBlobResponse"
+                    }
+                },
+                "meta": {
+                    "$ref": "#/definitions/dataapi.Meta"
+                },
+                "next_page_token": {
+                    "type": "string"
+                }
+            }
+        },
         "dataapi.ErrorResponse": {
             "type": "object",
             "properties": {
@@ -395,8 +557,8 @@ const docTemplate = `{
         "dataapi.Metric": {
             "type": "object",
             "properties": {
-                "cost_in_wei": {
-                    "type": "integer"
+                "cost_in_gas": {
+                    "type": "number"
                 },
                 "throughput": {
                     "type": "number"
@@ -428,6 +590,42 @@ const docTemplate = `{
                 }
             }
         },
+        "disperser.BlobFailure": {
+            "type": "object",
+            "properties": {
+                "attempt": {
+                    "description": "Attempt is the number of retries of the blob when the attempt failed",
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "stage": {
+                    "$ref": "#/definitions/disperser.FailureStage"
+                },
+                "timestamp": {
+                    "description": "Timestamp is unix epoch time in nanoseconds at which the attempt failed",
+                    "type": "integer"
+                }
+            }
+        },
+        "disperser.FailureStage": {
+            "type": "string",
+            "enum": [
+                "encoding",
+                "dispersal",
+                "aggregation",
+                "confirmation",
+                "metadata_update"
+            ],
+            "x-enum-varnames": [
+                "EncodingStage",
+                "DispersalStage",
+                "AggregationStage",
+                "ConfirmationStage",
+                "MetadataUpdateStage"
+            ]
+        },
         "github_com_Layr-Labs_eigenda_disperser.BlobStatus": {
             "type": "integer",
             "enum": [
@@ -435,14 +633,16 @@ const docTemplate = `{
                 1,
                 2,
                 3,
-                4
+                4,
+                5
             ],
             "x-enum-varnames": [
                 "Processing",
                 "Confirmed",
                 "Failed",
                 "Finalized",
-                "InsufficientSignatures"
+                "InsufficientSignatures",
+                "Cancelled"
             ]
         }
     }
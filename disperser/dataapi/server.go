@@ -2,11 +2,13 @@ package dataapi
 
 import (
 	"context"
+	"crypto/subtle"
 	"errors"
 	"net/http"
 	"os"
 	"os/signal"
 	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
@@ -26,9 +28,16 @@ import (
 const (
 	maxWorkerPoolLimit   = 10
 	maxQueryBatchesLimit = 2
+
+	maxDeadLetterBlobsLimit = 1000
 )
 
-var errNotFound = errors.New("not found")
+var (
+	errNotFound     = errors.New("not found")
+	errBadRequest   = errors.New("bad request")
+	errUnauthorized = errors.New("unauthorized")
+	errForbidden    = errors.New("admin api is disabled")
+)
 
 type (
 	BlobMetadataResponse struct {
@@ -48,6 +57,21 @@ type (
 		BlobStatus              disperser.BlobStatus  `json:"blob_status"`
 	}
 
+	DeadLetterBlobResponse struct {
+		BlobKey        string                   `json:"blob_key"`
+		SecurityParams []*core.SecurityParam    `json:"security_params"`
+		RequestAt      uint64                   `json:"requested_at"`
+		BlobStatus     disperser.BlobStatus     `json:"blob_status"`
+		NumRetries     uint                     `json:"num_retries"`
+		FailureHistory []*disperser.BlobFailure `json:"failure_history"`
+	}
+
+	DeadLetterBlobsResponse struct {
+		Meta          Meta                      `json:"meta"`
+		Data          []*DeadLetterBlobResponse `json:"data"`
+		NextPageToken string                    `json:"next_page_token"`
+	}
+
 	Metric struct {
 		Throughput float64 `json:"throughput"`
 		CostInGas  float64 `json:"cost_in_gas"`
@@ -76,6 +100,7 @@ type (
 		serverMode     string
 		socketAddr     string
 		allowOrigins   []string
+		adminAPIKey    string
 		logger         common.Logger
 		blobstore      disperser.BlobStore
 		promClient     PrometheusClient
@@ -102,6 +127,7 @@ func NewServer(
 		serverMode:     config.ServerMode,
 		socketAddr:     config.SocketAddr,
 		allowOrigins:   config.AllowOrigins,
+		adminAPIKey:    config.AdminAPIKey,
 		blobstore:      blobstore,
 		promClient:     promClient,
 		subgraphClient: subgraphClient,
@@ -129,6 +155,11 @@ func (s *server) Start() error {
 			feed.GET("/blobs", s.FetchBlobsHandler)
 			feed.GET("/blobs/:blob_key", s.FetchBlobHandler)
 		}
+		deadLetter := v1.Group("/dead_letter")
+		{
+			deadLetter.GET("/blobs", s.FetchDeadLetterBlobsHandler)
+			deadLetter.POST("/blobs/:blob_key/requeue", s.RequeueDeadLetterBlobHandler)
+		}
 		metrics := v1.Group("/metrics")
 		{
 			metrics.GET("/", s.FetchMetricsHandler)
@@ -240,6 +271,90 @@ func (s *server) FetchBlobsHandler(c *gin.Context) {
 	})
 }
 
+// FetchDeadLetterBlobsHandler godoc
+//
+//	@Summary	Fetch a page of the blobs that failed to be dispersed, oldest request first
+//	@Tags		DeadLetter
+//	@Produce	json
+//	@Param		limit		query		int		false	"Limit [default: 10, max: 1000]"
+//	@Param		page_token	query		string	false	"Token of the page, from next_page_token of the previous page"
+//	@Success	200		{object}	DeadLetterBlobsResponse
+//	@Failure	400		{object}	ErrorResponse	"error: Bad request"
+//	@Failure	500		{object}	ErrorResponse	"error: Server error"
+//	@Router		/dead_letter/blobs [get]
+func (s *server) FetchDeadLetterBlobsHandler(c *gin.Context) {
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(f float64) {
+		s.metrics.ObserveLatency("FetchDeadLetterBlobs", f*1000) // make milliseconds
+	}))
+	defer timer.ObserveDuration()
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	if err != nil {
+		limit = 10
+	}
+
+	blobs, nextPageToken, err := s.getDeadLetterBlobs(c.Request.Context(), limit, c.Query("page_token"))
+	if err != nil {
+		s.metrics.IncrementFailedRequestNum("FetchDeadLetterBlobs")
+		errorResponse(c, err)
+		return
+	}
+
+	s.metrics.IncrementSuccessfulRequestNum("FetchDeadLetterBlobs")
+	c.JSON(http.StatusOK, DeadLetterBlobsResponse{
+		Meta: Meta{
+			Size: len(blobs),
+		},
+		Data:          blobs,
+		NextPageToken: nextPageToken,
+	})
+}
+
+// RequeueDeadLetterBlobHandler godoc
+//
+//	@Summary	Move a failed blob back to processing so that it's dispersed again
+//	@Tags		DeadLetter
+//	@Produce	json
+//	@Param		Authorization	header		string	true	"Bearer admin API key"
+//	@Param		blob_key		path		string	true	"Blob Key"
+//	@Success	200				{object}	BlobMetadataResponse
+//	@Failure	400				{object}	ErrorResponse	"error: Bad request"
+//	@Failure	401				{object}	ErrorResponse	"error: Unauthorized"
+//	@Failure	403				{object}	ErrorResponse	"error: Admin API is disabled"
+//	@Failure	404				{object}	ErrorResponse	"error: Not found"
+//	@Failure	409				{object}	ErrorResponse	"error: Blob is not failed"
+//	@Failure	500				{object}	ErrorResponse	"error: Server error"
+//	@Router		/dead_letter/blobs/{blob_key}/requeue [post]
+func (s *server) RequeueDeadLetterBlobHandler(c *gin.Context) {
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(f float64) {
+		s.metrics.ObserveLatency("RequeueDeadLetterBlob", f*1000) // make milliseconds
+	}))
+	defer timer.ObserveDuration()
+
+	if err := s.authorizeAdmin(c); err != nil {
+		s.metrics.IncrementFailedRequestNum("RequeueDeadLetterBlob")
+		errorResponse(c, err)
+		return
+	}
+
+	blobKey := c.Param("blob_key")
+	if err := s.requeueBlob(c.Request.Context(), blobKey); err != nil {
+		s.metrics.IncrementFailedRequestNum("RequeueDeadLetterBlob")
+		errorResponse(c, err)
+		return
+	}
+
+	metadata, err := s.getBlob(c.Request.Context(), blobKey)
+	if err != nil {
+		s.metrics.IncrementFailedRequestNum("RequeueDeadLetterBlob")
+		errorResponse(c, err)
+		return
+	}
+
+	s.metrics.IncrementSuccessfulRequestNum("RequeueDeadLetterBlob")
+	c.JSON(http.StatusOK, metadata)
+}
+
 // FetchMetricsHandler godoc
 //
 //	@Summary	Fetch metrics
@@ -426,12 +541,32 @@ func (s *server) getBlobMetadataByBatchesWithLimit(ctx context.Context, limit in
 	return batches, blobMetadatas, nil
 }
 
+// authorizeAdmin checks that the request carries the admin API key as a bearer token
+func (s *server) authorizeAdmin(c *gin.Context) error {
+	if s.adminAPIKey == "" {
+		return errForbidden
+	}
+	token, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+	if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(s.adminAPIKey)) != 1 {
+		return errUnauthorized
+	}
+	return nil
+}
+
 func errorResponse(c *gin.Context, err error) {
 	_ = c.Error(err)
 	var code int
 	switch {
-	case errors.Is(err, errNotFound):
+	case errors.Is(err, errNotFound), errors.Is(err, disperser.ErrBlobNotFound):
 		code = http.StatusNotFound
+	case errors.Is(err, errBadRequest):
+		code = http.StatusBadRequest
+	case errors.Is(err, errUnauthorized):
+		code = http.StatusUnauthorized
+	case errors.Is(err, errForbidden):
+		code = http.StatusForbidden
+	case errors.Is(err, disperser.ErrBlobNotFailed):
+		code = http.StatusConflict
 	default:
 		code = http.StatusInternalServerError
 	}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	commock "github.com/Layr-Labs/eigenda/common/mock"
@@ -136,6 +137,135 @@ func TestFetchBlobsHandler(t *testing.T) {
 	assert.Equal(t, 2, len(response.Data))
 }
 
+func TestFetchDeadLetterBlobsHandler(t *testing.T) {
+	r := setUpRouter()
+	store := inmem.NewBlobStore()
+	server := dataapi.NewServer(config, store, prometheusClient, subgraphClient, mockTx, mockChainState, &commock.Logger{}, dataapi.NewMetrics("9001", &commock.Logger{}))
+
+	blob := makeTestBlob(0, 80)
+	processingKey := queueBlob(t, &blob, store)
+	failedKeys := make([]disperser.BlobKey, 3)
+	for i := range failedKeys {
+		failedKeys[i] = queueBlob(t, &blob, store)
+		err := store.MarkBlobFailed(context.Background(), failedKeys[i], &disperser.BlobFailure{
+			Stage: disperser.ConfirmationStage,
+			Error: "error confirming batch",
+		})
+		assert.NoError(t, err)
+	}
+
+	r.GET("/v1/dead_letter/blobs", server.FetchDeadLetterBlobsHandler)
+
+	fetch := func(query string) (int, dataapi.DeadLetterBlobsResponse) {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/v1/dead_letter/blobs?"+query, nil)
+		r.ServeHTTP(w, req)
+
+		res := w.Result()
+		defer res.Body.Close()
+
+		data, err := io.ReadAll(res.Body)
+		assert.NoError(t, err)
+
+		var response dataapi.DeadLetterBlobsResponse
+		if res.StatusCode == http.StatusOK {
+			err = json.Unmarshal(data, &response)
+			assert.NoError(t, err)
+		}
+		return res.StatusCode, response
+	}
+
+	statusCode, firstPage := fetch("limit=2")
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, 2, firstPage.Meta.Size)
+	assert.Len(t, firstPage.Data, 2)
+	assert.NotEmpty(t, firstPage.NextPageToken)
+
+	statusCode, secondPage := fetch("limit=2&page_token=" + url.QueryEscape(firstPage.NextPageToken))
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Len(t, secondPage.Data, 1)
+	assert.Empty(t, secondPage.NextPageToken)
+
+	seen := make(map[string]bool)
+	for _, blob := range append(firstPage.Data, secondPage.Data...) {
+		assert.NotEqual(t, processingKey.String(), blob.BlobKey)
+		assert.False(t, seen[blob.BlobKey])
+		seen[blob.BlobKey] = true
+		assert.Equal(t, disperser.Failed, blob.BlobStatus)
+		assert.Len(t, blob.FailureHistory, 1)
+		assert.Equal(t, disperser.ConfirmationStage, blob.FailureHistory[0].Stage)
+		assert.Equal(t, "error confirming batch", blob.FailureHistory[0].Error)
+	}
+	for _, key := range failedKeys {
+		assert.True(t, seen[key.String()])
+	}
+
+	statusCode, _ = fetch("page_token=invalid")
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+	statusCode, _ = fetch("limit=1001")
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+}
+
+func TestRequeueDeadLetterBlobHandler(t *testing.T) {
+	store := inmem.NewBlobStore()
+	adminConfig := dataapi.Config{ServerMode: "test", SocketAddr: ":8080", AdminAPIKey: "secret"}
+	server := dataapi.NewServer(adminConfig, store, prometheusClient, subgraphClient, mockTx, mockChainState, &commock.Logger{}, dataapi.NewMetrics("9001", &commock.Logger{}))
+
+	blob := makeTestBlob(0, 80)
+	key := queueBlob(t, &blob, store)
+	err := store.MarkBlobFailed(context.Background(), key, &disperser.BlobFailure{Stage: disperser.AggregationStage, Error: "error aggregating signatures"})
+	assert.NoError(t, err)
+
+	requeue := func(server interface{ RequeueDeadLetterBlobHandler(*gin.Context) }, blobKey string, token string) *http.Response {
+		r := setUpRouter()
+		r.POST("/v1/dead_letter/blobs/:blob_key/requeue", server.RequeueDeadLetterBlobHandler)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/v1/dead_letter/blobs/"+blobKey+"/requeue", nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		r.ServeHTTP(w, req)
+		return w.Result()
+	}
+
+	// The admin endpoints are disabled without an admin API key
+	res := requeue(testDataApiServer, key.String(), "secret")
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+
+	res = requeue(server, key.String(), "")
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+	res = requeue(server, key.String(), "wrong")
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+	res = requeue(server, "invalid", "secret")
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+
+	res = requeue(server, key.String(), "secret")
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	data, err := io.ReadAll(res.Body)
+	assert.NoError(t, err)
+	var response dataapi.BlobMetadataResponse
+	err = json.Unmarshal(data, &response)
+	assert.NoError(t, err)
+	assert.Equal(t, key.String(), response.BlobKey)
+	assert.Equal(t, disperser.Processing, response.BlobStatus)
+
+	metadata, err := store.GetBlobMetadata(context.Background(), key)
+	assert.NoError(t, err)
+	assert.Equal(t, disperser.Processing, metadata.BlobStatus)
+	assert.Equal(t, uint(0), metadata.NumRetries)
+	assert.Len(t, metadata.FailureHistory, 1)
+
+	// Only failed blobs can be requeued
+	res = requeue(server, key.String(), "secret")
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusConflict, res.StatusCode)
+}
+
 func TestFetchMetricsHandler(t *testing.T) {
 	defer goleak.VerifyNone(t)
 
@@ -5,7 +5,10 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Layr-Labs/eigenda/common"
 	"github.com/Layr-Labs/eigenda/core"
@@ -25,6 +28,7 @@ const (
 	Failed
 	Finalized
 	InsufficientSignatures
+	Cancelled
 )
 
 var enumStrings = map[BlobStatus]string{
@@ -33,6 +37,7 @@ var enumStrings = map[BlobStatus]string{
 	Failed:                 "Failed",
 	Finalized:              "Finalized",
 	InsufficientSignatures: "InsufficientSignatures",
+	Cancelled:              "Cancelled",
 }
 
 func (bs BlobStatus) String() string {
@@ -54,6 +59,44 @@ func (mk BlobKey) String() string {
 	return fmt.Sprintf("%s-%s", mk.BlobHash, mk.MetadataHash)
 }
 
+// BlobStoreExclusiveStartKey is the cursor of a paginated query of blob metadata by status.
+// The next page starts after the blob it identifies.
+type BlobStoreExclusiveStartKey struct {
+	BlobHash     BlobHash
+	MetadataHash MetadataHash
+	BlobStatus   int32 // BlobStatus is an integer
+	RequestedAt  int64 // RequestedAt is epoch time in nanoseconds
+}
+
+// FormatPageToken encodes the key to start the next page of blobs from as <requested at>-<blob key>, for the APIs
+// that list the blobs with a status one page at a time
+func FormatPageToken(key *BlobStoreExclusiveStartKey) string {
+	blobKey := BlobKey{BlobHash: key.BlobHash, MetadataHash: key.MetadataHash}
+	return fmt.Sprintf("%d-%s", key.RequestedAt, blobKey.String())
+}
+
+// ParsePageToken decodes a page token returned by FormatPageToken for a page of blobs with the given status
+func ParsePageToken(token string, blobStatus BlobStatus) (*BlobStoreExclusiveStartKey, error) {
+	requestedAt, key, ok := strings.Cut(token, "-")
+	if !ok {
+		return nil, fmt.Errorf("invalid page token: %s", token)
+	}
+	parsedRequestedAt, err := strconv.ParseInt(requestedAt, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid page token: %s", token)
+	}
+	blobKey, err := ParseBlobKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid page token: %s", token)
+	}
+	return &BlobStoreExclusiveStartKey{
+		BlobHash:     blobKey.BlobHash,
+		MetadataHash: blobKey.MetadataHash,
+		BlobStatus:   int32(blobStatus),
+		RequestedAt:  parsedRequestedAt,
+	}, nil
+}
+
 func ParseBlobKey(key string) (BlobKey, error) {
 	parts := strings.Split(key, "-")
 	if len(parts) != 2 {
@@ -74,6 +117,18 @@ type BlobMetadata struct {
 	// NumRetries is the number of times the blob has been retried
 	// After few failed attempts, the blob will be marked as failed
 	NumRetries uint `json:"num_retries"`
+	// NotBefore is unix epoch time in nanoseconds before which the blob won't be retried
+	// This field is zero if the blob can be dispersed right away
+	NotBefore uint64 `json:"not_before"`
+	// FailureHistory is the list of the most recent failed attempts to disperse the blob, oldest first
+	// At most MaxFailureHistoryLength failures are kept
+	FailureHistory []*BlobFailure `json:"failure_history"`
+	// NotifiedStatus is the last status that was delivered to the callback URL of the blob
+	// A notification is pending while the blob is in a notified status other than this one
+	NotifiedStatus BlobStatus `json:"notified_status"`
+	// AbandonedNotificationStatus is the last status whose delivery was given up on after too many failed attempts
+	// The notification of this status isn't pending anymore, so it's not delivered again when the batcher restarts
+	AbandonedNotificationStatus BlobStatus `json:"abandoned_notification_status"`
 	// RequestMetadata is the request metadata of the blob when it was requested
 	// This field is omitted when marshalling to DynamoDB attributevalue as this field will be flattened
 	RequestMetadata *RequestMetadata `json:"request_metadata" dynamodbav:"-"`
@@ -90,6 +145,37 @@ func (m *BlobMetadata) GetBlobKey() BlobKey {
 	}
 }
 
+// LastFailure returns the most recent failed attempt to disperse the blob, or nil if no attempt has failed
+func (m *BlobMetadata) LastFailure() *BlobFailure {
+	if len(m.FailureHistory) == 0 {
+		return nil
+	}
+	return m.FailureHistory[len(m.FailureHistory)-1]
+}
+
+// IsEligibleForDispersal returns whether the blob is not waiting for a retry delay at the given time
+func (m *BlobMetadata) IsEligibleForDispersal(now time.Time) bool {
+	return m.NotBefore <= uint64(now.UnixNano())
+}
+
+// HasPendingNotification returns whether the current status of the blob still has to be delivered to its callback URL
+func (m *BlobMetadata) HasPendingNotification() bool {
+	if m.RequestMetadata == nil || m.RequestMetadata.CallbackURL == "" {
+		return false
+	}
+	return IsNotifiedStatus(m.BlobStatus) && m.NotifiedStatus != m.BlobStatus && m.AbandonedNotificationStatus != m.BlobStatus
+}
+
+// IsNotifiedStatus returns whether the callback URL of a blob is notified when it reaches the given status
+func IsNotifiedStatus(status BlobStatus) bool {
+	switch status {
+	case Confirmed, InsufficientSignatures, Failed, Finalized:
+		return true
+	default:
+		return false
+	}
+}
+
 func (m *BlobMetadata) IsConfirmed() (bool, error) {
 	if m.BlobStatus != Confirmed && m.BlobStatus != Finalized {
 		return false, nil
@@ -101,6 +187,97 @@ func (m *BlobMetadata) IsConfirmed() (bool, error) {
 	return true, nil
 }
 
+// FailureStage is the stage of the dispersal pipeline at which an attempt failed
+type FailureStage string
+
+const (
+	EncodingStage       FailureStage = "encoding"
+	DispersalStage      FailureStage = "dispersal"
+	AggregationStage    FailureStage = "aggregation"
+	ConfirmationStage   FailureStage = "confirmation"
+	MetadataUpdateStage FailureStage = "metadata_update"
+	// AdminStage is used when a blob is failed by an operator through the admin API
+	AdminStage FailureStage = "admin"
+)
+
+// MaxFailureHistoryLength is the maximum number of failures kept in the failure history of a blob.
+// Encoding failures don't count towards the retries, so the history has to be bounded.
+const MaxFailureHistoryLength = 10
+
+// BlobFailure records a failed attempt to disperse a blob
+type BlobFailure struct {
+	Stage FailureStage `json:"stage"`
+	Error string       `json:"error"`
+	// Attempt is the number of retries of the blob when the attempt failed
+	Attempt uint `json:"attempt"`
+	// Timestamp is unix epoch time in nanoseconds at which the attempt failed
+	Timestamp uint64 `json:"timestamp"`
+}
+
+// AppendBlobFailure returns a new failure history with the failure appended, dropping the oldest failures
+// so that the history has at most MaxFailureHistoryLength entries
+func AppendBlobFailure(history []*BlobFailure, failure *BlobFailure) []*BlobFailure {
+	start := 0
+	if len(history) >= MaxFailureHistoryLength {
+		start = len(history) - MaxFailureHistoryLength + 1
+	}
+	newHistory := make([]*BlobFailure, 0, len(history)-start+1)
+	newHistory = append(newHistory, history[start:]...)
+	return append(newHistory, failure)
+}
+
+// PaginateBlobMetadata orders the metadata by request time and returns the page of at most limit metadata that
+// starts after exclusiveStartKey, along with the key to start the next page from (nil if there are no more metadata).
+// A limit of 0 or less returns all the remaining metadata. Ties in request time are broken by blob key.
+// It's meant for blob stores that don't keep a sorted status index.
+func PaginateBlobMetadata(metadatas []*BlobMetadata, limit int32, exclusiveStartKey *BlobStoreExclusiveStartKey) ([]*BlobMetadata, *BlobStoreExclusiveStartKey) {
+	sorted := make([]*BlobMetadata, len(metadatas))
+	copy(sorted, metadatas)
+	sort.Slice(sorted, func(i, j int) bool {
+		return newBlobStoreExclusiveStartKey(sorted[i]).less(newBlobStoreExclusiveStartKey(sorted[j]))
+	})
+
+	start := 0
+	if exclusiveStartKey != nil {
+		start = sort.Search(len(sorted), func(i int) bool {
+			return exclusiveStartKey.less(newBlobStoreExclusiveStartKey(sorted[i]))
+		})
+	}
+	end := len(sorted)
+	if limit > 0 && start+int(limit) < end {
+		end = start + int(limit)
+	}
+
+	if end == len(sorted) {
+		return sorted[start:end], nil
+	}
+	lastEvaluatedKey := newBlobStoreExclusiveStartKey(sorted[end-1])
+	return sorted[start:end], &lastEvaluatedKey
+}
+
+func newBlobStoreExclusiveStartKey(metadata *BlobMetadata) BlobStoreExclusiveStartKey {
+	key := BlobStoreExclusiveStartKey{
+		BlobHash:     metadata.BlobHash,
+		MetadataHash: metadata.MetadataHash,
+		BlobStatus:   int32(metadata.BlobStatus),
+	}
+	if metadata.RequestMetadata != nil {
+		key.RequestedAt = int64(metadata.RequestMetadata.RequestedAt)
+	}
+	return key
+}
+
+// less orders keys by request time, then by blob key
+func (k BlobStoreExclusiveStartKey) less(other BlobStoreExclusiveStartKey) bool {
+	if k.RequestedAt != other.RequestedAt {
+		return k.RequestedAt < other.RequestedAt
+	}
+	if k.BlobHash != other.BlobHash {
+		return k.BlobHash < other.BlobHash
+	}
+	return k.MetadataHash < other.MetadataHash
+}
+
 type RequestMetadata struct {
 	core.BlobRequestHeader
 	BlobSize    uint   `json:"blob_size"`
@@ -124,35 +301,93 @@ type ConfirmationInfo struct {
 	BlobQuorumInfos         []*core.BlobQuorumInfo               `json:"blob_quorum_infos"`
 }
 
+// PendingBatch is the confirmation that the blobs of a batch will have once the batch is confirmed on chain.
+// It's recorded before the batch is confirmed, so that the blobs can be marked as confirmed if the batcher stops
+// in between.
+type PendingBatch struct {
+	BatchHeaderHash      [32]byte `json:"batch_header_hash"`
+	ReferenceBlockNumber uint32   `json:"reference_block_number"`
+	// Blobs has the pending confirmation of each blob of the batch, in the order of the batch
+	Blobs []*PendingConfirmation `json:"blobs"`
+}
+
+// PendingConfirmation is the confirmation of a blob in a batch that's being confirmed on chain
+type PendingConfirmation struct {
+	BlobKey BlobKey `json:"blob_key"`
+	// Status is the status of the blob once the batch is confirmed, either Confirmed or InsufficientSignatures
+	Status BlobStatus `json:"status"`
+	// ConfirmationInfo is the confirmation info of the blob without the BatchID, ConfirmationTxnHash and
+	// ConfirmationBlockNumber, which are only known once the batch is confirmed
+	ConfirmationInfo *ConfirmationInfo `json:"confirmation_info"`
+}
+
 type BlobStore interface {
 	// StoreBlob adds a blob to the queue and returns a key that can be used to retrieve the blob later
 	StoreBlob(ctx context.Context, blob *core.Blob, requestedAt uint64) (BlobKey, error)
 	// GetBlobContent retrieves a blob's content
 	GetBlobContent(ctx context.Context, blobHash BlobHash) ([]byte, error)
-	// MarkBlobConfirmed updates blob metadata to Confirmed status with confirmation info
-	// Returns the updated metadata and error
+	// MarkBlobConfirmed updates the metadata of a processing blob to Confirmed status with confirmation info
+	// Returns the updated metadata and error, which is ErrBlobNotProcessing if the blob is in any other status
 	MarkBlobConfirmed(ctx context.Context, existingMetadata *BlobMetadata, confirmationInfo *ConfirmationInfo) (*BlobMetadata, error)
-	// MarkBlobInsufficientSignatures updates blob metadata to InsufficientSignatures status with confirmation info
-	// Returns the updated metadata and error
+	// MarkBlobInsufficientSignatures updates the metadata of a processing blob to InsufficientSignatures status with
+	// confirmation info
+	// Returns the updated metadata and error, which is ErrBlobNotProcessing if the blob is in any other status
 	MarkBlobInsufficientSignatures(ctx context.Context, existingMetadata *BlobMetadata, confirmationInfo *ConfirmationInfo) (*BlobMetadata, error)
 	// MarkBlobFinalized marks a blob as finalized
 	MarkBlobFinalized(ctx context.Context, blobKey BlobKey) error
 	// MarkBlobProcessing marks a blob as processing
+	// Returns ErrBlobCancelled if the blob was cancelled
 	MarkBlobProcessing(ctx context.Context, blobKey BlobKey) error
-	// MarkBlobFailed marks a blob as failed
-	MarkBlobFailed(ctx context.Context, blobKey BlobKey) error
-	// IncrementBlobRetryCount increments the retry count of a blob
-	IncrementBlobRetryCount(ctx context.Context, existingMetadata *BlobMetadata) error
+	// MarkBlobFailed marks a processing blob as failed and appends the failure to its failure history
+	// Returns ErrBlobNotProcessing if the blob is in any other status
+	MarkBlobFailed(ctx context.Context, blobKey BlobKey, failure *BlobFailure) error
+	// MarkBlobCancelled marks a blob as cancelled if it's still processing
+	// Returns ErrBlobNotProcessing if the blob is in any other status
+	MarkBlobCancelled(ctx context.Context, blobKey BlobKey) error
+	// IncrementBlobRetryCount increments the retry count of a blob, appends the failure to its failure history and
+	// delays the next attempt until notBefore (unix epoch time in nanoseconds, zero for no delay)
+	// Returns ErrBlobNotProcessing if the blob is no longer processing
+	IncrementBlobRetryCount(ctx context.Context, existingMetadata *BlobMetadata, failure *BlobFailure, notBefore uint64) error
+	// RecordBlobFailure appends the failure to the failure history of a blob without changing its status or retry count
+	RecordBlobFailure(ctx context.Context, blobKey BlobKey, failure *BlobFailure) error
+	// RequeueBlob moves a failed blob back to processing and resets its retry count, delay and notified status,
+	// keeping its failure history
+	// Returns ErrBlobNotFailed if the blob is in any other status
+	RequeueBlob(ctx context.Context, blobKey BlobKey) error
+	// SetPendingBatch records the confirmation that the blobs of a batch will have once the batch is confirmed on
+	// chain, replacing any previous record of the batch
+	SetPendingBatch(ctx context.Context, pendingBatch *PendingBatch) error
+	// GetPendingBatches returns the recorded pending batches
+	GetPendingBatches(ctx context.Context) ([]*PendingBatch, error)
+	// RemovePendingBatch removes the record of a pending batch, if there is one
+	RemovePendingBatch(ctx context.Context, batchHeaderHash [32]byte) error
+	// RequeueConfirmedBlob moves a confirmed blob back to processing, clearing its confirmation info and resetting its
+	// notified and abandoned notification statuses, e.g. because its confirmation transaction was dropped by a reorg
+	// Returns ErrBlobNotConfirmed if the blob is in any other status
+	RequeueConfirmedBlob(ctx context.Context, blobKey BlobKey) error
+	// MarkBlobNotified records that the given status of a blob was delivered to its callback URL
+	MarkBlobNotified(ctx context.Context, blobKey BlobKey, status BlobStatus) error
+	// MarkBlobNotificationAbandoned records that the delivery of the given status of a blob was given up on
+	MarkBlobNotificationAbandoned(ctx context.Context, blobKey BlobKey, status BlobStatus) error
 	// GetBlobsByMetadata retrieves a list of blobs given a list of metadata
 	GetBlobsByMetadata(ctx context.Context, metadata []*BlobMetadata) (map[BlobKey]*core.Blob, error)
 	// GetBlobMetadataByStatus returns a list of blob metadata for blobs with the given status
 	GetBlobMetadataByStatus(ctx context.Context, blobStatus BlobStatus) ([]*BlobMetadata, error)
+	// GetBlobMetadataByStatusWithPagination returns at most limit blob metadata with the given status, ordered by
+	// request time and starting after exclusiveStartKey (from the beginning if it's nil).
+	// It also returns the key to start the next page from, which is nil once all the blobs have been returned.
+	GetBlobMetadataByStatusWithPagination(ctx context.Context, blobStatus BlobStatus, limit int32, exclusiveStartKey *BlobStoreExclusiveStartKey) ([]*BlobMetadata, *BlobStoreExclusiveStartKey, error)
 	// GetMetadataInBatch returns the metadata in a given batch at given index.
 	GetMetadataInBatch(ctx context.Context, batchHeaderHash [32]byte, blobIndex uint32) (*BlobMetadata, error)
 	// GetAllBlobMetadataByBatch returns the metadata of all the blobs in the batch.
 	GetAllBlobMetadataByBatch(ctx context.Context, batchHeaderHash [32]byte) ([]*BlobMetadata, error)
+	// GetAllBlobMetadataByContent returns the metadata of all the blobs with the given data.
+	GetAllBlobMetadataByContent(ctx context.Context, data []byte) ([]*BlobMetadata, error)
 	// GetBlobMetadata returns a blob metadata given a metadata key
 	GetBlobMetadata(ctx context.Context, blobKey BlobKey) (*BlobMetadata, error)
+	// GetBulkBlobMetadata returns the metadata of the blobs with the given keys in a single read where the store allows it.
+	// The blobs that aren't found are left out, and the metadata aren't necessarily in the order of the keys.
+	GetBulkBlobMetadata(ctx context.Context, blobKeys []BlobKey) ([]*BlobMetadata, error)
 }
 
 type Dispatcher interface {
@@ -192,6 +427,9 @@ func FromBlobStatusProto(status disperser_rpc.BlobStatus) (*BlobStatus, error) {
 	case disperser_rpc.BlobStatus_FINALIZED:
 		res = Finalized
 		return &res, nil
+	case disperser_rpc.BlobStatus_CANCELLED:
+		res = Cancelled
+		return &res, nil
 	}
 
 	return nil, fmt.Errorf("unknown blob status: %v", status)
@@ -2,14 +2,27 @@ package encoder
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/Layr-Labs/eigenda/core"
 	"github.com/Layr-Labs/eigenda/disperser"
 	pb "github.com/Layr-Labs/eigenda/disperser/api/grpc/encoder"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// streamRecvMsgSize is the maximum size of a frame received from EncodeBlobStream and EncodeBlobs. The frames hold
+	// at most MaxStreamFrameSize bytes of chunks, or a single chunk that is larger than the frame size.
+	streamRecvMsgSize = 2 * MaxStreamFrameSize
+	// unaryRecvMsgSize is the maximum size of an EncodeBlob reply, which holds all the chunks of the blob. It's only
+	// received from the servers that don't implement EncodeBlobStream.
+	unaryRecvMsgSize = 300 * 1024 * 1024 // 300 MiB
 )
 
 type client struct {
@@ -25,33 +38,96 @@ func NewEncoderClient(addr string, timeout time.Duration) (disperser.EncoderClie
 }
 
 func (c client) EncodeBlob(ctx context.Context, data []byte, encodingParams core.EncodingParams) (*core.BlobCommitments, []*core.Chunk, error) {
-	conn, err := grpc.Dial(
-		c.addr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(1024*1024*1024)), // 1 GiB
-	)
+	conn, err := dialEncoder(c.addr)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to dial encoder: %w", err)
 	}
 	defer conn.Close()
 
+	return encodeBlob(ctx, conn, data, encodingParams)
+}
+
+func (c client) EncodeBlobs(ctx context.Context, blobs [][]byte, encodingParams core.EncodingParams) ([]core.BlobEncodingResult, error) {
+	conn, err := dialEncoder(c.addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial encoder: %w", err)
+	}
+	defer conn.Close()
+
+	return encodeBlobs(ctx, conn, blobs, encodingParams)
+}
+
+func dialEncoder(addr string) (*grpc.ClientConn, error) {
+	return grpc.Dial(
+		addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(streamRecvMsgSize)),
+	)
+}
+
+// encodeBlob sends an encoding request to the encoder server at the other end of conn. The reply is streamed, and the
+// chunks are deserialized as they arrive. Servers that don't implement the streaming RPC are sent a unary request.
+func encodeBlob(ctx context.Context, conn *grpc.ClientConn, data []byte, encodingParams core.EncodingParams) (*core.BlobCommitments, []*core.Chunk, error) {
+	// Canceling the context releases the stream if it isn't read to the end
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
 	encoder := pb.NewEncoderClient(conn)
-	reply, err := encoder.EncodeBlob(ctx, &pb.EncodeBlobRequest{
+	request := &pb.EncodeBlobRequest{
 		Data: data,
 		EncodingParams: &pb.EncodingParams{
 			ChunkLength: uint32(encodingParams.ChunkLength),
 			NumChunks:   uint32(encodingParams.NumChunks),
 		},
-	})
+	}
+
+	stream, err := encoder.EncodeBlobStream(ctx, request)
+	if err != nil {
+		return nil, nil, err
+	}
+	first, err := stream.Recv()
+	if status.Code(err) == codes.Unimplemented {
+		return encodeBlobUnary(ctx, encoder, request)
+	}
 	if err != nil {
 		return nil, nil, err
 	}
 
-	commitment, err := new(core.Commitment).Deserialize(reply.GetCommitment().GetCommitment())
+	commitments, err := deserializeCommitments(first.GetCommitment())
 	if err != nil {
 		return nil, nil, err
 	}
-	lengthProof, err := new(core.Commitment).Deserialize(reply.GetCommitment().GetLengthProof())
+	numChunks := int(first.GetNumChunks())
+	chunks := make([]*core.Chunk, 0, numChunks)
+	for {
+		frame, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, chunk := range frame.GetChunks() {
+			deserialized, err := new(core.Chunk).Deserialize(chunk)
+			if err != nil {
+				return nil, nil, err
+			}
+			chunks = append(chunks, deserialized)
+		}
+	}
+	if len(chunks) != numChunks {
+		return nil, nil, fmt.Errorf("encoder streamed %d chunks, expected %d", len(chunks), numChunks)
+	}
+	return commitments, chunks, nil
+}
+
+func encodeBlobUnary(ctx context.Context, encoder pb.EncoderClient, request *pb.EncodeBlobRequest) (*core.BlobCommitments, []*core.Chunk, error) {
+	reply, err := encoder.EncodeBlob(ctx, request, grpc.MaxCallRecvMsgSize(unaryRecvMsgSize))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	commitments, err := deserializeCommitments(reply.GetCommitment())
 	if err != nil {
 		return nil, nil, err
 	}
@@ -63,9 +139,131 @@ func (c client) EncodeBlob(ctx context.Context, data []byte, encodingParams core
 		}
 		chunks[i] = deserialized
 	}
+	return commitments, chunks, nil
+}
+
+// encodeBlobs sends a request to encode several blobs to the encoder server at the other end of conn. The reply is
+// streamed blob by blob, and the chunks are deserialized as they arrive. Servers that don't implement EncodeBlobs are
+// sent a request per blob.
+func encodeBlobs(ctx context.Context, conn *grpc.ClientConn, blobs [][]byte, encodingParams core.EncodingParams) ([]core.BlobEncodingResult, error) {
+	// Canceling the context releases the stream if it isn't read to the end
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	encoder := pb.NewEncoderClient(conn)
+	stream, err := encoder.EncodeBlobs(ctx, &pb.EncodeBlobsRequest{
+		Data: blobs,
+		EncodingParams: &pb.EncodingParams{
+			ChunkLength: uint32(encodingParams.ChunkLength),
+			NumChunks:   uint32(encodingParams.NumChunks),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]core.BlobEncodingResult, len(blobs))
+	// current is the index of the blob whose frames are being received, and numChunks is the number of chunks the
+	// encoder announced for it
+	current := -1
+	numChunks := 0
+	checkCurrent := func() error {
+		if current >= 0 && results[current].Err == nil && len(results[current].Chunks) != numChunks {
+			return fmt.Errorf("encoder streamed %d chunks for blob %d, expected %d", len(results[current].Chunks), current, numChunks)
+		}
+		return nil
+	}
+	for {
+		frame, err := stream.Recv()
+		if current < 0 && status.Code(err) == codes.Unimplemented {
+			return encodeBlobsOneByOne(ctx, conn, blobs, encodingParams), nil
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if frame.GetCommitment() != nil || frame.GetError() != "" {
+			// The first frame of the next blob
+			if err := checkCurrent(); err != nil {
+				return nil, err
+			}
+			if int(frame.GetBlobIndex()) != current+1 || int(frame.GetBlobIndex()) >= len(blobs) {
+				return nil, fmt.Errorf("encoder streamed blob %d after blob %d of %d blobs", frame.GetBlobIndex(), current, len(blobs))
+			}
+			current++
+			if frame.GetError() != "" {
+				results[current] = core.BlobEncodingResult{Err: errors.New(frame.GetError())}
+				continue
+			}
+			commitments, err := deserializeCommitments(frame.GetCommitment())
+			if err != nil {
+				results[current] = core.BlobEncodingResult{Err: err}
+				continue
+			}
+			numChunks = int(frame.GetNumChunks())
+			results[current] = core.BlobEncodingResult{
+				Commitments: *commitments,
+				Chunks:      make([]*core.Chunk, 0, numChunks),
+			}
+			continue
+		}
+
+		if current < 0 || int(frame.GetBlobIndex()) != current {
+			return nil, fmt.Errorf("encoder streamed chunks of blob %d while streaming blob %d", frame.GetBlobIndex(), current)
+		}
+		if results[current].Err != nil {
+			continue
+		}
+		for _, chunk := range frame.GetChunks() {
+			deserialized, err := new(core.Chunk).Deserialize(chunk)
+			if err != nil {
+				results[current] = core.BlobEncodingResult{Err: err}
+				break
+			}
+			results[current].Chunks = append(results[current].Chunks, deserialized)
+		}
+	}
+	if err := checkCurrent(); err != nil {
+		return nil, err
+	}
+	if current != len(blobs)-1 {
+		return nil, fmt.Errorf("encoder streamed %d results for %d blobs", current+1, len(blobs))
+	}
+	return results, nil
+}
+
+// encodeBlobsOneByOne encodes the blobs with a request per blob
+func encodeBlobsOneByOne(ctx context.Context, conn *grpc.ClientConn, blobs [][]byte, encodingParams core.EncodingParams) []core.BlobEncodingResult {
+	results := make([]core.BlobEncodingResult, len(blobs))
+	for i, blob := range blobs {
+		commitments, chunks, err := encodeBlob(ctx, conn, blob, encodingParams)
+		if err != nil {
+			results[i] = core.BlobEncodingResult{Err: err}
+			continue
+		}
+		results[i] = core.BlobEncodingResult{
+			Commitments: *commitments,
+			Chunks:      chunks,
+		}
+	}
+	return results
+}
+
+func deserializeCommitments(commitment *pb.BlobCommitment) (*core.BlobCommitments, error) {
+	blobCommitment, err := new(core.Commitment).Deserialize(commitment.GetCommitment())
+	if err != nil {
+		return nil, err
+	}
+	lengthProof, err := new(core.Commitment).Deserialize(commitment.GetLengthProof())
+	if err != nil {
+		return nil, err
+	}
 	return &core.BlobCommitments{
-		Commitment:  commitment,
+		Commitment:  blobCommitment,
 		LengthProof: lengthProof,
-		Length:      uint(reply.GetCommitment().GetLength()),
-	}, chunks, nil
+		Length:      uint(commitment.GetLength()),
+	}, nil
 }
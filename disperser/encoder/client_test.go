@@ -0,0 +1,88 @@
+package encoder
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Layr-Labs/eigenda/core"
+	pb "github.com/Layr-Labs/eigenda/disperser/api/grpc/encoder"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// unaryEncoderServer only implements the unary EncodeBlob RPC, like the encoder servers that predate streaming
+type unaryEncoderServer struct {
+	pb.UnimplementedEncoderServer
+	encoder pb.EncoderServer
+}
+
+func (s *unaryEncoderServer) EncodeBlob(ctx context.Context, req *pb.EncodeBlobRequest) (*pb.EncodeBlobReply, error) {
+	return s.encoder.EncodeBlob(ctx, req)
+}
+
+func TestClientEncodeBlob(t *testing.T) {
+	config := testServerConfig
+	config.StreamFrameSize = 1
+	server := NewServer(config, logger, testEncoder, NewMetrics("9000", logger))
+	streamingAddr, _ := startPoolTestServer(t, server)
+	unaryAddr, _ := startPoolTestServer(t, &unaryEncoderServer{encoder: server})
+
+	testBlob, testEncodingParams := getTestData()
+	expectedCommitments, expectedChunks, err := testEncoder.Encode(testBlob.Data, testEncodingParams)
+	require.NoError(t, err)
+
+	// The streamed reply and the unary reply of servers without streaming are assembled into the same result
+	for _, addr := range []string{streamingAddr, unaryAddr} {
+		client, err := NewEncoderClient(addr, 0)
+		require.NoError(t, err)
+		commitments, chunks, err := client.EncodeBlob(context.Background(), testBlob.Data, testEncodingParams)
+		require.NoError(t, err)
+
+		assert.Equal(t, expectedCommitments.Length, commitments.Length)
+		assert.Equal(t, expectedCommitments.Commitment, commitments.Commitment)
+		assert.Equal(t, expectedCommitments.LengthProof, commitments.LengthProof)
+		assertSameChunks(t, expectedChunks, chunks)
+	}
+}
+
+func assertSameChunks(t *testing.T, expected []*core.Chunk, actual []*core.Chunk) {
+	require.Len(t, actual, len(expected))
+	for i := range expected {
+		expectedSerialized, err := expected[i].Serialize()
+		require.NoError(t, err)
+		actualSerialized, err := actual[i].Serialize()
+		require.NoError(t, err)
+		assert.Equal(t, expectedSerialized, actualSerialized)
+	}
+}
+
+func TestClientEncodeBlobs(t *testing.T) {
+	config := testServerConfig
+	config.StreamFrameSize = 1
+	server := NewServer(config, logger, testEncoder, NewMetrics("9000", logger))
+	streamingAddr, _ := startPoolTestServer(t, server)
+	unaryAddr, _ := startPoolTestServer(t, &unaryEncoderServer{encoder: server})
+
+	testBlob, testEncodingParams := getTestData()
+	blobs := [][]byte{testBlob.Data, []byte("small blob"), make([]byte, 32*testEncodingParams.ChunkLength*testEncodingParams.NumChunks)}
+
+	// Servers without EncodeBlobs are sent a request per blob
+	for _, addr := range []string{streamingAddr, unaryAddr} {
+		client, err := NewEncoderClient(addr, 0)
+		require.NoError(t, err)
+		results, err := client.EncodeBlobs(context.Background(), blobs, testEncodingParams)
+		require.NoError(t, err)
+		require.Len(t, results, len(blobs))
+
+		for i, blob := range blobs[:2] {
+			assert.NoError(t, results[i].Err)
+			expectedCommitments, expectedChunks, err := testEncoder.Encode(blob, testEncodingParams)
+			require.NoError(t, err)
+			assert.Equal(t, expectedCommitments.Commitment, results[i].Commitments.Commitment)
+			assert.Equal(t, expectedCommitments.LengthProof, results[i].Commitments.LengthProof)
+			assert.Equal(t, expectedCommitments.Length, results[i].Commitments.Length)
+			assertSameChunks(t, expectedChunks, results[i].Chunks)
+		}
+		assert.ErrorContains(t, results[2].Err, "not sufficient")
+	}
+}
@@ -2,10 +2,31 @@ package encoder
 
 const (
 	Localhost = "0.0.0.0"
+
+	// DefaultStreamFrameSize is the default maximum size in bytes of the chunks sent in a frame of EncodeBlobStream
+	// and EncodeBlobs
+	DefaultStreamFrameSize = 1024 * 1024 // 1 MiB
+
+	// MaxStreamFrameSize is the largest StreamFrameSize a server can be configured with. The clients' receive limit
+	// for the frames is a small multiple of it, which leaves room for a single chunk that is larger than a frame.
+	MaxStreamFrameSize = 4 * 1024 * 1024 // 4 MiB
+
+	// DefaultMaxRequestSize is the default maximum size in bytes of a request that the server receives
+	DefaultMaxRequestSize = 16 * 1024 * 1024 // 16 MiB
+
+	// DefaultMaxBlobsPerRequest is the default maximum number of blobs in an EncodeBlobs request
+	DefaultMaxBlobsPerRequest = 16
 )
 
 type ServerConfig struct {
 	GrpcPort              string
 	MaxConcurrentRequests int
 	RequestPoolSize       int
+	// StreamFrameSize is the maximum size in bytes of the chunks sent in a frame of EncodeBlobStream and EncodeBlobs
+	StreamFrameSize int
+	// MaxRequestSize is the maximum size in bytes of a request, which bounds the total size of the blobs of an
+	// EncodeBlobs request. DefaultMaxRequestSize is used if it's 0.
+	MaxRequestSize int
+	// MaxBlobsPerRequest is the maximum number of blobs in an EncodeBlobs request
+	MaxBlobsPerRequest int
 }
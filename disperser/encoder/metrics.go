@@ -25,6 +25,7 @@ type Metrics struct {
 
 	NumEncodeBlobRequests *prometheus.CounterVec
 	Latency               *prometheus.SummaryVec
+	QueueDepth            prometheus.Gauge
 }
 
 func NewMetrics(httpPort string, logger common.Logger) *Metrics {
@@ -53,6 +54,13 @@ func NewMetrics(httpPort string, logger common.Logger) *Metrics {
 			},
 			[]string{"time"},
 		),
+		QueueDepth: promauto.With(reg).NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: "eigenda_encoder",
+				Name:      "request_queue_depth",
+				Help:      "the number of encode blob requests waiting to be encoded",
+			},
+		),
 	}
 }
 
@@ -85,6 +93,16 @@ func (m *Metrics) TakeLatency(encoding, total time.Duration) {
 	m.Latency.WithLabelValues("total").Observe(float64(total.Milliseconds()))
 }
 
+// TakeQueueingLatency records the time a request waited in the request queue before being encoded
+func (m *Metrics) TakeQueueingLatency(queueing time.Duration) {
+	m.Latency.WithLabelValues("queuing").Observe(float64(queueing.Milliseconds()))
+}
+
+// ObserveQueueDepth sets the number of requests waiting in the request queue
+func (m *Metrics) ObserveQueueDepth(depth int) {
+	m.QueueDepth.Set(float64(depth))
+}
+
 func (m *Metrics) Start(ctx context.Context) {
 	m.logger.Info("Starting metrics server at ", "port", m.httpPort)
 
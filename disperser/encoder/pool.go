@@ -0,0 +1,202 @@
+package encoder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/Layr-Labs/eigenda/common"
+	"github.com/Layr-Labs/eigenda/core"
+	"github.com/Layr-Labs/eigenda/disperser"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const healthCheckTimeout = 3 * time.Second
+
+// EncoderPool is an EncoderClient that spreads the encoding requests over several encoder servers.
+// Each request goes to the healthy server with the fewest outstanding requests, and is retried on another server if
+// it fails, e.g. because the server is rate limiting. Servers are ejected from the pool while they fail their gRPC
+// health checks, unless none of them is healthy.
+type EncoderPool struct {
+	endpoints           []*encoderEndpoint
+	healthCheckInterval time.Duration
+	// next rotates the order in which the endpoints are considered, so that ties are spread over the endpoints
+	next atomic.Uint64
+
+	logger common.Logger
+}
+
+type encoderEndpoint struct {
+	addr string
+	conn *grpc.ClientConn
+	// outstanding is the number of encoding requests sent to the endpoint that haven't returned yet
+	outstanding atomic.Int64
+	// healthy is false while the endpoint fails its health checks
+	healthy atomic.Bool
+}
+
+var _ disperser.EncoderClient = (*EncoderPool)(nil)
+
+// NewEncoderPool connects to the encoder servers at addrs, which are all assumed to be healthy until they're checked
+func NewEncoderPool(addrs []string, healthCheckInterval time.Duration, logger common.Logger) (*EncoderPool, error) {
+	if len(addrs) == 0 {
+		return nil, errors.New("at least one encoder address must be specified")
+	}
+
+	endpoints := make([]*encoderEndpoint, 0, len(addrs))
+	for _, addr := range addrs {
+		conn, err := dialEncoder(addr)
+		if err != nil {
+			for _, endpoint := range endpoints {
+				endpoint.conn.Close()
+			}
+			return nil, fmt.Errorf("failed to dial encoder %s: %w", addr, err)
+		}
+		endpoint := &encoderEndpoint{
+			addr: addr,
+			conn: conn,
+		}
+		endpoint.healthy.Store(true)
+		endpoints = append(endpoints, endpoint)
+	}
+
+	return &EncoderPool{
+		endpoints:           endpoints,
+		healthCheckInterval: healthCheckInterval,
+		logger:              logger,
+	}, nil
+}
+
+// Start checks the health of the encoder servers periodically until the context is done
+func (p *EncoderPool) Start(ctx context.Context) {
+	if p.healthCheckInterval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(p.healthCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				p.checkHealth(ctx)
+			}
+		}
+	}()
+}
+
+// Close closes the connections to the encoder servers
+func (p *EncoderPool) Close() {
+	for _, endpoint := range p.endpoints {
+		if err := endpoint.conn.Close(); err != nil {
+			p.logger.Warn("failed to close encoder connection", "encoder", endpoint.addr, "err", err)
+		}
+	}
+}
+
+func (p *EncoderPool) EncodeBlob(ctx context.Context, data []byte, encodingParams core.EncodingParams) (*core.BlobCommitments, []*core.Chunk, error) {
+	var commitments *core.BlobCommitments
+	var chunks []*core.Chunk
+	err := p.withEncoder(ctx, func(conn *grpc.ClientConn) error {
+		var err error
+		commitments, chunks, err = encodeBlob(ctx, conn, data, encodingParams)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return commitments, chunks, nil
+}
+
+func (p *EncoderPool) EncodeBlobs(ctx context.Context, blobs [][]byte, encodingParams core.EncodingParams) ([]core.BlobEncodingResult, error) {
+	var results []core.BlobEncodingResult
+	err := p.withEncoder(ctx, func(conn *grpc.ClientConn) error {
+		var err error
+		results, err = encodeBlobs(ctx, conn, blobs, encodingParams)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// withEncoder sends the request to the encoders picked by pick until one of them succeeds
+func (p *EncoderPool) withEncoder(ctx context.Context, request func(conn *grpc.ClientConn) error) error {
+	tried := make(map[*encoderEndpoint]bool, len(p.endpoints))
+	var err error
+	for endpoint := p.pick(tried); endpoint != nil; endpoint = p.pick(tried) {
+		tried[endpoint] = true
+
+		endpoint.outstanding.Add(1)
+		requestErr := request(endpoint.conn)
+		endpoint.outstanding.Add(-1)
+		if requestErr == nil {
+			return nil
+		}
+
+		// The request isn't retried once the caller has given up on it
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		p.logger.Warn("encoding request failed, retrying on another encoder", "encoder", endpoint.addr, "err", requestErr)
+		err = requestErr
+	}
+
+	return fmt.Errorf("encoding request failed on %d encoders: %w", len(tried), err)
+}
+
+// pick returns the endpoint with the fewest outstanding requests among the healthy ones that haven't been tried yet.
+// All the endpoints are considered if none of them is healthy. It returns nil if there's no endpoint left to try.
+func (p *EncoderPool) pick(tried map[*encoderEndpoint]bool) *encoderEndpoint {
+	anyHealthy := false
+	for _, endpoint := range p.endpoints {
+		if endpoint.healthy.Load() {
+			anyHealthy = true
+			break
+		}
+	}
+
+	start := p.next.Add(1)
+	var picked *encoderEndpoint
+	var pickedOutstanding int64
+	for i := range p.endpoints {
+		endpoint := p.endpoints[(start+uint64(i))%uint64(len(p.endpoints))]
+		if tried[endpoint] || (anyHealthy && !endpoint.healthy.Load()) {
+			continue
+		}
+		outstanding := endpoint.outstanding.Load()
+		if picked == nil || outstanding < pickedOutstanding {
+			picked = endpoint
+			pickedOutstanding = outstanding
+		}
+	}
+	return picked
+}
+
+// checkHealth updates the health of each endpoint with the status reported by its gRPC health service
+func (p *EncoderPool) checkHealth(ctx context.Context) {
+	for _, endpoint := range p.endpoints {
+		healthy := true
+		checkCtx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+		reply, err := grpc_health_v1.NewHealthClient(endpoint.conn).Check(checkCtx, &grpc_health_v1.HealthCheckRequest{})
+		cancel()
+		if err != nil || reply.GetStatus() != grpc_health_v1.HealthCheckResponse_SERVING {
+			healthy = false
+		}
+
+		if wasHealthy := endpoint.healthy.Swap(healthy); wasHealthy != healthy {
+			if healthy {
+				p.logger.Info("encoder is healthy again, adding it back to the pool", "encoder", endpoint.addr)
+			} else {
+				p.logger.Warn("encoder failed its health check, ejecting it from the pool", "encoder", endpoint.addr, "status", reply.GetStatus().String(), "err", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package encoder
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync/atomic"
+	"testing"
+
+	pb "github.com/Layr-Labs/eigenda/disperser/api/grpc/encoder"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// countingEncoderServer counts the encoding requests it receives and passes them on to the wrapped server
+type countingEncoderServer struct {
+	pb.UnimplementedEncoderServer
+	encoder  pb.EncoderServer
+	requests atomic.Int64
+}
+
+func (s *countingEncoderServer) EncodeBlob(ctx context.Context, req *pb.EncodeBlobRequest) (*pb.EncodeBlobReply, error) {
+	s.requests.Add(1)
+	return s.encoder.EncodeBlob(ctx, req)
+}
+
+func (s *countingEncoderServer) EncodeBlobStream(req *pb.EncodeBlobRequest, stream pb.Encoder_EncodeBlobStreamServer) error {
+	s.requests.Add(1)
+	return s.encoder.EncodeBlobStream(req, stream)
+}
+
+// rateLimitedEncoderServer rejects all the encoding requests
+type rateLimitedEncoderServer struct {
+	pb.UnimplementedEncoderServer
+}
+
+func (s *rateLimitedEncoderServer) EncodeBlob(context.Context, *pb.EncodeBlobRequest) (*pb.EncodeBlobReply, error) {
+	return nil, errors.New("too many requests")
+}
+
+func (s *rateLimitedEncoderServer) EncodeBlobStream(*pb.EncodeBlobRequest, pb.Encoder_EncodeBlobStreamServer) error {
+	return errors.New("too many requests")
+}
+
+// startPoolTestServer serves the encoder and a health service on a local port and returns the address
+func startPoolTestServer(t *testing.T, encoder pb.EncoderServer) (string, *health.Server) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	gs := grpc.NewServer()
+	pb.RegisterEncoderServer(gs, encoder)
+	healthServer := health.NewServer()
+	grpc_health_v1.RegisterHealthServer(gs, healthServer)
+	go func() {
+		_ = gs.Serve(listener)
+	}()
+	t.Cleanup(gs.Stop)
+
+	return listener.Addr().String(), healthServer
+}
+
+func TestEncoderPoolFailover(t *testing.T) {
+	rateLimitedAddr, _ := startPoolTestServer(t, &rateLimitedEncoderServer{})
+	encoder := &countingEncoderServer{encoder: newEncoderTestServer(t)}
+	addr, _ := startPoolTestServer(t, encoder)
+
+	pool, err := NewEncoderPool([]string{rateLimitedAddr, addr}, 0, logger)
+	require.NoError(t, err)
+	defer pool.Close()
+
+	testBlob, testEncodingParams := getTestData()
+	numRequests := 4
+	for i := 0; i < numRequests; i++ {
+		commitments, chunks, err := pool.EncodeBlob(context.Background(), testBlob.Data, testEncodingParams)
+		assert.NoError(t, err)
+		assert.NotNil(t, commitments)
+		assert.Len(t, chunks, int(testEncodingParams.NumChunks))
+	}
+	assert.Equal(t, int64(numRequests), encoder.requests.Load())
+}
+
+func TestEncoderPoolAllEncodersFail(t *testing.T) {
+	addr1, _ := startPoolTestServer(t, &rateLimitedEncoderServer{})
+	addr2, _ := startPoolTestServer(t, &rateLimitedEncoderServer{})
+
+	pool, err := NewEncoderPool([]string{addr1, addr2}, 0, logger)
+	require.NoError(t, err)
+	defer pool.Close()
+
+	testBlob, testEncodingParams := getTestData()
+	_, _, err = pool.EncodeBlob(context.Background(), testBlob.Data, testEncodingParams)
+	assert.ErrorContains(t, err, "encoding request failed on 2 encoders")
+}
+
+func TestEncoderPoolEjectsUnhealthyEncoders(t *testing.T) {
+	unhealthyEncoder := &countingEncoderServer{encoder: newEncoderTestServer(t)}
+	unhealthyAddr, unhealthyHealthServer := startPoolTestServer(t, unhealthyEncoder)
+	healthyEncoder := &countingEncoderServer{encoder: newEncoderTestServer(t)}
+	healthyAddr, _ := startPoolTestServer(t, healthyEncoder)
+
+	pool, err := NewEncoderPool([]string{unhealthyAddr, healthyAddr}, 0, logger)
+	require.NoError(t, err)
+	defer pool.Close()
+
+	unhealthyHealthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	pool.checkHealth(context.Background())
+
+	testBlob, testEncodingParams := getTestData()
+	for i := 0; i < 4; i++ {
+		_, _, err := pool.EncodeBlob(context.Background(), testBlob.Data, testEncodingParams)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, int64(0), unhealthyEncoder.requests.Load())
+	assert.Equal(t, int64(4), healthyEncoder.requests.Load())
+
+	// The encoder is added back to the pool once it's healthy again
+	unhealthyHealthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
+	pool.checkHealth(context.Background())
+	for i := 0; i < 4; i++ {
+		_, _, err := pool.EncodeBlob(context.Background(), testBlob.Data, testEncodingParams)
+		assert.NoError(t, err)
+	}
+	assert.Greater(t, unhealthyEncoder.requests.Load(), int64(0))
+}
+
+func TestEncoderPoolPicksLeastOutstanding(t *testing.T) {
+	pool, err := NewEncoderPool([]string{"127.0.0.1:1", "127.0.0.1:2", "127.0.0.1:3"}, 0, logger)
+	require.NoError(t, err)
+	defer pool.Close()
+
+	pool.endpoints[0].outstanding.Store(2)
+	pool.endpoints[1].outstanding.Store(1)
+	pool.endpoints[2].outstanding.Store(3)
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, pool.endpoints[1], pool.pick(map[*encoderEndpoint]bool{}))
+	}
+
+	// Endpoints that were already tried are skipped
+	tried := map[*encoderEndpoint]bool{pool.endpoints[1]: true}
+	assert.Equal(t, pool.endpoints[0], pool.pick(tried))
+
+	// Unhealthy endpoints are skipped while another one is healthy
+	pool.endpoints[0].healthy.Store(false)
+	assert.Equal(t, pool.endpoints[2], pool.pick(tried))
+
+	// All endpoints are considered if none is healthy
+	pool.endpoints[1].healthy.Store(false)
+	pool.endpoints[2].healthy.Store(false)
+	assert.Equal(t, pool.endpoints[0], pool.pick(tried))
+
+	tried[pool.endpoints[0]] = true
+	tried[pool.endpoints[2]] = true
+	assert.Nil(t, pool.pick(tried))
+}
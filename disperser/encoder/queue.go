@@ -0,0 +1,202 @@
+package encoder
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+var errQueueFull = errors.New("too many requests")
+
+// queuedRequest is an encoding request waiting in the requestQueue for one of the encoding slots
+type queuedRequest struct {
+	ctx context.Context
+	// deadline is the deadline of the request's context, or the zero time if it has none
+	deadline time.Time
+	// slots is the number of encoding slots the request takes, i.e. the number of blobs it encodes
+	slots      int
+	seq        uint64
+	enqueuedAt time.Time
+	// ready is closed when the request leaves the queue. The request holds an encoding slot if admitted is true,
+	// otherwise it was dropped because its context expired.
+	ready    chan struct{}
+	admitted bool
+	// index is the position of the request in the heap, or -1 once it has left the queue
+	index int
+}
+
+// requestQueue admits the queued encoding requests in order of deadline, as long as the running requests take at most
+// maxRunning slots. A request that takes more slots than that is admitted once nothing else is running.
+// Requests without a deadline are admitted after the ones with a deadline, and requests with the same deadline are
+// admitted in arrival order. At most maxSize slots are held, counting both the queued and the running requests.
+type requestQueue struct {
+	mu       sync.Mutex
+	requests requestHeap
+	// numRunning and numQueued are the number of slots taken by the running and the queued requests
+	numRunning int
+	numQueued  int
+	nextSeq    uint64
+
+	maxRunning int
+	maxSize    int
+}
+
+func newRequestQueue(maxRunning int, maxSize int) *requestQueue {
+	return &requestQueue{
+		maxRunning: maxRunning,
+		maxSize:    maxSize,
+	}
+}
+
+// push adds a request taking the given number of slots to the queue. It returns errQueueFull if the queue doesn't
+// have room for it after dropping the requests whose context expired.
+func (q *requestQueue) push(ctx context.Context, slots int) (*queuedRequest, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.numRunning+q.numQueued+slots > q.maxSize {
+		q.dropExpired()
+		if q.numRunning+q.numQueued+slots > q.maxSize {
+			return nil, errQueueFull
+		}
+	}
+
+	deadline, _ := ctx.Deadline()
+	req := &queuedRequest{
+		ctx:        ctx,
+		deadline:   deadline,
+		slots:      slots,
+		seq:        q.nextSeq,
+		enqueuedAt: time.Now(),
+		ready:      make(chan struct{}),
+	}
+	q.nextSeq++
+	heap.Push(&q.requests, req)
+	q.numQueued += slots
+	q.dispatch()
+	return req, nil
+}
+
+// wait blocks until the request is admitted and returns the time it spent in the queue. It returns the context's
+// error if the context expires first; the request doesn't hold an encoding slot in that case.
+func (q *requestQueue) wait(req *queuedRequest) (time.Duration, error) {
+	select {
+	case <-req.ready:
+	case <-req.ctx.Done():
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		if req.index >= 0 {
+			heap.Remove(&q.requests, req.index)
+			q.numQueued -= req.slots
+			return time.Since(req.enqueuedAt), req.ctx.Err()
+		}
+		if req.admitted {
+			// The request was admitted concurrently, so its slots go to the next requests
+			q.numRunning -= req.slots
+			q.dispatch()
+		}
+		return time.Since(req.enqueuedAt), req.ctx.Err()
+	}
+
+	if !req.admitted {
+		return time.Since(req.enqueuedAt), req.ctx.Err()
+	}
+	return time.Since(req.enqueuedAt), nil
+}
+
+// done releases the encoding slots of an admitted request
+func (q *requestQueue) done(req *queuedRequest) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.numRunning -= req.slots
+	q.dispatch()
+}
+
+// len returns the number of requests waiting in the queue
+func (q *requestQueue) len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.requests.Len()
+}
+
+// dispatch admits the queued requests in order while there are enough free encoding slots for them, dropping the
+// ones whose context expired while they were waiting. q.mu must be held.
+func (q *requestQueue) dispatch() {
+	for q.requests.Len() > 0 {
+		req := q.requests[0]
+		if req.ctx.Err() == nil && q.numRunning > 0 && q.numRunning+req.slots > q.maxRunning {
+			return
+		}
+		heap.Pop(&q.requests)
+		q.numQueued -= req.slots
+		if req.ctx.Err() != nil {
+			close(req.ready)
+			continue
+		}
+		req.admitted = true
+		q.numRunning += req.slots
+		close(req.ready)
+	}
+}
+
+// dropExpired removes the queued requests whose context expired. q.mu must be held.
+func (q *requestQueue) dropExpired() {
+	requests := q.requests[:0]
+	for _, req := range q.requests {
+		if req.ctx.Err() != nil {
+			req.index = -1
+			q.numQueued -= req.slots
+			close(req.ready)
+			continue
+		}
+		requests = append(requests, req)
+	}
+	for i := len(requests); i < len(q.requests); i++ {
+		q.requests[i] = nil
+	}
+	q.requests = requests
+	for i, req := range q.requests {
+		req.index = i
+	}
+	heap.Init(&q.requests)
+}
+
+// requestHeap implements heap.Interface, ordering the requests by deadline and then by arrival
+type requestHeap []*queuedRequest
+
+func (h requestHeap) Len() int { return len(h) }
+
+func (h requestHeap) Less(i, j int) bool {
+	a, b := h[i], h[j]
+	if a.deadline.IsZero() != b.deadline.IsZero() {
+		return !a.deadline.IsZero()
+	}
+	if !a.deadline.Equal(b.deadline) {
+		return a.deadline.Before(b.deadline)
+	}
+	return a.seq < b.seq
+}
+
+func (h requestHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *requestHeap) Push(x any) {
+	req := x.(*queuedRequest)
+	req.index = len(*h)
+	*h = append(*h, req)
+}
+
+func (h *requestHeap) Pop() any {
+	old := *h
+	n := len(old)
+	req := old[n-1]
+	old[n-1] = nil
+	req.index = -1
+	*h = old[:n-1]
+	return req
+}
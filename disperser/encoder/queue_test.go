@@ -0,0 +1,133 @@
+package encoder
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func isAdmitted(req *queuedRequest) bool {
+	select {
+	case <-req.ready:
+		return req.admitted
+	default:
+		return false
+	}
+}
+
+func TestRequestQueueOrdersByDeadline(t *testing.T) {
+	q := newRequestQueue(1, 10)
+
+	running, err := q.push(context.Background(), 1)
+	require.NoError(t, err)
+	assert.True(t, isAdmitted(running))
+
+	noDeadline, err := q.push(context.Background(), 1)
+	require.NoError(t, err)
+	ctx3, cancel3 := context.WithTimeout(context.Background(), 3*time.Minute)
+	defer cancel3()
+	late, err := q.push(ctx3, 1)
+	require.NoError(t, err)
+	ctx1, cancel1 := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel1()
+	early, err := q.push(ctx1, 1)
+	require.NoError(t, err)
+	ctx2, cancel2 := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel2()
+	middle, err := q.push(ctx2, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 4, q.len())
+
+	for _, next := range []*queuedRequest{early, middle, late, noDeadline} {
+		assert.False(t, isAdmitted(next))
+		q.done(running)
+		assert.True(t, isAdmitted(next))
+		waitTime, err := q.wait(next)
+		assert.NoError(t, err)
+		assert.GreaterOrEqual(t, waitTime, time.Duration(0))
+		running = next
+	}
+	assert.Equal(t, 0, q.len())
+}
+
+func TestRequestQueueDropsExpiredRequests(t *testing.T) {
+	q := newRequestQueue(1, 3)
+
+	running, err := q.push(context.Background(), 1)
+	require.NoError(t, err)
+
+	expiredCtx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	expired, err := q.push(expiredCtx, 1)
+	require.NoError(t, err)
+	next, err := q.push(context.Background(), 1)
+	require.NoError(t, err)
+
+	// The queue is full until the expired request is dropped
+	<-expiredCtx.Done()
+	full, err := q.push(context.Background(), 1)
+	require.NoError(t, err)
+	_, err = q.push(context.Background(), 1)
+	assert.ErrorIs(t, err, errQueueFull)
+
+	_, err = q.wait(expired)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.False(t, expired.admitted)
+
+	q.done(running)
+	assert.True(t, isAdmitted(next))
+	assert.False(t, isAdmitted(full))
+}
+
+func TestRequestQueueReleasesCanceledRequests(t *testing.T) {
+	q := newRequestQueue(1, 3)
+
+	running, err := q.push(context.Background(), 1)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	canceled, err := q.push(ctx, 1)
+	require.NoError(t, err)
+	next, err := q.push(context.Background(), 1)
+	require.NoError(t, err)
+
+	cancel()
+	_, err = q.wait(canceled)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, q.len())
+
+	// The slot of the running request goes to the next request rather than the canceled one
+	q.done(running)
+	assert.True(t, isAdmitted(next))
+	assert.False(t, canceled.admitted)
+}
+
+func TestRequestQueueCountsSlots(t *testing.T) {
+	q := newRequestQueue(2, 4)
+
+	running, err := q.push(context.Background(), 1)
+	require.NoError(t, err)
+	assert.True(t, isAdmitted(running))
+
+	// A request for 3 blobs doesn't fit in the free slot, and holds 3 of the 4 slots of the queue
+	batch, err := q.push(context.Background(), 3)
+	require.NoError(t, err)
+	assert.False(t, isAdmitted(batch))
+	_, err = q.push(context.Background(), 1)
+	assert.ErrorIs(t, err, errQueueFull)
+
+	// It takes more slots than can run at once, so it runs once nothing else is running
+	q.done(running)
+	assert.True(t, isAdmitted(batch))
+	next, err := q.push(context.Background(), 1)
+	require.NoError(t, err)
+	assert.False(t, isAdmitted(next))
+	_, err = q.push(context.Background(), 1)
+	assert.ErrorIs(t, err, errQueueFull)
+
+	q.done(batch)
+	assert.True(t, isAdmitted(next))
+}
@@ -13,7 +13,9 @@ import (
 	"github.com/Layr-Labs/eigenda/disperser"
 	pb "github.com/Layr-Labs/eigenda/disperser/api/grpc/encoder"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 )
 
 // TODO: Add EncodeMetrics
@@ -26,8 +28,8 @@ type Server struct {
 	metrics     *Metrics
 	close       func()
 
-	runningRequests chan struct{}
-	requestPool     chan struct{}
+	// requestQueue holds the requests waiting to be encoded, ordered by deadline
+	requestQueue *requestQueue
 }
 
 func NewServer(config ServerConfig, logger common.Logger, coreEncoder core.Encoder, metrics *Metrics) *Server {
@@ -37,26 +39,16 @@ func NewServer(config ServerConfig, logger common.Logger, coreEncoder core.Encod
 		coreEncoder: coreEncoder,
 		metrics:     metrics,
 
-		runningRequests: make(chan struct{}, config.MaxConcurrentRequests),
-		requestPool:     make(chan struct{}, config.RequestPoolSize),
+		requestQueue: newRequestQueue(config.MaxConcurrentRequests, config.RequestPoolSize),
 	}
 }
 
 func (s *Server) EncodeBlob(ctx context.Context, req *pb.EncodeBlobRequest) (*pb.EncodeBlobReply, error) {
-	select {
-	case s.requestPool <- struct{}{}:
-	default:
-		s.metrics.IncrementRateLimitedBlobRequestNum()
-		s.logger.Warn("rate limiting as request pool is full", "requestPoolSize", s.config.RequestPoolSize, "maxConcurrentRequests", s.config.MaxConcurrentRequests)
-		return nil, fmt.Errorf("too many requests")
-	}
-	s.runningRequests <- struct{}{}
-	defer s.popRequest()
-
-	if ctx.Err() != nil {
-		s.metrics.IncrementCanceledBlobRequestNum()
-		return nil, ctx.Err()
+	queuedRequest, err := s.acquireSlots(ctx, 1)
+	if err != nil {
+		return nil, err
 	}
+	defer s.requestQueue.done(queuedRequest)
 
 	reply, err := s.handleEncoding(ctx, req)
 	if err != nil {
@@ -67,38 +59,100 @@ func (s *Server) EncodeBlob(ctx context.Context, req *pb.EncodeBlobRequest) (*pb
 	return reply, err
 }
 
-func (s *Server) popRequest() {
-	<-s.requestPool
-	<-s.runningRequests
+// EncodeBlobStream encodes the blob like EncodeBlob, but sends the commitment first and then the chunks in frames of
+// at most StreamFrameSize bytes
+func (s *Server) EncodeBlobStream(req *pb.EncodeBlobRequest, stream pb.Encoder_EncodeBlobStreamServer) error {
+	ctx := stream.Context()
+	queuedRequest, err := s.acquireSlots(ctx, 1)
+	if err != nil {
+		return err
+	}
+	defer s.requestQueue.done(queuedRequest)
+
+	err = s.handleStreamEncoding(ctx, req, stream)
+	if err != nil {
+		s.metrics.IncrementFailedBlobRequestNum()
+	} else {
+		s.metrics.IncrementSuccessfulBlobRequestNum()
+	}
+	return err
 }
 
-func (s *Server) handleEncoding(ctx context.Context, req *pb.EncodeBlobRequest) (*pb.EncodeBlobReply, error) {
-	begin := time.Now()
+// EncodeBlobs encodes the blobs of the request together and streams their encodings one blob after the other, in
+// frames like EncodeBlobStream. The request takes a slot of the request queue per blob, and is rejected if it has more
+// than MaxBlobsPerRequest blobs.
+func (s *Server) EncodeBlobs(req *pb.EncodeBlobsRequest, stream pb.Encoder_EncodeBlobsServer) error {
+	numBlobs := len(req.GetData())
+	if numBlobs == 0 {
+		return status.Error(codes.InvalidArgument, "no blobs to encode")
+	}
+	maxBlobs := s.config.MaxBlobsPerRequest
+	if maxBlobs <= 0 {
+		maxBlobs = DefaultMaxBlobsPerRequest
+	}
+	if numBlobs > maxBlobs {
+		return status.Errorf(codes.InvalidArgument, "too many blobs to encode: %d, the maximum is %d", numBlobs, maxBlobs)
+	}
 
-	// Convert to core EncodingParams
-	var encodingParams = core.EncodingParams{
-		ChunkLength: uint(req.EncodingParams.ChunkLength),
-		NumChunks:   uint(req.EncodingParams.NumChunks),
+	ctx := stream.Context()
+	queuedRequest, err := s.acquireSlots(ctx, numBlobs)
+	if err != nil {
+		return err
 	}
+	defer s.requestQueue.done(queuedRequest)
 
-	commits, chunks, err := s.coreEncoder.Encode(req.Data, encodingParams)
+	numFailed, err := s.handleBatchEncoding(ctx, req, stream)
+	if err != nil {
+		numFailed = numBlobs
+	}
+	for i := 0; i < numBlobs; i++ {
+		if i < numFailed {
+			s.metrics.IncrementFailedBlobRequestNum()
+		} else {
+			s.metrics.IncrementSuccessfulBlobRequestNum()
+		}
+	}
+	return err
+}
 
+// acquireSlots waits in the request queue until the request can be encoded with the given number of slots. The caller
+// must release the slots with s.requestQueue.done if it returns no error.
+func (s *Server) acquireSlots(ctx context.Context, slots int) (*queuedRequest, error) {
+	queuedRequest, err := s.requestQueue.push(ctx, slots)
 	if err != nil {
+		s.metrics.IncrementRateLimitedBlobRequestNum()
+		s.logger.Warn("rate limiting as request pool is full", "requestPoolSize", s.config.RequestPoolSize, "maxConcurrentRequests", s.config.MaxConcurrentRequests, "slots", slots)
 		return nil, err
 	}
+	s.metrics.ObserveQueueDepth(s.requestQueue.len())
 
-	encodingTime := time.Since(begin)
-
-	commitData, err := commits.Commitment.Serialize()
+	waitTime, err := s.requestQueue.wait(queuedRequest)
+	s.metrics.ObserveQueueDepth(s.requestQueue.len())
+	s.metrics.TakeQueueingLatency(waitTime)
 	if err != nil {
+		s.metrics.IncrementCanceledBlobRequestNum()
 		return nil, err
 	}
 
-	lengthProofData, err := commits.LengthProof.Serialize()
+	// The deadline may have passed while the request was being admitted, in which case it isn't worth encoding
+	if ctx.Err() != nil {
+		s.requestQueue.done(queuedRequest)
+		s.metrics.IncrementCanceledBlobRequestNum()
+		return nil, ctx.Err()
+	}
+	return queuedRequest, nil
+}
+
+func (s *Server) handleEncoding(ctx context.Context, req *pb.EncodeBlobRequest) (*pb.EncodeBlobReply, error) {
+	begin := time.Now()
+
+	commitment, chunks, err := s.encode(req)
 	if err != nil {
 		return nil, err
 	}
 
+	encodingTime := time.Since(begin)
+
 	var chunksData [][]byte
 
 	for _, chunk := range chunks {
@@ -114,12 +168,166 @@ func (s *Server) handleEncoding(ctx context.Context, req *pb.EncodeBlobRequest)
 	s.metrics.TakeLatency(encodingTime, totalTime)
 
 	return &pb.EncodeBlobReply{
-		Commitment: &pb.BlobCommitment{
-			Commitment:  commitData,
-			LengthProof: lengthProofData,
-			Length:      uint32(commits.Length),
-		},
-		Chunks: chunksData,
+		Commitment: commitment,
+		Chunks:     chunksData,
+	}, nil
+}
+
+func (s *Server) handleStreamEncoding(ctx context.Context, req *pb.EncodeBlobRequest, stream pb.Encoder_EncodeBlobStreamServer) error {
+	begin := time.Now()
+
+	commitment, chunks, err := s.encode(req)
+	if err != nil {
+		return err
+	}
+
+	encodingTime := time.Since(begin)
+
+	err = stream.Send(&pb.EncodeBlobStreamReply{
+		Commitment: commitment,
+		NumChunks:  uint32(len(chunks)),
+	})
+	if err != nil {
+		return err
+	}
+
+	err = s.sendChunks(chunks, func(frame [][]byte) error {
+		return stream.Send(&pb.EncodeBlobStreamReply{Chunks: frame})
+	})
+	if err != nil {
+		return err
+	}
+
+	totalTime := time.Since(begin)
+	s.metrics.TakeLatency(encodingTime, totalTime)
+
+	return nil
+}
+
+// handleBatchEncoding encodes the blobs of the request and streams their encodings. It returns the number of blobs
+// that failed to encode.
+func (s *Server) handleBatchEncoding(ctx context.Context, req *pb.EncodeBlobsRequest, stream pb.Encoder_EncodeBlobsServer) (int, error) {
+	begin := time.Now()
+
+	// Convert to core EncodingParams
+	var encodingParams = core.EncodingParams{
+		ChunkLength: uint(req.EncodingParams.ChunkLength),
+		NumChunks:   uint(req.EncodingParams.NumChunks),
+	}
+
+	results, err := s.coreEncoder.EncodeBlobs(req.Data, encodingParams)
+	if err != nil {
+		return 0, err
+	}
+
+	encodingTime := time.Since(begin)
+
+	numFailed := 0
+	for i, result := range results {
+		blobIndex := uint32(i)
+		if result.Err != nil {
+			numFailed++
+			if err := stream.Send(&pb.EncodeBlobsReply{BlobIndex: blobIndex, Error: result.Err.Error()}); err != nil {
+				return 0, err
+			}
+			continue
+		}
+
+		commitment, err := serializeCommitments(result.Commitments)
+		if err != nil {
+			return 0, err
+		}
+		err = stream.Send(&pb.EncodeBlobsReply{
+			BlobIndex:  blobIndex,
+			Commitment: commitment,
+			NumChunks:  uint32(len(result.Chunks)),
+		})
+		if err != nil {
+			return 0, err
+		}
+		err = s.sendChunks(result.Chunks, func(frame [][]byte) error {
+			return stream.Send(&pb.EncodeBlobsReply{BlobIndex: blobIndex, Chunks: frame})
+		})
+		if err != nil {
+			return 0, err
+		}
+		// The chunks of the blob are no longer needed once they're sent
+		results[i] = core.BlobEncodingResult{}
+	}
+
+	totalTime := time.Since(begin)
+	s.metrics.TakeLatency(encodingTime, totalTime)
+
+	return numFailed, nil
+}
+
+// sendChunks serializes the chunks and sends them in order with send, in frames of at most StreamFrameSize bytes.
+// Only a frame of serialized chunks is held at a time. A frame holds at least one chunk, even if the chunk is larger
+// than the frame size.
+func (s *Server) sendChunks(chunks []*core.Chunk, send func(frame [][]byte) error) error {
+	frameSize := s.config.StreamFrameSize
+	if frameSize <= 0 {
+		frameSize = DefaultStreamFrameSize
+	}
+	var frame [][]byte
+	var size int
+	for _, chunk := range chunks {
+		chunkSerialized, err := chunk.Serialize()
+		if err != nil {
+			return err
+		}
+		if len(frame) > 0 && size+len(chunkSerialized) > frameSize {
+			if err := send(frame); err != nil {
+				return err
+			}
+			frame = nil
+			size = 0
+		}
+		frame = append(frame, chunkSerialized)
+		size += len(chunkSerialized)
+	}
+	if len(frame) > 0 {
+		return send(frame)
+	}
+	return nil
+}
+
+// encode encodes the blob of the request and serializes its commitment
+func (s *Server) encode(req *pb.EncodeBlobRequest) (*pb.BlobCommitment, []*core.Chunk, error) {
+	// Convert to core EncodingParams
+	var encodingParams = core.EncodingParams{
+		ChunkLength: uint(req.EncodingParams.ChunkLength),
+		NumChunks:   uint(req.EncodingParams.NumChunks),
+	}
+
+	commits, chunks, err := s.coreEncoder.Encode(req.Data, encodingParams)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	commitment, err := serializeCommitments(commits)
+	if err != nil {
+		return nil, nil, err
+	}
+	return commitment, chunks, nil
+}
+
+func serializeCommitments(commits core.BlobCommitments) (*pb.BlobCommitment, error) {
+	commitData, err := commits.Commitment.Serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	lengthProofData, err := commits.LengthProof.Serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.BlobCommitment{
+		Commitment:  commitData,
+		LengthProof: lengthProofData,
+		Length:      uint32(commits.Length),
 	}, nil
 }
 
@@ -134,7 +342,11 @@ func (s *Server) Start() error {
 		log.Fatalf("Could not start tcp listener: %v", err)
 	}
 
-	opt := grpc.MaxRecvMsgSize(1024 * 1024 * 300) // 300 MiB
+	maxRequestSize := s.config.MaxRequestSize
+	if maxRequestSize <= 0 {
+		maxRequestSize = DefaultMaxRequestSize
+	}
+	opt := grpc.MaxRecvMsgSize(maxRequestSize)
 	gs := grpc.NewServer(opt)
 	reflection.Register(gs)
 	pb.RegisterEncoderServer(gs, s)
@@ -3,6 +3,7 @@ package encoder
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"math/big"
@@ -13,6 +14,9 @@ import (
 	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	cmock "github.com/Layr-Labs/eigenda/common/mock"
 	"github.com/Layr-Labs/eigenda/core"
@@ -221,3 +225,182 @@ func TestThrottling(t *testing.T) {
 		}
 	}
 }
+
+func TestExpiredRequestsAreNotEncoded(t *testing.T) {
+	metrics := NewMetrics("9000", logger)
+	encoder := &encoding.MockEncoder{
+		Delay: 300 * time.Millisecond,
+	}
+	encoder.On("Encode", mock.Anything, mock.Anything).Return(core.BlobCommitments{}, []*core.Chunk{}, errors.New("encoding failed"))
+	encoderServerConfig := ServerConfig{
+		GrpcPort:              "3000",
+		MaxConcurrentRequests: 1,
+		RequestPoolSize:       4,
+	}
+	s := NewServer(encoderServerConfig, logger, encoder, metrics)
+	testBlobData, testEncodingParams := getTestData()
+	encodeBlobRequestProto := &pb.EncodeBlobRequest{
+		Data: []byte(testBlobData.Data),
+		EncodingParams: &pb.EncodingParams{
+			ChunkLength: uint32(testEncodingParams.ChunkLength),
+			NumChunks:   uint32(testEncodingParams.NumChunks),
+		},
+	}
+
+	done := make(chan error)
+	go func() {
+		_, err := s.EncodeBlob(context.Background(), encodeBlobRequestProto)
+		done <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	// The request waits in the queue instead of being rejected, and is dropped once its deadline passes
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err := s.EncodeBlob(ctx, encodeBlobRequestProto)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	assert.ErrorContains(t, <-done, "encoding failed")
+	encoder.AssertNumberOfCalls(t, "Encode", 1)
+}
+
+// testEncodeBlobStream records the frames sent by EncodeBlobStream
+type testEncodeBlobStream struct {
+	grpc.ServerStream
+	frames []*pb.EncodeBlobStreamReply
+}
+
+func (s *testEncodeBlobStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *testEncodeBlobStream) Send(reply *pb.EncodeBlobStreamReply) error {
+	s.frames = append(s.frames, reply)
+	return nil
+}
+
+func TestEncodeBlobStream(t *testing.T) {
+	metrics := NewMetrics("9000", logger)
+	config := testServerConfig
+	testBlobData, testEncodingParams := getTestData()
+	encodeBlobRequestProto := &pb.EncodeBlobRequest{
+		Data: []byte(testBlobData.Data),
+		EncodingParams: &pb.EncodingParams{
+			ChunkLength: uint32(testEncodingParams.ChunkLength),
+			NumChunks:   uint32(testEncodingParams.NumChunks),
+		},
+	}
+
+	reply, err := NewServer(config, logger, testEncoder, metrics).EncodeBlob(context.Background(), encodeBlobRequestProto)
+	assert.NoError(t, err)
+	maxChunkSize := 0
+	for _, chunk := range reply.GetChunks() {
+		if len(chunk) > maxChunkSize {
+			maxChunkSize = len(chunk)
+		}
+	}
+
+	// Frames fit at least three chunks
+	config.StreamFrameSize = 3 * maxChunkSize
+	stream := &testEncodeBlobStream{}
+	err = NewServer(config, logger, testEncoder, metrics).EncodeBlobStream(encodeBlobRequestProto, stream)
+	assert.NoError(t, err)
+
+	numChunks := len(reply.GetChunks())
+	assert.Greater(t, len(stream.frames), 2)
+	assert.LessOrEqual(t, len(stream.frames), 1+(numChunks+2)/3)
+	assert.Equal(t, reply.GetCommitment().GetCommitment(), stream.frames[0].GetCommitment().GetCommitment())
+	assert.Equal(t, reply.GetCommitment().GetLengthProof(), stream.frames[0].GetCommitment().GetLengthProof())
+	assert.Equal(t, reply.GetCommitment().GetLength(), stream.frames[0].GetCommitment().GetLength())
+	assert.Equal(t, uint32(numChunks), stream.frames[0].GetNumChunks())
+	assert.Empty(t, stream.frames[0].GetChunks())
+
+	var chunks [][]byte
+	for _, frame := range stream.frames[1:] {
+		frameSize := 0
+		for _, chunk := range frame.GetChunks() {
+			frameSize += len(chunk)
+		}
+		assert.LessOrEqual(t, frameSize, config.StreamFrameSize)
+		chunks = append(chunks, frame.GetChunks()...)
+	}
+	assert.Equal(t, reply.GetChunks(), chunks)
+}
+
+// testEncodeBlobsStream records the frames sent by EncodeBlobs
+type testEncodeBlobsStream struct {
+	grpc.ServerStream
+	frames []*pb.EncodeBlobsReply
+}
+
+func (s *testEncodeBlobsStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *testEncodeBlobsStream) Send(reply *pb.EncodeBlobsReply) error {
+	s.frames = append(s.frames, reply)
+	return nil
+}
+
+func TestEncodeBlobs(t *testing.T) {
+	config := testServerConfig
+	config.StreamFrameSize = 1
+	server := NewServer(config, logger, testEncoder, NewMetrics("9000", logger))
+	testBlobData, testEncodingParams := getTestData()
+
+	// The last blob is too large to be encoded with the params
+	blobs := [][]byte{testBlobData.Data, []byte("small blob"), make([]byte, 32*testEncodingParams.ChunkLength*testEncodingParams.NumChunks)}
+	stream := &testEncodeBlobsStream{}
+	err := server.EncodeBlobs(&pb.EncodeBlobsRequest{
+		Data: blobs,
+		EncodingParams: &pb.EncodingParams{
+			ChunkLength: uint32(testEncodingParams.ChunkLength),
+			NumChunks:   uint32(testEncodingParams.NumChunks),
+		},
+	}, stream)
+	assert.NoError(t, err)
+
+	// Each blob is sent in a first frame followed by a frame per chunk, as the frame size fits a single chunk
+	frames := stream.frames
+	for i, blob := range blobs[:2] {
+		commitments, chunks, err := testEncoder.Encode(blob, testEncodingParams)
+		assert.NoError(t, err)
+		commitData, err := commitments.Commitment.Serialize()
+		assert.NoError(t, err)
+
+		first := frames[0]
+		assert.Equal(t, uint32(i), first.GetBlobIndex())
+		assert.Empty(t, first.GetError())
+		assert.Equal(t, commitData, first.GetCommitment().GetCommitment())
+		assert.Equal(t, uint32(commitments.Length), first.GetCommitment().GetLength())
+		assert.Equal(t, uint32(len(chunks)), first.GetNumChunks())
+		for _, frame := range frames[1 : 1+len(chunks)] {
+			assert.Equal(t, uint32(i), frame.GetBlobIndex())
+			assert.Nil(t, frame.GetCommitment())
+			assert.Len(t, frame.GetChunks(), 1)
+		}
+		frames = frames[1+len(chunks):]
+	}
+	assert.Len(t, frames, 1)
+	assert.Equal(t, uint32(2), frames[0].GetBlobIndex())
+	assert.NotEmpty(t, frames[0].GetError())
+	assert.Nil(t, frames[0].GetCommitment())
+}
+
+func TestEncodeBlobsRejectsTooManyBlobs(t *testing.T) {
+	config := testServerConfig
+	config.MaxBlobsPerRequest = 2
+	server := NewServer(config, logger, testEncoder, NewMetrics("9000", logger))
+	testBlobData, testEncodingParams := getTestData()
+
+	stream := &testEncodeBlobsStream{}
+	err := server.EncodeBlobs(&pb.EncodeBlobsRequest{
+		Data: [][]byte{testBlobData.Data, testBlobData.Data, testBlobData.Data},
+		EncodingParams: &pb.EncodingParams{
+			ChunkLength: uint32(testEncodingParams.ChunkLength),
+			NumChunks:   uint32(testEncodingParams.NumChunks),
+		},
+	}, stream)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Empty(t, stream.frames)
+}
@@ -8,4 +8,7 @@ import (
 
 type EncoderClient interface {
 	EncodeBlob(ctx context.Context, data []byte, encodingParams core.EncodingParams) (*core.BlobCommitments, []*core.Chunk, error)
+	// EncodeBlobs encodes blobs that share the same encoding params in a single request. The i-th result is the encoding
+	// of the i-th blob. An error is returned if the request as a whole fails.
+	EncodeBlobs(ctx context.Context, blobs [][]byte, encodingParams core.EncodingParams) ([]core.BlobEncodingResult, error)
 }
@@ -3,5 +3,9 @@ package disperser
 import "errors"
 
 var (
-	ErrBlobNotFound = errors.New("blob not found")
+	ErrBlobNotFound      = errors.New("blob not found")
+	ErrBlobNotProcessing = errors.New("blob is not processing")
+	ErrBlobNotFailed     = errors.New("blob is not failed")
+	ErrBlobNotConfirmed  = errors.New("blob is not confirmed")
+	ErrBlobCancelled     = errors.New("blob is cancelled")
 )
@@ -31,3 +31,9 @@ func (m *LocalEncoderClient) EncodeBlob(ctx context.Context, data []byte, encodi
 
 	return &commits, chunks, nil
 }
+
+func (m *LocalEncoderClient) EncodeBlobs(ctx context.Context, blobs [][]byte, encodingParams core.EncodingParams) ([]core.BlobEncodingResult, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.encoder.EncodeBlobs(blobs, encodingParams)
+}
@@ -30,3 +30,12 @@ func (m *MockEncoderClient) EncodeBlob(ctx context.Context, data []byte, encodin
 	}
 	return commitments, chunks, args.Error(2)
 }
+
+func (m *MockEncoderClient) EncodeBlobs(ctx context.Context, blobs [][]byte, encodingParams core.EncodingParams) ([]core.BlobEncodingResult, error) {
+	args := m.Called(ctx, blobs, encodingParams)
+	var results []core.BlobEncodingResult
+	if args.Get(0) != nil {
+		results = args.Get(0).([]core.BlobEncodingResult)
+	}
+	return results, args.Error(1)
+}
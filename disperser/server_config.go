@@ -1,9 +1,63 @@
 package disperser
 
+import (
+	"fmt"
+	"time"
+
+	"github.com/Layr-Labs/eigenda/core"
+	"github.com/Layr-Labs/eigenda/pkg/encoding/encoder"
+)
+
 const (
 	Localhost = "0.0.0.0"
 )
 
+// DefaultMaxBlobSize is the max size of a blob in bytes accepted by the server if it isn't configured
+const DefaultMaxBlobSize = 1024 * 512 // 512 KiB
+
+// Limits of the SubscribeBlobStatus streams used by the server if they aren't configured
+const (
+	DefaultMaxStatusStreams        = 10_000
+	DefaultMaxStatusStreamsPerIP   = 16
+	DefaultMaxStatusStreamDuration = 30 * time.Minute
+)
+
 type ServerConfig struct {
 	GrpcPort string
+	// StatusPollInterval is how often the server checks the blob store for status
+	// transitions of blobs with an open SubscribeBlobStatus stream
+	StatusPollInterval time.Duration
+	// MaxStatusStreams is the max number of SubscribeBlobStatus streams open at a time, and MaxStatusStreamsPerIP the
+	// max number of them from the same client address. MaxStatusStreamDuration is how long a stream is kept open
+	// before the client has to subscribe again. The defaults are used if they're 0.
+	MaxStatusStreams        int
+	MaxStatusStreamsPerIP   int
+	MaxStatusStreamDuration time.Duration
+	// MaxBlobSize is the max size of a blob in bytes accepted by the server.
+	// DefaultMaxBlobSize is used if it's 0.
+	MaxBlobSize int
+	// DuplicateWindow is how long a dispersed blob is remembered for duplicate detection.
+	// Within the window, a request from the same account with the same data and security
+	// params returns the existing request ID instead of dispersing the blob again.
+	// Duplicate detection is disabled if it's 0.
+	DuplicateWindow time.Duration
+	// BlockedCallbackPorts are the ports that callback URLs can't point to, e.g. the port of the admin server
+	BlockedCallbackPorts []string
+}
+
+// ValidateMaxBlobSize checks that a blob of maxBlobSize bytes can be encoded with an SRS of
+// order srsOrder under the largest coding ratio allowed by BlobRequestHeader.Validate.
+func ValidateMaxBlobSize(maxBlobSize, srsOrder int) error {
+	if maxBlobSize <= 0 {
+		return fmt.Errorf("max blob size must be greater than 0, but found %d", maxBlobSize)
+	}
+
+	blobLength := core.GetBlobLength(uint(maxBlobSize))
+	// The quorum threshold must be at least 10 above the adversary threshold
+	encodedLength := core.GetEncodedBlobLength(blobLength, 100, 90)
+	if encoder.NextPowerOf2(uint64(encodedLength)) >= uint64(srsOrder) {
+		return fmt.Errorf("max blob size of %d bytes is too large for SRS order %d", maxBlobSize, srsOrder)
+	}
+
+	return nil
 }
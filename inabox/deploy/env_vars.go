@@ -15,6 +15,8 @@ type DisperserVars struct {
 
 	DISPERSER_SERVER_EIGENDA_SERVICE_MANAGER string
 
+	DISPERSER_SERVER_SRS_ORDER string
+
 	DISPERSER_SERVER_METRICS_HTTP_PORT string
 
 	DISPERSER_SERVER_ENABLE_METRICS string
@@ -23,6 +25,18 @@ type DisperserVars struct {
 
 	DISPERSER_SERVER_RATE_BUCKET_STORE_SIZE string
 
+	DISPERSER_SERVER_STATUS_POLL_INTERVAL string
+
+	DISPERSER_SERVER_MAX_STATUS_STREAMS string
+
+	DISPERSER_SERVER_MAX_STATUS_STREAMS_PER_IP string
+
+	DISPERSER_SERVER_MAX_STATUS_STREAM_DURATION string
+
+	DISPERSER_SERVER_MAX_BLOB_SIZE string
+
+	DISPERSER_SERVER_DUPLICATE_WINDOW string
+
 	DISPERSER_SERVER_CHAIN_RPC string
 
 	DISPERSER_SERVER_PRIVATE_KEY string
@@ -31,6 +31,7 @@ import (
 	"github.com/Layr-Labs/eigenda/common/logging"
 	commonmock "github.com/Layr-Labs/eigenda/common/mock"
 	"github.com/Layr-Labs/eigenda/core"
+	"github.com/Layr-Labs/eigenda/core/auth"
 	"github.com/Layr-Labs/eigenda/core/encoding"
 	coremock "github.com/Layr-Labs/eigenda/core/mock"
 	"github.com/Layr-Labs/eigenda/disperser"
@@ -146,7 +147,7 @@ func mustMakeDisperser(t *testing.T, cst core.IndexedChainState, store disperser
 	batcherConfig := batcher.Config{
 		PullInterval:             5 * time.Second,
 		NumConnections:           1,
-		EncoderSocket:            fmt.Sprintf("localhost:%s", encoderPort),
+		EncoderSockets:           []string{fmt.Sprintf("localhost:%s", encoderPort)},
 		EncodingRequestQueueSize: 100,
 		SRSOrder:                 3000,
 	}
@@ -165,7 +166,7 @@ func mustMakeDisperser(t *testing.T, cst core.IndexedChainState, store disperser
 		RequestPoolSize:       32,
 	}, logger, enc0, metrics)
 
-	encoderClient, err := encoder.NewEncoderClient(batcherConfig.EncoderSocket, 10*time.Second)
+	encoderClient, err := encoder.NewEncoderClient(batcherConfig.EncoderSockets[0], 10*time.Second)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -174,7 +175,7 @@ func mustMakeDisperser(t *testing.T, cst core.IndexedChainState, store disperser
 	disperserMetrics := disperser.NewMetrics("9100", logger)
 	batcherMetrics := batcher.NewMetrics("9100", logger)
 
-	batcher, err := batcher.NewBatcher(batcherConfig, timeoutConfig, store, dispatcher, confirmer, cst, asn, encoderClient, agg, &commonmock.MockEthClient{}, finalizer, logger, batcherMetrics)
+	batcher, err := batcher.NewBatcher(batcherConfig, timeoutConfig, store, dispatcher, confirmer, cst, asn, encoderClient, agg, &commonmock.MockEthClient{}, finalizer, batchermock.NewNotifier(), logger, batcherMetrics)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -195,7 +196,7 @@ func mustMakeDisperser(t *testing.T, cst core.IndexedChainState, store disperser
 	tx := &coremock.MockTransactor{}
 	tx.On("GetCurrentBlockNumber").Return(uint64(100), nil)
 	tx.On("GetQuorumCount").Return(1, nil)
-	server := apiserver.NewDispersalServer(serverConfig, store, tx, logger, disperserMetrics, ratelimiter, rateConfig)
+	server := apiserver.NewDispersalServer(serverConfig, store, tx, logger, disperserMetrics, ratelimiter, rateConfig, auth.NewAuthenticator())
 
 	return TestDisperser{
 		Batcher:       batcher,
@@ -8,7 +8,6 @@ import (
 	"encoding/hex"
 	"fmt"
 	"log"
-	"math/big"
 	"math/rand"
 	"os"
 	"runtime"
@@ -20,6 +19,7 @@ import (
 
 	disperser_rpc "github.com/Layr-Labs/eigenda/api/grpc/disperser"
 	retriever_rpc "github.com/Layr-Labs/eigenda/api/grpc/retriever"
+	"github.com/Layr-Labs/eigenda/clients/calldata"
 	common "github.com/Layr-Labs/eigenda/common"
 	"github.com/Layr-Labs/eigenda/common/geth"
 	"github.com/Layr-Labs/eigenda/common/logging"
@@ -223,9 +223,9 @@ loop:
 					logger.Printf("Validating OnChain Transaction for Blob with header %v", blobReply.Info.BlobHeader)
 
 					// Verify Blob OnChain
-					blobHeader := blobHeaderFromProto(blobReply.Info.BlobHeader)
+					blobHeader, verificationProof, err := calldata.FromBlobStatusReply(blobReply)
+					assert.Nil(t, err)
 					logger.Printf("BlobHeader %v", blobHeader)
-					verificationProof := blobVerificationProofFromProto(blobReply.GetInfo().GetBlobVerificationProof())
 					logger.Printf("VerificationProof %v", verificationProof)
 
 					// Get MockRollUp And EthClient
@@ -380,69 +380,6 @@ func retrieverClientBlobRetrieve(blobStatusReply *disperser_rpc.BlobStatusReply,
 
 }
 
-func blobHeaderFromProto(blobHeader *disperser_rpc.BlobHeader) rollupbindings.IEigenDAServiceManagerBlobHeader {
-	logger := testSuite.Logger
-	commitmentBytes := blobHeader.GetCommitment()
-	commitment, err := new(core.Commitment).Deserialize(commitmentBytes)
-	if err != nil {
-		logger.Printf("failed to deserialize commitment: %s", err)
-		return rollupbindings.IEigenDAServiceManagerBlobHeader{}
-	}
-	type IEigenDAServiceManagerQuorumBlobParam struct {
-		QuorumNumber                 uint8
-		AdversaryThresholdPercentage uint8
-		QuorumThresholdPercentage    uint8
-		QuantizationParameter        uint8
-	}
-	quorums := make([]rollupbindings.IEigenDAServiceManagerQuorumBlobParam, len(blobHeader.GetBlobQuorumParams()))
-	for i, quorum := range blobHeader.GetBlobQuorumParams() {
-		quorums[i] = rollupbindings.IEigenDAServiceManagerQuorumBlobParam{
-			QuorumNumber:                 uint8(quorum.GetQuorumNumber()),
-			AdversaryThresholdPercentage: uint8(quorum.GetAdversaryThresholdPercentage()),
-			QuorumThresholdPercentage:    uint8(quorum.GetQuorumThresholdPercentage()),
-			QuantizationParameter:        uint8(quorum.GetQuantizationParam()),
-		}
-	}
-
-	return rollupbindings.IEigenDAServiceManagerBlobHeader{
-		Commitment: rollupbindings.BN254G1Point{
-			X: commitment.X.BigInt(new(big.Int)),
-			Y: commitment.Y.BigInt(new(big.Int)),
-		},
-		DataLength:       blobHeader.GetDataLength(),
-		QuorumBlobParams: quorums,
-	}
-}
-
-func blobVerificationProofFromProto(verificationProof *disperser_rpc.BlobVerificationProof) rollupbindings.EigenDABlobUtilsBlobVerificationProof {
-	batchMetadataProto := verificationProof.GetBatchMetadata()
-	batchHeaderProto := verificationProof.GetBatchMetadata().GetBatchHeader()
-	var batchRoot [32]byte
-	copy(batchRoot[:], batchHeaderProto.GetBatchRoot())
-	batchHeader := rollupbindings.IEigenDAServiceManagerBatchHeader{
-		BlobHeadersRoot:            batchRoot,
-		QuorumNumbers:              batchHeaderProto.GetQuorumNumbers(),
-		QuorumThresholdPercentages: batchHeaderProto.GetQuorumSignedPercentages(),
-		ReferenceBlockNumber:       batchHeaderProto.GetReferenceBlockNumber(),
-	}
-	var sig [32]byte
-	copy(sig[:], batchMetadataProto.GetSignatoryRecordHash())
-	fee := new(big.Int).SetBytes(batchMetadataProto.GetFee())
-	batchMetadata := rollupbindings.IEigenDAServiceManagerBatchMetadata{
-		BatchHeader:             batchHeader,
-		SignatoryRecordHash:     sig,
-		Fee:                     fee,
-		ConfirmationBlockNumber: batchMetadataProto.GetConfirmationBlockNumber(),
-	}
-	return rollupbindings.EigenDABlobUtilsBlobVerificationProof{
-		BatchId:                verificationProof.GetBatchId(),
-		BlobIndex:              uint8(verificationProof.GetBlobIndex()),
-		BatchMetadata:          batchMetadata,
-		InclusionProof:         verificationProof.GetInclusionProof(),
-		QuorumThresholdIndexes: verificationProof.GetQuorumIndexes(),
-	}
-}
-
 func TestEncodeBlob(t *testing.T) {
 	t.Skip("Skipping this test")
 